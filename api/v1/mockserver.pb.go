@@ -23,6 +23,257 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FallbackPolicy represents what the mockserver does with a request that does not match any expectation.
+type FallbackPolicy int32
+
+const (
+	// FALLBACK_POLICY_UNSPECIFIED uses the global policy configured with UNMATCHED_POLICY.
+	FallbackPolicy_FALLBACK_POLICY_UNSPECIFIED FallbackPolicy = 0
+	// FALLBACK_POLICY_REPLY publishes the default response and acknowledges the request.
+	FallbackPolicy_FALLBACK_POLICY_REPLY FallbackPolicy = 1
+	// FALLBACK_POLICY_DROP acknowledges the request without publishing any reply.
+	FallbackPolicy_FALLBACK_POLICY_DROP FallbackPolicy = 2
+	// FALLBACK_POLICY_REJECT negatively acknowledges the request without requeueing it,
+	// so it is dead-lettered if the queue has a dead letter exchange.
+	FallbackPolicy_FALLBACK_POLICY_REJECT FallbackPolicy = 3
+	// FALLBACK_POLICY_REQUEUE negatively acknowledges the request and puts it back to the queue.
+	FallbackPolicy_FALLBACK_POLICY_REQUEUE FallbackPolicy = 4
+	// FALLBACK_POLICY_PROXY forwards the request to the real service and relays its reply to the caller.
+	// The round trip is recorded as a proxied assertion.
+	FallbackPolicy_FALLBACK_POLICY_PROXY FallbackPolicy = 5
+)
+
+// Enum value maps for FallbackPolicy.
+var (
+	FallbackPolicy_name = map[int32]string{
+		0: "FALLBACK_POLICY_UNSPECIFIED",
+		1: "FALLBACK_POLICY_REPLY",
+		2: "FALLBACK_POLICY_DROP",
+		3: "FALLBACK_POLICY_REJECT",
+		4: "FALLBACK_POLICY_REQUEUE",
+		5: "FALLBACK_POLICY_PROXY",
+	}
+	FallbackPolicy_value = map[string]int32{
+		"FALLBACK_POLICY_UNSPECIFIED": 0,
+		"FALLBACK_POLICY_REPLY":       1,
+		"FALLBACK_POLICY_DROP":        2,
+		"FALLBACK_POLICY_REJECT":      3,
+		"FALLBACK_POLICY_REQUEUE":     4,
+		"FALLBACK_POLICY_PROXY":       5,
+	}
+)
+
+func (x FallbackPolicy) Enum() *FallbackPolicy {
+	p := new(FallbackPolicy)
+	*p = x
+	return p
+}
+
+func (x FallbackPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FallbackPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[0].Descriptor()
+}
+
+func (FallbackPolicy) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[0]
+}
+
+func (x FallbackPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FallbackPolicy.Descriptor instead.
+func (FallbackPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{0}
+}
+
+// Action represents what the mockserver does with a matched request.
+type Action int32
+
+const (
+	// ACTION_UNSPECIFIED defaults to ACTION_REPLY.
+	Action_ACTION_UNSPECIFIED Action = 0
+	// ACTION_REPLY publishes the response to the reply-to queue of the request.
+	Action_ACTION_REPLY Action = 1
+	// ACTION_DROP acknowledges the request and records the assertion, but never publishes a reply.
+	// Use it to exercise client side timeouts.
+	Action_ACTION_DROP Action = 2
+)
+
+// Enum value maps for Action.
+var (
+	Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_REPLY",
+		2: "ACTION_DROP",
+	}
+	Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_REPLY":       1,
+		"ACTION_DROP":        2,
+	}
+)
+
+func (x Action) Enum() *Action {
+	p := new(Action)
+	*p = x
+	return p
+}
+
+func (x Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[1].Descriptor()
+}
+
+func (Action) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[1]
+}
+
+func (x Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Action.Descriptor instead.
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{1}
+}
+
+// EventType represents the kind of an event.
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED         EventType = 0
+	EventType_EVENT_TYPE_EXPECTATION_CREATED EventType = 1
+	EventType_EVENT_TYPE_EXPECTATION_UPDATED EventType = 2
+	EventType_EVENT_TYPE_EXPECTATION_DELETED EventType = 3
+	// The expectation matched a request.
+	EventType_EVENT_TYPE_EXPECTATION_USED EventType = 4
+	// The expectation reached its usage limit.
+	EventType_EVENT_TYPE_EXPECTATION_EXHAUSTED EventType = 5
+	// The time to live of the expectation elapsed.
+	EventType_EVENT_TYPE_EXPECTATION_EXPIRED  EventType = 6
+	EventType_EVENT_TYPE_REQUEST_MATCHED      EventType = 7
+	EventType_EVENT_TYPE_REQUEST_UNMATCHED    EventType = 8
+	EventType_EVENT_TYPE_SUBSCRIPTION_ADDED   EventType = 9
+	EventType_EVENT_TYPE_SUBSCRIPTION_REMOVED EventType = 10
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_EXPECTATION_CREATED",
+		2:  "EVENT_TYPE_EXPECTATION_UPDATED",
+		3:  "EVENT_TYPE_EXPECTATION_DELETED",
+		4:  "EVENT_TYPE_EXPECTATION_USED",
+		5:  "EVENT_TYPE_EXPECTATION_EXHAUSTED",
+		6:  "EVENT_TYPE_EXPECTATION_EXPIRED",
+		7:  "EVENT_TYPE_REQUEST_MATCHED",
+		8:  "EVENT_TYPE_REQUEST_UNMATCHED",
+		9:  "EVENT_TYPE_SUBSCRIPTION_ADDED",
+		10: "EVENT_TYPE_SUBSCRIPTION_REMOVED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":           0,
+		"EVENT_TYPE_EXPECTATION_CREATED":   1,
+		"EVENT_TYPE_EXPECTATION_UPDATED":   2,
+		"EVENT_TYPE_EXPECTATION_DELETED":   3,
+		"EVENT_TYPE_EXPECTATION_USED":      4,
+		"EVENT_TYPE_EXPECTATION_EXHAUSTED": 5,
+		"EVENT_TYPE_EXPECTATION_EXPIRED":   6,
+		"EVENT_TYPE_REQUEST_MATCHED":       7,
+		"EVENT_TYPE_REQUEST_UNMATCHED":     8,
+		"EVENT_TYPE_SUBSCRIPTION_ADDED":    9,
+		"EVENT_TYPE_SUBSCRIPTION_REMOVED":  10,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[2].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[2]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{2}
+}
+
+// SequenceMode represents how strictly the steps of a sequence must follow each other.
+type SequenceMode int32
+
+const (
+	// SEQUENCE_MODE_UNSPECIFIED defaults to SEQUENCE_MODE_LOOSE.
+	SequenceMode_SEQUENCE_MODE_UNSPECIFIED SequenceMode = 0
+	// SEQUENCE_MODE_LOOSE allows other requests to arrive between the steps.
+	SequenceMode_SEQUENCE_MODE_LOOSE SequenceMode = 1
+	// SEQUENCE_MODE_STRICT requires the steps to match consecutive requests.
+	SequenceMode_SEQUENCE_MODE_STRICT SequenceMode = 2
+)
+
+// Enum value maps for SequenceMode.
+var (
+	SequenceMode_name = map[int32]string{
+		0: "SEQUENCE_MODE_UNSPECIFIED",
+		1: "SEQUENCE_MODE_LOOSE",
+		2: "SEQUENCE_MODE_STRICT",
+	}
+	SequenceMode_value = map[string]int32{
+		"SEQUENCE_MODE_UNSPECIFIED": 0,
+		"SEQUENCE_MODE_LOOSE":       1,
+		"SEQUENCE_MODE_STRICT":      2,
+	}
+)
+
+func (x SequenceMode) Enum() *SequenceMode {
+	p := new(SequenceMode)
+	*p = x
+	return p
+}
+
+func (x SequenceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SequenceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[3].Descriptor()
+}
+
+func (SequenceMode) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[3]
+}
+
+func (x SequenceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SequenceMode.Descriptor instead.
+func (SequenceMode) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{3}
+}
+
 type JSONBodyAssertion_MatchType int32
 
 const (
@@ -54,50 +305,4720 @@ func (x JSONBodyAssertion_MatchType) Enum() *JSONBodyAssertion_MatchType {
 	return p
 }
 
-func (x JSONBodyAssertion_MatchType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x JSONBodyAssertion_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JSONBodyAssertion_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[4].Descriptor()
+}
+
+func (JSONBodyAssertion_MatchType) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[4]
+}
+
+func (x JSONBodyAssertion_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JSONBodyAssertion_MatchType.Descriptor instead.
+func (JSONBodyAssertion_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type ValueAssertion_MatchType int32
+
+const (
+	// Unspecified match type. If not set, it defaults to EXACT.
+	ValueAssertion_MATCH_TYPE_UNSPECIFIED ValueAssertion_MatchType = 0
+	// Exact match of the value.
+	ValueAssertion_MATCH_TYPE_EXACT ValueAssertion_MatchType = 1
+	// Match of the value using a regular expression.
+	ValueAssertion_MATCH_TYPE_REGEX ValueAssertion_MatchType = 2
+	// The header or property must be present, its value is ignored.
+	ValueAssertion_MATCH_TYPE_PRESENT ValueAssertion_MatchType = 3
+	// The header or property must not be present.
+	ValueAssertion_MATCH_TYPE_ABSENT ValueAssertion_MatchType = 4
+)
+
+// Enum value maps for ValueAssertion_MatchType.
+var (
+	ValueAssertion_MatchType_name = map[int32]string{
+		0: "MATCH_TYPE_UNSPECIFIED",
+		1: "MATCH_TYPE_EXACT",
+		2: "MATCH_TYPE_REGEX",
+		3: "MATCH_TYPE_PRESENT",
+		4: "MATCH_TYPE_ABSENT",
+	}
+	ValueAssertion_MatchType_value = map[string]int32{
+		"MATCH_TYPE_UNSPECIFIED": 0,
+		"MATCH_TYPE_EXACT":       1,
+		"MATCH_TYPE_REGEX":       2,
+		"MATCH_TYPE_PRESENT":     3,
+		"MATCH_TYPE_ABSENT":      4,
+	}
+)
+
+func (x ValueAssertion_MatchType) Enum() *ValueAssertion_MatchType {
+	p := new(ValueAssertion_MatchType)
+	*p = x
+	return p
+}
+
+func (x ValueAssertion_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueAssertion_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[5].Descriptor()
+}
+
+func (ValueAssertion_MatchType) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[5]
+}
+
+func (x ValueAssertion_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValueAssertion_MatchType.Descriptor instead.
+func (ValueAssertion_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Request_ExchangeMatchType int32
+
+const (
+	// Unspecified match type. If not set, it defaults to EXACT.
+	Request_EXCHANGE_MATCH_TYPE_UNSPECIFIED Request_ExchangeMatchType = 0
+	// The exchange must be equal.
+	Request_EXCHANGE_MATCH_TYPE_EXACT Request_ExchangeMatchType = 1
+	// Messages from any exchange are matched; the exchange field may be left empty.
+	Request_EXCHANGE_MATCH_TYPE_ANY Request_ExchangeMatchType = 2
+)
+
+// Enum value maps for Request_ExchangeMatchType.
+var (
+	Request_ExchangeMatchType_name = map[int32]string{
+		0: "EXCHANGE_MATCH_TYPE_UNSPECIFIED",
+		1: "EXCHANGE_MATCH_TYPE_EXACT",
+		2: "EXCHANGE_MATCH_TYPE_ANY",
+	}
+	Request_ExchangeMatchType_value = map[string]int32{
+		"EXCHANGE_MATCH_TYPE_UNSPECIFIED": 0,
+		"EXCHANGE_MATCH_TYPE_EXACT":       1,
+		"EXCHANGE_MATCH_TYPE_ANY":         2,
+	}
+)
+
+func (x Request_ExchangeMatchType) Enum() *Request_ExchangeMatchType {
+	p := new(Request_ExchangeMatchType)
+	*p = x
+	return p
+}
+
+func (x Request_ExchangeMatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Request_ExchangeMatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[6].Descriptor()
+}
+
+func (Request_ExchangeMatchType) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[6]
+}
+
+func (x Request_ExchangeMatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Request_ExchangeMatchType.Descriptor instead.
+func (Request_ExchangeMatchType) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type Request_RoutingKeyMatchType int32
+
+const (
+	// Unspecified match type. If not set, it defaults to EXACT.
+	Request_ROUTING_KEY_MATCH_TYPE_UNSPECIFIED Request_RoutingKeyMatchType = 0
+	// The routing key must be equal.
+	Request_ROUTING_KEY_MATCH_TYPE_EXACT Request_RoutingKeyMatchType = 1
+	// The routing key is a topic exchange binding pattern,
+	// "*" matches exactly one word and "#" matches zero or more words.
+	Request_ROUTING_KEY_MATCH_TYPE_TOPIC Request_RoutingKeyMatchType = 2
+)
+
+// Enum value maps for Request_RoutingKeyMatchType.
+var (
+	Request_RoutingKeyMatchType_name = map[int32]string{
+		0: "ROUTING_KEY_MATCH_TYPE_UNSPECIFIED",
+		1: "ROUTING_KEY_MATCH_TYPE_EXACT",
+		2: "ROUTING_KEY_MATCH_TYPE_TOPIC",
+	}
+	Request_RoutingKeyMatchType_value = map[string]int32{
+		"ROUTING_KEY_MATCH_TYPE_UNSPECIFIED": 0,
+		"ROUTING_KEY_MATCH_TYPE_EXACT":       1,
+		"ROUTING_KEY_MATCH_TYPE_TOPIC":       2,
+	}
+)
+
+func (x Request_RoutingKeyMatchType) Enum() *Request_RoutingKeyMatchType {
+	p := new(Request_RoutingKeyMatchType)
+	*p = x
+	return p
+}
+
+func (x Request_RoutingKeyMatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Request_RoutingKeyMatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mockserver_proto_enumTypes[7].Descriptor()
+}
+
+func (Request_RoutingKeyMatchType) Type() protoreflect.EnumType {
+	return &file_mockserver_proto_enumTypes[7]
+}
+
+func (x Request_RoutingKeyMatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Request_RoutingKeyMatchType.Descriptor instead.
+func (Request_RoutingKeyMatchType) EnumDescriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{13, 1}
+}
+
+// ProxyTarget is where unmatched requests are forwarded to with the FALLBACK_POLICY_PROXY policy.
+type ProxyTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// exchange is the exchange of the real service.
+	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// routing_key is the routing key of the real service, if empty the routing key of the request is kept.
+	RoutingKey    string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyTarget) Reset() {
+	*x = ProxyTarget{}
+	mi := &file_mockserver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyTarget) ProtoMessage() {}
+
+func (x *ProxyTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyTarget.ProtoReflect.Descriptor instead.
+func (*ProxyTarget) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProxyTarget) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ProxyTarget) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	// fallback_policy is the policy override for unmatched requests, unspecified if the global policy applies.
+	FallbackPolicy FallbackPolicy `protobuf:"varint,3,opt,name=fallback_policy,json=fallbackPolicy,proto3,enum=rmqrpc.mockserver.api.v1.FallbackPolicy" json:"fallback_policy,omitempty"`
+	// proxy_target is the proxy target override, unset if the global target applies.
+	ProxyTarget *ProxyTarget `protobuf:"bytes,4,opt,name=proxy_target,json=proxyTarget,proto3,oneof" json:"proxy_target,omitempty"`
+	// namespace is the namespace the requests received by the subscription are matched in, empty for the default one.
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// session_id is the session owning the subscription, not set if it has none.
+	SessionId     *string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_mockserver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *Subscription) GetFallbackPolicy() FallbackPolicy {
+	if x != nil {
+		return x.FallbackPolicy
+	}
+	return FallbackPolicy_FALLBACK_POLICY_UNSPECIFIED
+}
+
+func (x *Subscription) GetProxyTarget() *ProxyTarget {
+	if x != nil {
+		return x.ProxyTarget
+	}
+	return nil
+}
+
+func (x *Subscription) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Subscription) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+// AddSubscriptionRequest is a request to add a subscription to a queue
+type AddSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// queue is the name of the queue to subscribe to.
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// idempotent indicates if the subscription should be idempotent.
+	// If true, adding a subscription with the same queue name multiple times will result in only one subscription.
+	Idempotent bool `protobuf:"varint,2,opt,name=idempotent,proto3" json:"idempotent,omitempty"`
+	// fallback_policy overrides the global policy for unmatched requests received by this subscription.
+	FallbackPolicy FallbackPolicy `protobuf:"varint,3,opt,name=fallback_policy,json=fallbackPolicy,proto3,enum=rmqrpc.mockserver.api.v1.FallbackPolicy" json:"fallback_policy,omitempty"`
+	// proxy_target overrides the global proxy target configured with PROXY_EXCHANGE and PROXY_ROUTING_KEY.
+	ProxyTarget *ProxyTarget `protobuf:"bytes,4,opt,name=proxy_target,json=proxyTarget,proto3,oneof" json:"proxy_target,omitempty"`
+	// namespace binds the subscription to a namespace, by default the namespace selected by the call.
+	Namespace     *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubscriptionRequest) Reset() {
+	*x = AddSubscriptionRequest{}
+	mi := &file_mockserver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubscriptionRequest) ProtoMessage() {}
+
+func (x *AddSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*AddSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddSubscriptionRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *AddSubscriptionRequest) GetIdempotent() bool {
+	if x != nil {
+		return x.Idempotent
+	}
+	return false
+}
+
+func (x *AddSubscriptionRequest) GetFallbackPolicy() FallbackPolicy {
+	if x != nil {
+		return x.FallbackPolicy
+	}
+	return FallbackPolicy_FALLBACK_POLICY_UNSPECIFIED
+}
+
+func (x *AddSubscriptionRequest) GetProxyTarget() *ProxyTarget {
+	if x != nil {
+		return x.ProxyTarget
+	}
+	return nil
+}
+
+func (x *AddSubscriptionRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// AddSubscriptionResponse returns the newly created subscription.
+type AddSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSubscriptionResponse) Reset() {
+	*x = AddSubscriptionResponse{}
+	mi := &file_mockserver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubscriptionResponse) ProtoMessage() {}
+
+func (x *AddSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*AddSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// DeleteSubscriptionRequest is a request to remove a subscription from a queue.
+type DeleteSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	mi := &file_mockserver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+// DeleteSubscriptionResponse is returned after a subscription is successfully removed.
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	mi := &file_mockserver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{5}
+}
+
+// UnsubscribeFromQueueRequest is used to remove all subscriptions from a specified queue.
+type UnsubscribeFromQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeFromQueueRequest) Reset() {
+	*x = UnsubscribeFromQueueRequest{}
+	mi := &file_mockserver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeFromQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFromQueueRequest) ProtoMessage() {}
+
+func (x *UnsubscribeFromQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFromQueueRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFromQueueRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnsubscribeFromQueueRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+// UnsubscribeFromQueueResponse confirms the successful removal of subscriptions from the queue.
+type UnsubscribeFromQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeFromQueueResponse) Reset() {
+	*x = UnsubscribeFromQueueResponse{}
+	mi := &file_mockserver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeFromQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFromQueueResponse) ProtoMessage() {}
+
+func (x *UnsubscribeFromQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFromQueueResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFromQueueResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{7}
+}
+
+// GetAllSubscriptionsRequest is used to retrieve all active subscriptions.
+type GetAllSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllSubscriptionsRequest) Reset() {
+	*x = GetAllSubscriptionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{8}
+}
+
+// GetAllSubscriptionsResponse contains a list of active subscriptions.
+type GetAllSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllSubscriptionsResponse) Reset() {
+	*x = GetAllSubscriptionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllSubscriptionsResponse) ProtoMessage() {}
+
+func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAllSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// JSONBodyAssertion is used to specify matching rules for JSON body content.
+type JSONBodyAssertion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The JSON structure to be matched.
+	Body *structpb.Struct `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// Type of matching for the JSON body.
+	MatchType     JSONBodyAssertion_MatchType `protobuf:"varint,2,opt,name=match_type,json=matchType,proto3,enum=rmqrpc.mockserver.api.v1.JSONBodyAssertion_MatchType" json:"match_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JSONBodyAssertion) Reset() {
+	*x = JSONBodyAssertion{}
+	mi := &file_mockserver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JSONBodyAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONBodyAssertion) ProtoMessage() {}
+
+func (x *JSONBodyAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONBodyAssertion.ProtoReflect.Descriptor instead.
+func (*JSONBodyAssertion) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *JSONBodyAssertion) GetBody() *structpb.Struct {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *JSONBodyAssertion) GetMatchType() JSONBodyAssertion_MatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return JSONBodyAssertion_MATCH_TYPE_UNSPECIFIED
+}
+
+// RegexBodyAssertion is used to specify matching rules using regular expressions.
+type RegexBodyAssertion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// regex is the pattern that should match the body of the request.
+	Regex         string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexBodyAssertion) Reset() {
+	*x = RegexBodyAssertion{}
+	mi := &file_mockserver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexBodyAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexBodyAssertion) ProtoMessage() {}
+
+func (x *RegexBodyAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexBodyAssertion.ProtoReflect.Descriptor instead.
+func (*RegexBodyAssertion) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegexBodyAssertion) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+// ValueAssertion is used to specify matching rules for a single message header or property.
+type ValueAssertion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the expected value or the regular expression, depending on match_type.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Type of matching for the value.
+	MatchType     ValueAssertion_MatchType `protobuf:"varint,2,opt,name=match_type,json=matchType,proto3,enum=rmqrpc.mockserver.api.v1.ValueAssertion_MatchType" json:"match_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueAssertion) Reset() {
+	*x = ValueAssertion{}
+	mi := &file_mockserver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueAssertion) ProtoMessage() {}
+
+func (x *ValueAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueAssertion.ProtoReflect.Descriptor instead.
+func (*ValueAssertion) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValueAssertion) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ValueAssertion) GetMatchType() ValueAssertion_MatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return ValueAssertion_MATCH_TYPE_UNSPECIFIED
+}
+
+// Request represents an incoming request that the mockserver should expect.
+type Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exchange the message is sent to.
+	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// The routing key the message is sent with.
+	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Request_JsonBody
+	//	*Request_RegexBody
+	Body isRequest_Body `protobuf_oneof:"body"`
+	// headers are matchers for the message headers, keyed by header name.
+	Headers map[string]*ValueAssertion `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// properties are matchers for the message properties, keyed by property name:
+	// content_type, content_encoding, delivery_mode, priority, correlation_id, reply_to,
+	// expiration, message_id, type, user_id, app_id.
+	Properties map[string]*ValueAssertion `protobuf:"bytes,6,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Type of matching for the exchange.
+	ExchangeMatchType Request_ExchangeMatchType `protobuf:"varint,7,opt,name=exchange_match_type,json=exchangeMatchType,proto3,enum=rmqrpc.mockserver.api.v1.Request_ExchangeMatchType" json:"exchange_match_type,omitempty"`
+	// Type of matching for the routing key.
+	RoutingKeyMatchType Request_RoutingKeyMatchType `protobuf:"varint,8,opt,name=routing_key_match_type,json=routingKeyMatchType,proto3,enum=rmqrpc.mockserver.api.v1.Request_RoutingKeyMatchType" json:"routing_key_match_type,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_mockserver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Request) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Request) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *Request) GetBody() isRequest_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Request) GetJsonBody() *JSONBodyAssertion {
+	if x != nil {
+		if x, ok := x.Body.(*Request_JsonBody); ok {
+			return x.JsonBody
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetRegexBody() *RegexBodyAssertion {
+	if x != nil {
+		if x, ok := x.Body.(*Request_RegexBody); ok {
+			return x.RegexBody
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetHeaders() map[string]*ValueAssertion {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Request) GetProperties() map[string]*ValueAssertion {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Request) GetExchangeMatchType() Request_ExchangeMatchType {
+	if x != nil {
+		return x.ExchangeMatchType
+	}
+	return Request_EXCHANGE_MATCH_TYPE_UNSPECIFIED
+}
+
+func (x *Request) GetRoutingKeyMatchType() Request_RoutingKeyMatchType {
+	if x != nil {
+		return x.RoutingKeyMatchType
+	}
+	return Request_ROUTING_KEY_MATCH_TYPE_UNSPECIFIED
+}
+
+type isRequest_Body interface {
+	isRequest_Body()
+}
+
+type Request_JsonBody struct {
+	// Match the JSON body of the request.
+	JsonBody *JSONBodyAssertion `protobuf:"bytes,3,opt,name=json_body,json=jsonBody,proto3,oneof"`
+}
+
+type Request_RegexBody struct {
+	// Match the body of the request using a regular expression.
+	RegexBody *RegexBodyAssertion `protobuf:"bytes,4,opt,name=regex_body,json=regexBody,proto3,oneof"`
+}
+
+func (*Request_JsonBody) isRequest_Body() {}
+
+func (*Request_RegexBody) isRequest_Body() {}
+
+// MessageProperties represents the AMQP basic properties of a message.
+type MessageProperties struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContentType     string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentEncoding string                 `protobuf:"bytes,2,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	DeliveryMode    uint32                 `protobuf:"varint,3,opt,name=delivery_mode,json=deliveryMode,proto3" json:"delivery_mode,omitempty"`
+	Priority        uint32                 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CorrelationId   string                 `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ReplyTo         string                 `protobuf:"bytes,6,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	Expiration      string                 `protobuf:"bytes,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	MessageId       string                 `protobuf:"bytes,8,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Type            string                 `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	UserId          string                 `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppId           string                 `protobuf:"bytes,11,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MessageProperties) Reset() {
+	*x = MessageProperties{}
+	mi := &file_mockserver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageProperties) ProtoMessage() {}
+
+func (x *MessageProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageProperties.ProtoReflect.Descriptor instead.
+func (*MessageProperties) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MessageProperties) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetContentEncoding() string {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetDeliveryMode() uint32 {
+	if x != nil {
+		return x.DeliveryMode
+	}
+	return 0
+}
+
+func (x *MessageProperties) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MessageProperties) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MessageProperties) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+// Response represents a response that the mockserver should return when the expectation is met.
+type Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The response body to be returned.
+	Body *structpb.Value `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// template is a Go text/template that is rendered for every matched request to produce the response body.
+	// If set, body is ignored. The template can reference .Exchange, .RoutingKey, .Headers, .Properties,
+	// .Body (decoded JSON), .RawBody and .Captures (regex_body capture groups by index or name),
+	// and use the helpers jsonPath, json, now, uuid, randomInt and randomString.
+	// Templates that fail to parse are rejected when the expectation is created.
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// headers are the AMQP headers published with the response.
+	Headers       map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_mockserver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Response) GetBody() *structpb.Value {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Response) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Response) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Times represents the number of times an expectation should/can be met
+type Times struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Times:
+	//
+	//	*Times_RemainingTimes
+	//	*Times_Unlimited
+	Times         isTimes_Times `protobuf_oneof:"times"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Times) Reset() {
+	*x = Times{}
+	mi := &file_mockserver_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Times) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Times) ProtoMessage() {}
+
+func (x *Times) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Times.ProtoReflect.Descriptor instead.
+func (*Times) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Times) GetTimes() isTimes_Times {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *Times) GetRemainingTimes() uint32 {
+	if x != nil {
+		if x, ok := x.Times.(*Times_RemainingTimes); ok {
+			return x.RemainingTimes
+		}
+	}
+	return 0
+}
+
+func (x *Times) GetUnlimited() bool {
+	if x != nil {
+		if x, ok := x.Times.(*Times_Unlimited); ok {
+			return x.Unlimited
+		}
+	}
+	return false
+}
+
+type isTimes_Times interface {
+	isTimes_Times()
+}
+
+type Times_RemainingTimes struct {
+	// remaining_times is the number of times the expectation can be met.
+	RemainingTimes uint32 `protobuf:"varint,1,opt,name=remaining_times,json=remainingTimes,proto3,oneof"`
+}
+
+type Times_Unlimited struct {
+	// unlimited indicates that the expectation can be met an unlimited number of times.
+	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3,oneof"`
+}
+
+func (*Times_RemainingTimes) isTimes_Times() {}
+
+func (*Times_Unlimited) isTimes_Times() {}
+
+// Delay represents how long the mockserver waits before publishing a reply.
+type Delay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Delay:
+	//
+	//	*Delay_FixedMs
+	//	*Delay_Uniform
+	//	*Delay_LogNormal
+	Delay         isDelay_Delay `protobuf_oneof:"delay"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delay) Reset() {
+	*x = Delay{}
+	mi := &file_mockserver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delay) ProtoMessage() {}
+
+func (x *Delay) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delay.ProtoReflect.Descriptor instead.
+func (*Delay) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Delay) GetDelay() isDelay_Delay {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *Delay) GetFixedMs() uint32 {
+	if x != nil {
+		if x, ok := x.Delay.(*Delay_FixedMs); ok {
+			return x.FixedMs
+		}
+	}
+	return 0
+}
+
+func (x *Delay) GetUniform() *Delay_UniformDelay {
+	if x != nil {
+		if x, ok := x.Delay.(*Delay_Uniform); ok {
+			return x.Uniform
+		}
+	}
+	return nil
+}
+
+func (x *Delay) GetLogNormal() *Delay_LogNormalDelay {
+	if x != nil {
+		if x, ok := x.Delay.(*Delay_LogNormal); ok {
+			return x.LogNormal
+		}
+	}
+	return nil
+}
+
+type isDelay_Delay interface {
+	isDelay_Delay()
+}
+
+type Delay_FixedMs struct {
+	// fixed_ms delays every reply by the same number of milliseconds.
+	FixedMs uint32 `protobuf:"varint,1,opt,name=fixed_ms,json=fixedMs,proto3,oneof"`
+}
+
+type Delay_Uniform struct {
+	// uniform delays replies by a random duration in a range.
+	Uniform *Delay_UniformDelay `protobuf:"bytes,2,opt,name=uniform,proto3,oneof"`
+}
+
+type Delay_LogNormal struct {
+	// log_normal delays replies by a random duration following a log-normal distribution.
+	LogNormal *Delay_LogNormalDelay `protobuf:"bytes,3,opt,name=log_normal,json=logNormal,proto3,oneof"`
+}
+
+func (*Delay_FixedMs) isDelay_Delay() {}
+
+func (*Delay_Uniform) isDelay_Delay() {}
+
+func (*Delay_LogNormal) isDelay_Delay() {}
+
+// Scenario makes an expectation part of a state machine shared by all expectations with the same scenario name.
+// Every scenario starts in the "Started" state.
+type Scenario struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the scenario.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// required_state is the state the scenario must be in for the expectation to match, any state if empty.
+	RequiredState string `protobuf:"bytes,2,opt,name=required_state,json=requiredState,proto3" json:"required_state,omitempty"`
+	// new_state is the state the scenario moves to when the expectation is matched, unchanged if empty.
+	NewState      string `protobuf:"bytes,3,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_mockserver_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetRequiredState() string {
+	if x != nil {
+		return x.RequiredState
+	}
+	return ""
+}
+
+func (x *Scenario) GetNewState() string {
+	if x != nil {
+		return x.NewState
+	}
+	return ""
+}
+
+// ScenarioState is the current state of a scenario.
+type ScenarioState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the scenario.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// state is the current state of the scenario.
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioState) Reset() {
+	*x = ScenarioState{}
+	mi := &file_mockserver_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioState) ProtoMessage() {}
+
+func (x *ScenarioState) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioState.ProtoReflect.Descriptor instead.
+func (*ScenarioState) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScenarioState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScenarioState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// CreateExpectationRequest is used to define an expectation for an incoming request.
+type CreateExpectationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the expected request details.
+	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// response is a response that should be returned when the expectation is met.
+	Response *Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// times is a number of times the expectation should be met
+	// if is not set, the expectation is set to be met once
+	Times *Times `protobuf:"bytes,3,opt,name=times,proto3,oneof" json:"times,omitempty"`
+	// time_to_live_seconds is a time to live for the expectation in seconds
+	// if time_to_live_seconds is not set, then the expectation is set to be live forever
+	TimeToLiveSeconds *float32 `protobuf:"fixed32,4,opt,name=time_to_live_seconds,json=timeToLiveSeconds,proto3,oneof" json:"time_to_live_seconds,omitempty"`
+	// delay is a delay before the reply is published, other messages of the subscription are not held back by it.
+	// if delay is not set, the reply is published immediately
+	Delay *Delay `protobuf:"bytes,5,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+	// action is what to do with a matched request, defaults to ACTION_REPLY.
+	Action Action `protobuf:"varint,6,opt,name=action,proto3,enum=rmqrpc.mockserver.api.v1.Action" json:"action,omitempty"`
+	// scenario makes the expectation only match in a given state of a scenario and move it to a new state.
+	Scenario *Scenario `protobuf:"bytes,7,opt,name=scenario,proto3,oneof" json:"scenario,omitempty"`
+	// priority decides between several matching expectations, the highest one wins. defaults to 0.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// name is an optional name of the expectation, unique among the expectations, which it can be upserted by.
+	Name string `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	// labels group the expectation, e.g. per test suite, to select it with a label selector.
+	// Keys and values follow the syntax of Kubernetes labels.
+	Labels        map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpectationRequest) Reset() {
+	*x = CreateExpectationRequest{}
+	mi := &file_mockserver_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpectationRequest) ProtoMessage() {}
+
+func (x *CreateExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpectationRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateExpectationRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *CreateExpectationRequest) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *CreateExpectationRequest) GetTimes() *Times {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *CreateExpectationRequest) GetTimeToLiveSeconds() float32 {
+	if x != nil && x.TimeToLiveSeconds != nil {
+		return *x.TimeToLiveSeconds
+	}
+	return 0
+}
+
+func (x *CreateExpectationRequest) GetDelay() *Delay {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *CreateExpectationRequest) GetAction() Action {
+	if x != nil {
+		return x.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (x *CreateExpectationRequest) GetScenario() *Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *CreateExpectationRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateExpectationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExpectationRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Expectation represents an expectation for an incoming request.
+type Expectation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is a unique identifier for the expectation.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// request is the expected request details.
+	Request *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// response is a response that should be returned when the expectation is met.
+	Response *Response `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// times is a number of times the expectation should be met
+	Times *Times `protobuf:"bytes,4,opt,name=times,proto3" json:"times,omitempty"`
+	// expires_at is a time when the expectation expires
+	ExpiresAt *string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// created_at is a time when the expectation was created
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// delay is a delay before the reply is published.
+	Delay *Delay `protobuf:"bytes,7,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+	// action is what is done with a matched request.
+	Action Action `protobuf:"varint,8,opt,name=action,proto3,enum=rmqrpc.mockserver.api.v1.Action" json:"action,omitempty"`
+	// source is where the expectation comes from, e.g. "file:payments.yaml", empty if created through the API.
+	Source string `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	// scenario is the scenario the expectation is part of.
+	Scenario *Scenario `protobuf:"bytes,10,opt,name=scenario,proto3,oneof" json:"scenario,omitempty"`
+	// priority decides between several matching expectations, the highest one wins.
+	Priority int32 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// name is the name of the expectation, empty if it has none.
+	Name string `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	// time_to_live_seconds is the time to live of the expectation in seconds, not set if it lives forever.
+	TimeToLiveSeconds *float32 `protobuf:"fixed32,13,opt,name=time_to_live_seconds,json=timeToLiveSeconds,proto3,oneof" json:"time_to_live_seconds,omitempty"`
+	// session_id is the session owning the expectation, not set if it has none.
+	SessionId *string `protobuf:"bytes,14,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	// labels are the labels of the expectation.
+	Labels        map[string]string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expectation) Reset() {
+	*x = Expectation{}
+	mi := &file_mockserver_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expectation) ProtoMessage() {}
+
+func (x *Expectation) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expectation.ProtoReflect.Descriptor instead.
+func (*Expectation) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Expectation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Expectation) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Expectation) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *Expectation) GetTimes() *Times {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *Expectation) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Expectation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Expectation) GetDelay() *Delay {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *Expectation) GetAction() Action {
+	if x != nil {
+		return x.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (x *Expectation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Expectation) GetScenario() *Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+func (x *Expectation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Expectation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Expectation) GetTimeToLiveSeconds() float32 {
+	if x != nil && x.TimeToLiveSeconds != nil {
+		return *x.TimeToLiveSeconds
+	}
+	return 0
+}
+
+func (x *Expectation) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *Expectation) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Assertion represents an assertion for an incoming request.
+type Assertion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is a unique identifier for the assertion.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// candidate is a candidate request sent to the mockserver.
+	Candidate *Assertion_Candidate `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// matched is a boolean indicating if the assertion matched an expectation.
+	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// expectation is a matched expectation.
+	// have value if the assertion is matched and "included" in request is set to embed expectation
+	Expectation *Expectation `protobuf:"bytes,4,opt,name=expectation,proto3,oneof" json:"expectation,omitempty"`
+	// created_at is a time when the assertion was created.
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// proxied is a boolean indicating if the unmatched request was forwarded to the real service.
+	Proxied bool `protobuf:"varint,6,opt,name=proxied,proto3" json:"proxied,omitempty"`
+	// proxy is the outcome of forwarding the request, set if the assertion is proxied.
+	Proxy *Assertion_ProxyResult `protobuf:"bytes,7,opt,name=proxy,proto3,oneof" json:"proxy,omitempty"`
+	// reply is the message published back to the caller, not set if no reply was published.
+	Reply *Assertion_Reply `protobuf:"bytes,8,opt,name=reply,proto3,oneof" json:"reply,omitempty"`
+	// near_misses are the closest expectations and why they did not match, set if the request matched none.
+	NearMisses    []*Assertion_NearMiss `protobuf:"bytes,9,rep,name=near_misses,json=nearMisses,proto3" json:"near_misses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assertion) Reset() {
+	*x = Assertion{}
+	mi := &file_mockserver_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assertion) ProtoMessage() {}
+
+func (x *Assertion) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
+func (*Assertion) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Assertion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assertion) GetCandidate() *Assertion_Candidate {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *Assertion) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *Assertion) GetExpectation() *Expectation {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
+}
+
+func (x *Assertion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Assertion) GetProxied() bool {
+	if x != nil {
+		return x.Proxied
+	}
+	return false
+}
+
+func (x *Assertion) GetProxy() *Assertion_ProxyResult {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *Assertion) GetReply() *Assertion_Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Assertion) GetNearMisses() []*Assertion_NearMiss {
+	if x != nil {
+		return x.NearMisses
+	}
+	return nil
+}
+
+// GetAssertionsRequest is used to retrieve history of assertions.
+// The filters are combined, unset filters match any assertion.
+type GetAssertionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id will return only assertions for the given expectation.
+	ExpectationId *string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
+	// status will return only assertions with the given status. by default it returns all assertions.
+	Status *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"` // matched, unmatched, proxied
+	// include will return embedded entities related to the assertion.
+	Include []string `protobuf:"bytes,3,rep,name=include,proto3" json:"include,omitempty"` // expectation
+	// exchange will return only requests published to the given exchange.
+	Exchange *string `protobuf:"bytes,4,opt,name=exchange,proto3,oneof" json:"exchange,omitempty"`
+	// routing_key will return only requests with the given routing key.
+	RoutingKey *string `protobuf:"bytes,5,opt,name=routing_key,json=routingKey,proto3,oneof" json:"routing_key,omitempty"`
+	// queue will return only requests consumed from the given queue.
+	Queue *string `protobuf:"bytes,6,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+	// headers will return only requests with all the given header values.
+	Headers map[string]string `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// body_contains will return only requests whose raw body contains the given text.
+	BodyContains *string `protobuf:"bytes,8,opt,name=body_contains,json=bodyContains,proto3,oneof" json:"body_contains,omitempty"`
+	// since will return only assertions created at or after the given RFC 3339 time.
+	Since *string `protobuf:"bytes,9,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// until will return only assertions created before the given RFC 3339 time.
+	Until *string `protobuf:"bytes,10,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// newest_first returns the latest assertions first instead of in arrival order.
+	NewestFirst bool `protobuf:"varint,11,opt,name=newest_first,json=newestFirst,proto3" json:"newest_first,omitempty"`
+	// page_size is the maximum number of assertions returned, all of them if 0.
+	PageSize uint32 `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page.
+	PageToken *string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// label_selector will return only assertions whose expectation has labels matching the Kubernetes-style selector,
+	// e.g. "suite=checkout,owner in (payments,orders)". Unmatched requests have no labels.
+	LabelSelector *string `protobuf:"bytes,14,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionsRequest) Reset() {
+	*x = GetAssertionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionsRequest) ProtoMessage() {}
+
+func (x *GetAssertionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssertionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAssertionsRequest) GetExpectationId() string {
+	if x != nil && x.ExpectationId != nil {
+		return *x.ExpectationId
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetAssertionsRequest) GetExchange() string {
+	if x != nil && x.Exchange != nil {
+		return *x.Exchange
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetRoutingKey() string {
+	if x != nil && x.RoutingKey != nil {
+		return *x.RoutingKey
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *GetAssertionsRequest) GetBodyContains() string {
+	if x != nil && x.BodyContains != nil {
+		return *x.BodyContains
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetSince() string {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetUntil() string {
+	if x != nil && x.Until != nil {
+		return *x.Until
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetNewestFirst() bool {
+	if x != nil {
+		return x.NewestFirst
+	}
+	return false
+}
+
+func (x *GetAssertionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAssertionsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAssertionsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+// GetAssertionsResponse contains a list of assertions.
+type GetAssertionsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Assertions []*Assertion           `protobuf:"bytes,1,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	// next_page_token fetches the next page, empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionsResponse) Reset() {
+	*x = GetAssertionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionsResponse) ProtoMessage() {}
+
+func (x *GetAssertionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAssertionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAssertionsResponse) GetAssertions() []*Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *GetAssertionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetAssertionRequest is used to retrieve a single assertion.
+type GetAssertionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssertionId   string                 `protobuf:"bytes,1,opt,name=assertion_id,json=assertionId,proto3" json:"assertion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionRequest) Reset() {
+	*x = GetAssertionRequest{}
+	mi := &file_mockserver_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionRequest) ProtoMessage() {}
+
+func (x *GetAssertionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionRequest.ProtoReflect.Descriptor instead.
+func (*GetAssertionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAssertionRequest) GetAssertionId() string {
+	if x != nil {
+		return x.AssertionId
+	}
+	return ""
+}
+
+// GetAssertionResponse contains the assertion, along with the matched expectation if any.
+type GetAssertionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assertion     *Assertion             `protobuf:"bytes,1,opt,name=assertion,proto3" json:"assertion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionResponse) Reset() {
+	*x = GetAssertionResponse{}
+	mi := &file_mockserver_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionResponse) ProtoMessage() {}
+
+func (x *GetAssertionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionResponse.ProtoReflect.Descriptor instead.
+func (*GetAssertionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAssertionResponse) GetAssertion() *Assertion {
+	if x != nil {
+		return x.Assertion
+	}
+	return nil
+}
+
+// WaitForAssertionsRequest is used to wait for assertions matching a filter, unset filter fields match anything.
+type WaitForAssertionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id waits for assertions of the given expectation.
+	ExpectationId *string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
+	// status waits for assertions with the given status.
+	Status *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"` // matched, unmatched, proxied
+	// exchange waits for requests published to the given exchange.
+	Exchange *string `protobuf:"bytes,3,opt,name=exchange,proto3,oneof" json:"exchange,omitempty"`
+	// routing_key waits for requests with the given routing key.
+	RoutingKey *string `protobuf:"bytes,4,opt,name=routing_key,json=routingKey,proto3,oneof" json:"routing_key,omitempty"`
+	// body waits for requests with a matching body.
+	//
+	// Types that are valid to be assigned to Body:
+	//
+	//	*WaitForAssertionsRequest_JsonBody
+	//	*WaitForAssertionsRequest_RegexBody
+	Body isWaitForAssertionsRequest_Body `protobuf_oneof:"body"`
+	// count is the number of assertions to wait for, defaults to 1.
+	Count uint32 `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	// timeout_seconds is how long to wait at most, defaults to 10 seconds.
+	TimeoutSeconds *float32 `protobuf:"fixed32,8,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// include will return embedded entities related to the assertions.
+	Include []string `protobuf:"bytes,9,rep,name=include,proto3" json:"include,omitempty"` // expectation
+	// label_selector waits only for assertions whose expectation has labels matching the selector.
+	LabelSelector *string `protobuf:"bytes,10,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForAssertionsRequest) Reset() {
+	*x = WaitForAssertionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForAssertionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForAssertionsRequest) ProtoMessage() {}
+
+func (x *WaitForAssertionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForAssertionsRequest.ProtoReflect.Descriptor instead.
+func (*WaitForAssertionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WaitForAssertionsRequest) GetExpectationId() string {
+	if x != nil && x.ExpectationId != nil {
+		return *x.ExpectationId
+	}
+	return ""
+}
+
+func (x *WaitForAssertionsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *WaitForAssertionsRequest) GetExchange() string {
+	if x != nil && x.Exchange != nil {
+		return *x.Exchange
+	}
+	return ""
+}
+
+func (x *WaitForAssertionsRequest) GetRoutingKey() string {
+	if x != nil && x.RoutingKey != nil {
+		return *x.RoutingKey
+	}
+	return ""
+}
+
+func (x *WaitForAssertionsRequest) GetBody() isWaitForAssertionsRequest_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *WaitForAssertionsRequest) GetJsonBody() *JSONBodyAssertion {
+	if x != nil {
+		if x, ok := x.Body.(*WaitForAssertionsRequest_JsonBody); ok {
+			return x.JsonBody
+		}
+	}
+	return nil
+}
+
+func (x *WaitForAssertionsRequest) GetRegexBody() *RegexBodyAssertion {
+	if x != nil {
+		if x, ok := x.Body.(*WaitForAssertionsRequest_RegexBody); ok {
+			return x.RegexBody
+		}
+	}
+	return nil
+}
+
+func (x *WaitForAssertionsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WaitForAssertionsRequest) GetTimeoutSeconds() float32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *WaitForAssertionsRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *WaitForAssertionsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+type isWaitForAssertionsRequest_Body interface {
+	isWaitForAssertionsRequest_Body()
+}
+
+type WaitForAssertionsRequest_JsonBody struct {
+	JsonBody *JSONBodyAssertion `protobuf:"bytes,5,opt,name=json_body,json=jsonBody,proto3,oneof"`
+}
+
+type WaitForAssertionsRequest_RegexBody struct {
+	RegexBody *RegexBodyAssertion `protobuf:"bytes,6,opt,name=regex_body,json=regexBody,proto3,oneof"`
+}
+
+func (*WaitForAssertionsRequest_JsonBody) isWaitForAssertionsRequest_Body() {}
+
+func (*WaitForAssertionsRequest_RegexBody) isWaitForAssertionsRequest_Body() {}
+
+// WaitForAssertionsResponse contains the assertions matching the filter.
+type WaitForAssertionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// satisfied is false if the timeout elapsed before enough assertions were recorded.
+	Satisfied bool `protobuf:"varint,1,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	// assertions are the assertions matching the filter, the ones recorded so far if not satisfied.
+	Assertions    []*Assertion `protobuf:"bytes,2,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForAssertionsResponse) Reset() {
+	*x = WaitForAssertionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForAssertionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForAssertionsResponse) ProtoMessage() {}
+
+func (x *WaitForAssertionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForAssertionsResponse.ProtoReflect.Descriptor instead.
+func (*WaitForAssertionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WaitForAssertionsResponse) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+func (x *WaitForAssertionsResponse) GetAssertions() []*Assertion {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+// ResetAssertionsRequest is used to clear the history of assertions.
+type ResetAssertionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetAssertionsRequest) Reset() {
+	*x = ResetAssertionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetAssertionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetAssertionsRequest) ProtoMessage() {}
+
+func (x *ResetAssertionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetAssertionsRequest.ProtoReflect.Descriptor instead.
+func (*ResetAssertionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{29}
+}
+
+// ResetAssertionsResponse is returned after the history of assertions is cleared.
+type ResetAssertionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetAssertionsResponse) Reset() {
+	*x = ResetAssertionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetAssertionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetAssertionsResponse) ProtoMessage() {}
+
+func (x *ResetAssertionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetAssertionsResponse.ProtoReflect.Descriptor instead.
+func (*ResetAssertionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{30}
+}
+
+// GetAssertionsStatsRequest is used to retrieve the statistics of the history of assertions.
+type GetAssertionsStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionsStatsRequest) Reset() {
+	*x = GetAssertionsStatsRequest{}
+	mi := &file_mockserver_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionsStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionsStatsRequest) ProtoMessage() {}
+
+func (x *GetAssertionsStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionsStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssertionsStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{31}
+}
+
+// GetAssertionsStatsResponse contains the statistics of the history of assertions.
+type GetAssertionsStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// count is the number of assertions in the history.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// evicted is the total number of assertions evicted because of the retention limits since the start.
+	Evicted uint64 `protobuf:"varint,2,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	// max_count is the number of assertions kept, 0 if unlimited.
+	MaxCount uint64 `protobuf:"varint,3,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// max_age_seconds is how long assertions are kept, 0 if unlimited.
+	MaxAgeSeconds float32 `protobuf:"fixed32,4,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssertionsStatsResponse) Reset() {
+	*x = GetAssertionsStatsResponse{}
+	mi := &file_mockserver_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssertionsStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssertionsStatsResponse) ProtoMessage() {}
+
+func (x *GetAssertionsStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssertionsStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAssertionsStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAssertionsStatsResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetAssertionsStatsResponse) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+func (x *GetAssertionsStatsResponse) GetMaxCount() uint64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *GetAssertionsStatsResponse) GetMaxAgeSeconds() float32 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+// WatchEventsRequest is used to watch events matching a filter, unset filter fields match any event.
+type WatchEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// types watches only the events of the given types.
+	Types []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=rmqrpc.mockserver.api.v1.EventType" json:"types,omitempty"`
+	// expectation_id watches only the events of the given expectation.
+	ExpectationId *string `protobuf:"bytes,2,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
+	// exchange watches only the events of requests and expectations on the given exchange.
+	Exchange *string `protobuf:"bytes,3,opt,name=exchange,proto3,oneof" json:"exchange,omitempty"`
+	// routing_key watches only the events of requests and expectations with the given routing key.
+	RoutingKey *string `protobuf:"bytes,4,opt,name=routing_key,json=routingKey,proto3,oneof" json:"routing_key,omitempty"`
+	// queue watches only the events of subscriptions to the given queue.
+	Queue         *string `protobuf:"bytes,5,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_mockserver_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetExpectationId() string {
+	if x != nil && x.ExpectationId != nil {
+		return *x.ExpectationId
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetExchange() string {
+	if x != nil && x.Exchange != nil {
+		return *x.Exchange
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetRoutingKey() string {
+	if x != nil && x.RoutingKey != nil {
+		return *x.RoutingKey
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+// Event represents something that happened in the mockserver.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the kind of the event.
+	Type EventType `protobuf:"varint,1,opt,name=type,proto3,enum=rmqrpc.mockserver.api.v1.EventType" json:"type,omitempty"`
+	// created_at is the time the event happened.
+	CreatedAt string `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expectation_id is set for expectation events and matched requests.
+	ExpectationId *string `protobuf:"bytes,3,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
+	// exchange is the one of the request, or of the expectation for expectation events.
+	Exchange string `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// routing_key is the one of the request, or of the expectation for expectation events.
+	RoutingKey string `protobuf:"bytes,5,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// candidate is the received request, set for request events.
+	Candidate *Assertion_Candidate `protobuf:"bytes,6,opt,name=candidate,proto3,oneof" json:"candidate,omitempty"`
+	// subscription_id is set for subscription events.
+	SubscriptionId *string `protobuf:"bytes,7,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	// queue is set for subscription events.
+	Queue *string `protobuf:"bytes,8,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+	// namespace is the namespace the event happened in, empty for the default one.
+	Namespace     string `protobuf:"bytes,9,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_mockserver_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Event) GetExpectationId() string {
+	if x != nil && x.ExpectationId != nil {
+		return *x.ExpectationId
+	}
+	return ""
+}
+
+func (x *Event) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Event) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *Event) GetCandidate() *Assertion_Candidate {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *Event) GetSubscriptionId() string {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Event) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *Event) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// VerifyExpectationsRequest is used to verify the requests received so far.
+type VerifyExpectationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// verifications are checked independently of each other.
+	Verifications []*Verification `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyExpectationsRequest) Reset() {
+	*x = VerifyExpectationsRequest{}
+	mi := &file_mockserver_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyExpectationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyExpectationsRequest) ProtoMessage() {}
+
+func (x *VerifyExpectationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyExpectationsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyExpectationsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyExpectationsRequest) GetVerifications() []*Verification {
+	if x != nil {
+		return x.Verifications
+	}
+	return nil
+}
+
+// Verification checks the number of times an expectation was matched, or requests matching a request spec arrived.
+type Verification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Verification_ExpectationId
+	//	*Verification_Request
+	Target isVerification_Target `protobuf_oneof:"target"`
+	// times are the expected bounds, if not set the requests are expected at least once.
+	Times         *VerificationTimes `protobuf:"bytes,3,opt,name=times,proto3" json:"times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Verification) Reset() {
+	*x = Verification{}
+	mi := &file_mockserver_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Verification) ProtoMessage() {}
+
+func (x *Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
+func (*Verification) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Verification) GetTarget() isVerification_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Verification) GetExpectationId() string {
+	if x != nil {
+		if x, ok := x.Target.(*Verification_ExpectationId); ok {
+			return x.ExpectationId
+		}
+	}
+	return ""
+}
+
+func (x *Verification) GetRequest() *Request {
+	if x != nil {
+		if x, ok := x.Target.(*Verification_Request); ok {
+			return x.Request
+		}
+	}
+	return nil
+}
+
+func (x *Verification) GetTimes() *VerificationTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type isVerification_Target interface {
+	isVerification_Target()
+}
+
+type Verification_ExpectationId struct {
+	// expectation_id counts the requests matched by the expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3,oneof"`
+}
+
+type Verification_Request struct {
+	// request counts the requests matching the spec, with the same semantics as the request of an expectation.
+	Request *Request `protobuf:"bytes,2,opt,name=request,proto3,oneof"`
+}
+
+func (*Verification_ExpectationId) isVerification_Target() {}
+
+func (*Verification_Request) isVerification_Target() {}
+
+// VerificationTimes are the bounds of a verification, exactly cannot be combined with the other ones.
+// Use exactly 0 to verify that something never happened.
+type VerificationTimes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exactly       *uint32                `protobuf:"varint,1,opt,name=exactly,proto3,oneof" json:"exactly,omitempty"`
+	AtLeast       *uint32                `protobuf:"varint,2,opt,name=at_least,json=atLeast,proto3,oneof" json:"at_least,omitempty"`
+	AtMost        *uint32                `protobuf:"varint,3,opt,name=at_most,json=atMost,proto3,oneof" json:"at_most,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationTimes) Reset() {
+	*x = VerificationTimes{}
+	mi := &file_mockserver_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationTimes) ProtoMessage() {}
+
+func (x *VerificationTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationTimes.ProtoReflect.Descriptor instead.
+func (*VerificationTimes) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VerificationTimes) GetExactly() uint32 {
+	if x != nil && x.Exactly != nil {
+		return *x.Exactly
+	}
+	return 0
+}
+
+func (x *VerificationTimes) GetAtLeast() uint32 {
+	if x != nil && x.AtLeast != nil {
+		return *x.AtLeast
+	}
+	return 0
+}
+
+func (x *VerificationTimes) GetAtMost() uint32 {
+	if x != nil && x.AtMost != nil {
+		return *x.AtMost
+	}
+	return 0
+}
+
+// VerifyExpectationsResponse contains the outcome of the verifications.
+type VerifyExpectationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passed is true if all verifications passed.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// results are the outcomes of the verifications, in the order of the request.
+	Results []*VerificationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// report describes the failed verifications, empty if all passed.
+	Report        string `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyExpectationsResponse) Reset() {
+	*x = VerifyExpectationsResponse{}
+	mi := &file_mockserver_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyExpectationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyExpectationsResponse) ProtoMessage() {}
+
+func (x *VerifyExpectationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyExpectationsResponse.ProtoReflect.Descriptor instead.
+func (*VerifyExpectationsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VerifyExpectationsResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *VerifyExpectationsResponse) GetResults() []*VerificationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *VerifyExpectationsResponse) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+// VerificationResult is the outcome of a single verification.
+type VerificationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passed is true if the count is within the bounds.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// count is the number of matching requests.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// report is a human-readable description of the outcome, listing the closest actual requests on failure.
+	Report        string `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationResult) Reset() {
+	*x = VerificationResult{}
+	mi := &file_mockserver_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationResult) ProtoMessage() {}
+
+func (x *VerificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationResult.ProtoReflect.Descriptor instead.
+func (*VerificationResult) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerificationResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *VerificationResult) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VerificationResult) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+// VerifySequenceRequest is used to verify the order of the requests received so far.
+type VerifySequenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// steps are the request specs in the expected order, with the same semantics as the request of an expectation.
+	Steps []*Request `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// mode is how strictly the steps must follow each other.
+	Mode          SequenceMode `protobuf:"varint,2,opt,name=mode,proto3,enum=rmqrpc.mockserver.api.v1.SequenceMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySequenceRequest) Reset() {
+	*x = VerifySequenceRequest{}
+	mi := &file_mockserver_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySequenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySequenceRequest) ProtoMessage() {}
+
+func (x *VerifySequenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySequenceRequest.ProtoReflect.Descriptor instead.
+func (*VerifySequenceRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifySequenceRequest) GetSteps() []*Request {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *VerifySequenceRequest) GetMode() SequenceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SequenceMode_SEQUENCE_MODE_UNSPECIFIED
+}
+
+// VerifySequenceResponse contains the outcome of the sequence verification.
+type VerifySequenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passed is true if the steps were received in order.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// report is a human-readable description of the outcome.
+	Report string `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	// actual_sequence are all received requests in arrival order, set if the verification failed.
+	ActualSequence []*VerifySequenceResponse_SequenceEntry `protobuf:"bytes,3,rep,name=actual_sequence,json=actualSequence,proto3" json:"actual_sequence,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifySequenceResponse) Reset() {
+	*x = VerifySequenceResponse{}
+	mi := &file_mockserver_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySequenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySequenceResponse) ProtoMessage() {}
+
+func (x *VerifySequenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySequenceResponse.ProtoReflect.Descriptor instead.
+func (*VerifySequenceResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VerifySequenceResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *VerifySequenceResponse) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *VerifySequenceResponse) GetActualSequence() []*VerifySequenceResponse_SequenceEntry {
+	if x != nil {
+		return x.ActualSequence
+	}
+	return nil
+}
+
+// SimulateMatchRequest describes a request to match against the expectations.
+type SimulateMatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// exchange is the exchange the request would be published to.
+	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// routing_key is the routing key the request would be published with.
+	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// body is the JSON body of the request.
+	Body *structpb.Value `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// raw_body is the body of the request as is, for bodies that are not JSON. It takes precedence over body.
+	RawBody *string `protobuf:"bytes,4,opt,name=raw_body,json=rawBody,proto3,oneof" json:"raw_body,omitempty"`
+	// headers are the message headers of the request.
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// properties are the message properties of the request.
+	Properties    *MessageProperties `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateMatchRequest) Reset() {
+	*x = SimulateMatchRequest{}
+	mi := &file_mockserver_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateMatchRequest) ProtoMessage() {}
+
+func (x *SimulateMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateMatchRequest.ProtoReflect.Descriptor instead.
+func (*SimulateMatchRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SimulateMatchRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SimulateMatchRequest) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *SimulateMatchRequest) GetBody() *structpb.Value {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *SimulateMatchRequest) GetRawBody() string {
+	if x != nil && x.RawBody != nil {
+		return *x.RawBody
+	}
+	return ""
+}
+
+func (x *SimulateMatchRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *SimulateMatchRequest) GetProperties() *MessageProperties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// SimulateMatchResponse tells which expectations the request would match.
+type SimulateMatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// matched is true if the request would match an expectation.
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// expectation is the expectation that would win, set if matched.
+	Expectation *Expectation `protobuf:"bytes,2,opt,name=expectation,proto3,oneof" json:"expectation,omitempty"`
+	// matches are all the expectations matching the request in priority order, the first one wins.
+	Matches []*Expectation `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
+	// skipped are the expectations whose request matches, but which cannot match at the moment.
+	Skipped       []*SimulateMatchResponse_SkippedExpectation `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateMatchResponse) Reset() {
+	*x = SimulateMatchResponse{}
+	mi := &file_mockserver_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateMatchResponse) ProtoMessage() {}
+
+func (x *SimulateMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateMatchResponse.ProtoReflect.Descriptor instead.
+func (*SimulateMatchResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SimulateMatchResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *SimulateMatchResponse) GetExpectation() *Expectation {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
+}
+
+func (x *SimulateMatchResponse) GetMatches() []*Expectation {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SimulateMatchResponse) GetSkipped() []*SimulateMatchResponse_SkippedExpectation {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// GetExpectationsRequest is used to retrieve all expectations.
+type GetExpectationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status will return only expectations with the given status. by default it returns all expectations.
+	Status *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"` // active, expired
+	// label_selector will return only expectations with labels matching the Kubernetes-style selector,
+	// e.g. "suite=checkout,owner in (payments,orders)".
+	LabelSelector *string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpectationsRequest) Reset() {
+	*x = GetExpectationsRequest{}
+	mi := &file_mockserver_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpectationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpectationsRequest) ProtoMessage() {}
+
+func (x *GetExpectationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpectationsRequest.ProtoReflect.Descriptor instead.
+func (*GetExpectationsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetExpectationsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *GetExpectationsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+// GetExpectationsResponse contains a list of expectations.
+type GetExpectationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expectations  []*Expectation         `protobuf:"bytes,1,rep,name=expectations,proto3" json:"expectations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpectationsResponse) Reset() {
+	*x = GetExpectationsResponse{}
+	mi := &file_mockserver_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpectationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpectationsResponse) ProtoMessage() {}
+
+func (x *GetExpectationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpectationsResponse.ProtoReflect.Descriptor instead.
+func (*GetExpectationsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetExpectationsResponse) GetExpectations() []*Expectation {
+	if x != nil {
+		return x.Expectations
+	}
+	return nil
+}
+
+// GetExpectationRequest is used to retrieve a specific expectation by its ID.
+type GetExpectationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is a unique identifier for the expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpectationRequest) Reset() {
+	*x = GetExpectationRequest{}
+	mi := &file_mockserver_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpectationRequest) ProtoMessage() {}
+
+func (x *GetExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpectationRequest.ProtoReflect.Descriptor instead.
+func (*GetExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetExpectationRequest) GetExpectationId() string {
+	if x != nil {
+		return x.ExpectationId
+	}
+	return ""
+}
+
+// GetExpectationResponse contains the requested expectation.
+type GetExpectationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expectation   *Expectation           `protobuf:"bytes,1,opt,name=expectation,proto3" json:"expectation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpectationResponse) Reset() {
+	*x = GetExpectationResponse{}
+	mi := &file_mockserver_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpectationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpectationResponse) ProtoMessage() {}
+
+func (x *GetExpectationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpectationResponse.ProtoReflect.Descriptor instead.
+func (*GetExpectationResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetExpectationResponse) GetExpectation() *Expectation {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
+}
+
+// CreateExpectationResponse is a response to CreateExpectationRequest
+type CreateExpectationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is a unique identifier for the created expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpectationResponse) Reset() {
+	*x = CreateExpectationResponse{}
+	mi := &file_mockserver_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpectationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpectationResponse) ProtoMessage() {}
+
+func (x *CreateExpectationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpectationResponse.ProtoReflect.Descriptor instead.
+func (*CreateExpectationResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateExpectationResponse) GetExpectationId() string {
+	if x != nil {
+		return x.ExpectationId
+	}
+	return ""
+}
+
+// UpdateExpectationRequest is used to replace the definition of an expectation.
+type UpdateExpectationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is a unique identifier for the expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	// expectation is the new definition of the expectation.
+	Expectation   *CreateExpectationRequest `protobuf:"bytes,2,opt,name=expectation,proto3" json:"expectation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpectationRequest) Reset() {
+	*x = UpdateExpectationRequest{}
+	mi := &file_mockserver_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpectationRequest) ProtoMessage() {}
+
+func (x *UpdateExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpectationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateExpectationRequest) GetExpectationId() string {
+	if x != nil {
+		return x.ExpectationId
+	}
+	return ""
+}
+
+func (x *UpdateExpectationRequest) GetExpectation() *CreateExpectationRequest {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
+}
+
+// UpdateExpectationResponse is returned after the expectation is successfully updated.
+type UpdateExpectationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpectationResponse) Reset() {
+	*x = UpdateExpectationResponse{}
+	mi := &file_mockserver_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpectationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpectationResponse) ProtoMessage() {}
+
+func (x *UpdateExpectationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpectationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpectationResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{50}
+}
+
+// UpsertExpectationRequest is used to replace or create an expectation by its ID or name.
+type UpsertExpectationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is the client-supplied identifier (a UUID) of the expectation.
+	// if it is not set, the expectation is upserted by its name.
+	ExpectationId *string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
+	// expectation is the definition of the expectation.
+	Expectation   *CreateExpectationRequest `protobuf:"bytes,2,opt,name=expectation,proto3" json:"expectation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertExpectationRequest) Reset() {
+	*x = UpsertExpectationRequest{}
+	mi := &file_mockserver_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertExpectationRequest) ProtoMessage() {}
+
+func (x *UpsertExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertExpectationRequest.ProtoReflect.Descriptor instead.
+func (*UpsertExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpsertExpectationRequest) GetExpectationId() string {
+	if x != nil && x.ExpectationId != nil {
+		return *x.ExpectationId
+	}
+	return ""
+}
+
+func (x *UpsertExpectationRequest) GetExpectation() *CreateExpectationRequest {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
+}
+
+// UpsertExpectationResponse is a response to UpsertExpectationRequest.
+type UpsertExpectationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is a unique identifier for the upserted expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	// created is true if the expectation did not exist before.
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertExpectationResponse) Reset() {
+	*x = UpsertExpectationResponse{}
+	mi := &file_mockserver_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertExpectationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertExpectationResponse) ProtoMessage() {}
+
+func (x *UpsertExpectationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertExpectationResponse.ProtoReflect.Descriptor instead.
+func (*UpsertExpectationResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpsertExpectationResponse) GetExpectationId() string {
+	if x != nil {
+		return x.ExpectationId
+	}
+	return ""
+}
+
+func (x *UpsertExpectationResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// DeleteExpectationRequest is used to remove a single expectation.
+type DeleteExpectationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expectation_id is a unique identifier for the expectation.
+	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpectationRequest) Reset() {
+	*x = DeleteExpectationRequest{}
+	mi := &file_mockserver_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpectationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpectationRequest) ProtoMessage() {}
+
+func (x *DeleteExpectationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpectationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpectationRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteExpectationRequest) GetExpectationId() string {
+	if x != nil {
+		return x.ExpectationId
+	}
+	return ""
+}
+
+// DeleteExpectationResponse is returned after the expectation is successfully removed.
+type DeleteExpectationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpectationResponse) Reset() {
+	*x = DeleteExpectationResponse{}
+	mi := &file_mockserver_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpectationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpectationResponse) ProtoMessage() {}
+
+func (x *DeleteExpectationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpectationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpectationResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{54}
+}
+
+// ResetExpectationsRequest is used to reset all expectations, or the ones selected by their labels.
+type ResetExpectationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// label_selector removes only the expectations with labels matching the Kubernetes-style selector.
+	LabelSelector *string `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetExpectationsRequest) Reset() {
+	*x = ResetExpectationsRequest{}
+	mi := &file_mockserver_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetExpectationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetExpectationsRequest) ProtoMessage() {}
+
+func (x *ResetExpectationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetExpectationsRequest.ProtoReflect.Descriptor instead.
+func (*ResetExpectationsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResetExpectationsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+// ResetExpectationsResponse is returned after all expectations are successfully reset.
+type ResetExpectationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetExpectationsResponse) Reset() {
+	*x = ResetExpectationsResponse{}
+	mi := &file_mockserver_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetExpectationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetExpectationsResponse) ProtoMessage() {}
+
+func (x *ResetExpectationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetExpectationsResponse.ProtoReflect.Descriptor instead.
+func (*ResetExpectationsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{56}
+}
+
+// GetDefaultResponseRequest is used to retrieve the default response.
+type GetDefaultResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefaultResponseRequest) Reset() {
+	*x = GetDefaultResponseRequest{}
+	mi := &file_mockserver_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefaultResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultResponseRequest) ProtoMessage() {}
+
+func (x *GetDefaultResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultResponseRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultResponseRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{57}
+}
+
+// GetDefaultResponseResponse contains the response published for unmatched requests.
+type GetDefaultResponseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDefaultResponseResponse) Reset() {
+	*x = GetDefaultResponseResponse{}
+	mi := &file_mockserver_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDefaultResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultResponseResponse) ProtoMessage() {}
+
+func (x *GetDefaultResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultResponseResponse.ProtoReflect.Descriptor instead.
+func (*GetDefaultResponseResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetDefaultResponseResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// SetDefaultResponseRequest is used to replace the default response.
+type SetDefaultResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      *Response              `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultResponseRequest) Reset() {
+	*x = SetDefaultResponseRequest{}
+	mi := &file_mockserver_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultResponseRequest) ProtoMessage() {}
+
+func (x *SetDefaultResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultResponseRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultResponseRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetDefaultResponseRequest) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// SetDefaultResponseResponse is returned after the default response is successfully replaced.
+type SetDefaultResponseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultResponseResponse) Reset() {
+	*x = SetDefaultResponseResponse{}
+	mi := &file_mockserver_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultResponseResponse) ProtoMessage() {}
+
+func (x *SetDefaultResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultResponseResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultResponseResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{60}
+}
+
+// ResetDefaultResponseRequest is used to restore the built-in default response.
+type ResetDefaultResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDefaultResponseRequest) Reset() {
+	*x = ResetDefaultResponseRequest{}
+	mi := &file_mockserver_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDefaultResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDefaultResponseRequest) ProtoMessage() {}
+
+func (x *ResetDefaultResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDefaultResponseRequest.ProtoReflect.Descriptor instead.
+func (*ResetDefaultResponseRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{61}
+}
+
+// ResetDefaultResponseResponse is returned after the default response is successfully restored.
+type ResetDefaultResponseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDefaultResponseResponse) Reset() {
+	*x = ResetDefaultResponseResponse{}
+	mi := &file_mockserver_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDefaultResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDefaultResponseResponse) ProtoMessage() {}
+
+func (x *ResetDefaultResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDefaultResponseResponse.ProtoReflect.Descriptor instead.
+func (*ResetDefaultResponseResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{62}
+}
+
+// StartRecordingRequest is used to turn the record mode on.
+type StartRecordingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target is where requests are forwarded to, if not set the global PROXY_EXCHANGE and PROXY_ROUTING_KEY are used.
+	Target        *ProxyTarget `protobuf:"bytes,1,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRecordingRequest) Reset() {
+	*x = StartRecordingRequest{}
+	mi := &file_mockserver_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingRequest) ProtoMessage() {}
+
+func (x *StartRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StartRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StartRecordingRequest) GetTarget() *ProxyTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+// StartRecordingResponse is returned after the record mode is turned on.
+type StartRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRecordingResponse) Reset() {
+	*x = StartRecordingResponse{}
+	mi := &file_mockserver_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingResponse) ProtoMessage() {}
+
+func (x *StartRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StartRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{64}
+}
+
+// StopRecordingRequest is used to turn the record mode off.
+type StopRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRecordingRequest) Reset() {
+	*x = StopRecordingRequest{}
+	mi := &file_mockserver_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRecordingRequest) ProtoMessage() {}
+
+func (x *StopRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StopRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{65}
+}
+
+// StopRecordingResponse is returned after the record mode is turned off.
+type StopRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRecordingResponse) Reset() {
+	*x = StopRecordingResponse{}
+	mi := &file_mockserver_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRecordingResponse) ProtoMessage() {}
+
+func (x *StopRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StopRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{66}
+}
+
+// GetRecordingsRequest is used to export the recorded expectations.
+type GetRecordingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecordingsRequest) Reset() {
+	*x = GetRecordingsRequest{}
+	mi := &file_mockserver_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordingsRequest) ProtoMessage() {}
+
+func (x *GetRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{67}
+}
+
+// GetRecordingsResponse contains the recorded expectations in the order the requests were received.
+type GetRecordingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recording indicates if the record mode is on.
+	Recording bool `protobuf:"varint,1,opt,name=recording,proto3" json:"recording,omitempty"`
+	// expectations can be passed to CreateExpectation as they are.
+	Expectations  []*CreateExpectationRequest `protobuf:"bytes,2,rep,name=expectations,proto3" json:"expectations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecordingsResponse) Reset() {
+	*x = GetRecordingsResponse{}
+	mi := &file_mockserver_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordingsResponse) ProtoMessage() {}
+
+func (x *GetRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetRecordingsResponse) GetRecording() bool {
+	if x != nil {
+		return x.Recording
+	}
+	return false
+}
+
+func (x *GetRecordingsResponse) GetExpectations() []*CreateExpectationRequest {
+	if x != nil {
+		return x.Expectations
+	}
+	return nil
+}
+
+// ResetRecordingsRequest is used to remove all recorded expectations.
+type ResetRecordingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRecordingsRequest) Reset() {
+	*x = ResetRecordingsRequest{}
+	mi := &file_mockserver_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRecordingsRequest) ProtoMessage() {}
+
+func (x *ResetRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*ResetRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{69}
+}
+
+// ResetRecordingsResponse is returned after the recordings are removed.
+type ResetRecordingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetRecordingsResponse) Reset() {
+	*x = ResetRecordingsResponse{}
+	mi := &file_mockserver_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetRecordingsResponse) ProtoMessage() {}
+
+func (x *ResetRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ResetRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{70}
+}
+
+// GetScenariosRequest is used to retrieve the current state of all scenarios.
+type GetScenariosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScenariosRequest) Reset() {
+	*x = GetScenariosRequest{}
+	mi := &file_mockserver_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScenariosRequest) ProtoMessage() {}
+
+func (x *GetScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScenariosRequest.ProtoReflect.Descriptor instead.
+func (*GetScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{71}
+}
+
+// GetScenariosResponse contains the scenarios sorted by name.
+type GetScenariosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenarios     []*ScenarioState       `protobuf:"bytes,1,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScenariosResponse) Reset() {
+	*x = GetScenariosResponse{}
+	mi := &file_mockserver_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (JSONBodyAssertion_MatchType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mockserver_proto_enumTypes[0].Descriptor()
+func (x *GetScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (JSONBodyAssertion_MatchType) Type() protoreflect.EnumType {
-	return &file_mockserver_proto_enumTypes[0]
+func (*GetScenariosResponse) ProtoMessage() {}
+
+func (x *GetScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x JSONBodyAssertion_MatchType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use GetScenariosResponse.ProtoReflect.Descriptor instead.
+func (*GetScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{72}
 }
 
-// Deprecated: Use JSONBodyAssertion_MatchType.Descriptor instead.
-func (JSONBodyAssertion_MatchType) EnumDescriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{9, 0}
+func (x *GetScenariosResponse) GetScenarios() []*ScenarioState {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
 }
 
-type Subscription struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Queue         string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+// GetScenarioRequest is used to retrieve the current state of a scenario.
+type GetScenarioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the scenario.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Subscription) Reset() {
-	*x = Subscription{}
-	mi := &file_mockserver_proto_msgTypes[0]
+func (x *GetScenarioRequest) Reset() {
+	*x = GetScenarioRequest{}
+	mi := &file_mockserver_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Subscription) String() string {
+func (x *GetScenarioRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscription) ProtoMessage() {}
+func (*GetScenarioRequest) ProtoMessage() {}
 
-func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[0]
+func (x *GetScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,52 +5029,89 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
-func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetScenarioRequest.ProtoReflect.Descriptor instead.
+func (*GetScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Subscription) GetId() string {
+func (x *GetScenarioRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Subscription) GetQueue() string {
+// GetScenarioResponse contains the current state of the scenario.
+type GetScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenario      *ScenarioState         `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScenarioResponse) Reset() {
+	*x = GetScenarioResponse{}
+	mi := &file_mockserver_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScenarioResponse) ProtoMessage() {}
+
+func (x *GetScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[74]
 	if x != nil {
-		return x.Queue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// AddSubscriptionRequest is a request to add a subscription to a queue
-type AddSubscriptionRequest struct {
+// Deprecated: Use GetScenarioResponse.ProtoReflect.Descriptor instead.
+func (*GetScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetScenarioResponse) GetScenario() *ScenarioState {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+// SetScenarioStateRequest is used to force a scenario into a state.
+type SetScenarioStateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// queue is the name of the queue to subscribe to.
-	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	// idempotent indicates if the subscription should be idempotent.
-	// If true, adding a subscription with the same queue name multiple times will result in only one subscription.
-	Idempotent    bool `protobuf:"varint,2,opt,name=idempotent,proto3" json:"idempotent,omitempty"`
+	// name is the name of the scenario.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// state is the state to move the scenario to.
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSubscriptionRequest) Reset() {
-	*x = AddSubscriptionRequest{}
-	mi := &file_mockserver_proto_msgTypes[1]
+func (x *SetScenarioStateRequest) Reset() {
+	*x = SetScenarioStateRequest{}
+	mi := &file_mockserver_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSubscriptionRequest) String() string {
+func (x *SetScenarioStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubscriptionRequest) ProtoMessage() {}
+func (*SetScenarioStateRequest) ProtoMessage() {}
 
-func (x *AddSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[1]
+func (x *SetScenarioStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,48 +5122,47 @@ func (x *AddSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*AddSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SetScenarioStateRequest.ProtoReflect.Descriptor instead.
+func (*SetScenarioStateRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AddSubscriptionRequest) GetQueue() string {
+func (x *SetScenarioStateRequest) GetName() string {
 	if x != nil {
-		return x.Queue
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddSubscriptionRequest) GetIdempotent() bool {
+func (x *SetScenarioStateRequest) GetState() string {
 	if x != nil {
-		return x.Idempotent
+		return x.State
 	}
-	return false
+	return ""
 }
 
-// AddSubscriptionResponse returns the newly created subscription.
-type AddSubscriptionResponse struct {
+// SetScenarioStateResponse is returned after the scenario is moved to the state.
+type SetScenarioStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subscription  *Subscription          `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSubscriptionResponse) Reset() {
-	*x = AddSubscriptionResponse{}
-	mi := &file_mockserver_proto_msgTypes[2]
+func (x *SetScenarioStateResponse) Reset() {
+	*x = SetScenarioStateResponse{}
+	mi := &file_mockserver_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSubscriptionResponse) String() string {
+func (x *SetScenarioStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSubscriptionResponse) ProtoMessage() {}
+func (*SetScenarioStateResponse) ProtoMessage() {}
 
-func (x *AddSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[2]
+func (x *SetScenarioStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,41 +5173,79 @@ func (x *AddSubscriptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*AddSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SetScenarioStateResponse.ProtoReflect.Descriptor instead.
+func (*SetScenarioStateResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AddSubscriptionResponse) GetSubscription() *Subscription {
+// ResetScenarioRequest is used to move a scenario back to its initial state.
+type ResetScenarioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the scenario.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetScenarioRequest) Reset() {
+	*x = ResetScenarioRequest{}
+	mi := &file_mockserver_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetScenarioRequest) ProtoMessage() {}
+
+func (x *ResetScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[77]
 	if x != nil {
-		return x.Subscription
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// DeleteSubscriptionRequest is a request to remove a subscription from a queue.
-type DeleteSubscriptionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use ResetScenarioRequest.ProtoReflect.Descriptor instead.
+func (*ResetScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DeleteSubscriptionRequest) Reset() {
-	*x = DeleteSubscriptionRequest{}
-	mi := &file_mockserver_proto_msgTypes[3]
+func (x *ResetScenarioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ResetScenarioResponse is returned after the scenario is reset.
+type ResetScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetScenarioResponse) Reset() {
+	*x = ResetScenarioResponse{}
+	mi := &file_mockserver_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSubscriptionRequest) String() string {
+func (x *ResetScenarioResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscriptionRequest) ProtoMessage() {}
+func (*ResetScenarioResponse) ProtoMessage() {}
 
-func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[3]
+func (x *ResetScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,40 +5256,70 @@ func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ResetScenarioResponse.ProtoReflect.Descriptor instead.
+func (*ResetScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteSubscriptionRequest) GetSubscriptionId() string {
+// ResetScenariosRequest is used to move all scenarios back to their initial state.
+type ResetScenariosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetScenariosRequest) Reset() {
+	*x = ResetScenariosRequest{}
+	mi := &file_mockserver_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetScenariosRequest) ProtoMessage() {}
+
+func (x *ResetScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[79]
 	if x != nil {
-		return x.SubscriptionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// DeleteSubscriptionResponse is returned after a subscription is successfully removed.
-type DeleteSubscriptionResponse struct {
+// Deprecated: Use ResetScenariosRequest.ProtoReflect.Descriptor instead.
+func (*ResetScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{79}
+}
+
+// ResetScenariosResponse is returned after all scenarios are reset.
+type ResetScenariosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSubscriptionResponse) Reset() {
-	*x = DeleteSubscriptionResponse{}
-	mi := &file_mockserver_proto_msgTypes[4]
+func (x *ResetScenariosResponse) Reset() {
+	*x = ResetScenariosResponse{}
+	mi := &file_mockserver_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSubscriptionResponse) String() string {
+func (x *ResetScenariosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscriptionResponse) ProtoMessage() {}
+func (*ResetScenariosResponse) ProtoMessage() {}
 
-func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[4]
+func (x *ResetScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,34 +5330,109 @@ func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ResetScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ResetScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{80}
 }
 
-// UnsubscribeFromQueueRequest is used to remove all subscriptions from a specified queue.
-type UnsubscribeFromQueueRequest struct {
+// ResetSubscriptionsRequest is used to reset all subscriptions.
+type ResetSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSubscriptionsRequest) Reset() {
+	*x = ResetSubscriptionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ResetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ResetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{81}
+}
+
+// ResetSubscriptionsResponse is returned after all subscriptions are successfully reset.
+type ResetSubscriptionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsubscribeFromQueueRequest) Reset() {
-	*x = UnsubscribeFromQueueRequest{}
-	mi := &file_mockserver_proto_msgTypes[5]
+func (x *ResetSubscriptionsResponse) Reset() {
+	*x = ResetSubscriptionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ResetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ResetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{82}
+}
+
+// ResetAllRequest is used to reset both expectations and subscriptions.
+type ResetAllRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// clear_assertions clears the history of assertions as well.
+	ClearAssertions bool `protobuf:"varint,1,opt,name=clear_assertions,json=clearAssertions,proto3" json:"clear_assertions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResetAllRequest) Reset() {
+	*x = ResetAllRequest{}
+	mi := &file_mockserver_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsubscribeFromQueueRequest) String() string {
+func (x *ResetAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeFromQueueRequest) ProtoMessage() {}
+func (*ResetAllRequest) ProtoMessage() {}
 
-func (x *UnsubscribeFromQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[5]
+func (x *ResetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,40 +5443,40 @@ func (x *UnsubscribeFromQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeFromQueueRequest.ProtoReflect.Descriptor instead.
-func (*UnsubscribeFromQueueRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ResetAllRequest.ProtoReflect.Descriptor instead.
+func (*ResetAllRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *UnsubscribeFromQueueRequest) GetQueue() string {
+func (x *ResetAllRequest) GetClearAssertions() bool {
 	if x != nil {
-		return x.Queue
+		return x.ClearAssertions
 	}
-	return ""
+	return false
 }
 
-// UnsubscribeFromQueueResponse confirms the successful removal of subscriptions from the queue.
-type UnsubscribeFromQueueResponse struct {
+// ResetAllResponse is returned after both expectations and subscriptions are successfully reset.
+type ResetAllResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsubscribeFromQueueResponse) Reset() {
-	*x = UnsubscribeFromQueueResponse{}
-	mi := &file_mockserver_proto_msgTypes[6]
+func (x *ResetAllResponse) Reset() {
+	*x = ResetAllResponse{}
+	mi := &file_mockserver_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsubscribeFromQueueResponse) String() string {
+func (x *ResetAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeFromQueueResponse) ProtoMessage() {}
+func (*ResetAllResponse) ProtoMessage() {}
 
-func (x *UnsubscribeFromQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[6]
+func (x *ResetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,33 +5487,33 @@ func (x *UnsubscribeFromQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeFromQueueResponse.ProtoReflect.Descriptor instead.
-func (*UnsubscribeFromQueueResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ResetAllResponse.ProtoReflect.Descriptor instead.
+func (*ResetAllResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{84}
 }
 
-// GetAllSubscriptionsRequest is used to retrieve all active subscriptions.
-type GetAllSubscriptionsRequest struct {
+// ListNamespacesRequest is used to list the namespaces.
+type ListNamespacesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllSubscriptionsRequest) Reset() {
-	*x = GetAllSubscriptionsRequest{}
-	mi := &file_mockserver_proto_msgTypes[7]
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_mockserver_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllSubscriptionsRequest) String() string {
+func (x *ListNamespacesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllSubscriptionsRequest) ProtoMessage() {}
+func (*ListNamespacesRequest) ProtoMessage() {}
 
-func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[7]
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,34 +5524,34 @@ func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{85}
 }
 
-// GetAllSubscriptionsResponse contains a list of active subscriptions.
-type GetAllSubscriptionsResponse struct {
+// ListNamespacesResponse contains the names of the namespaces, sorted.
+type ListNamespacesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Namespaces    []string               `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllSubscriptionsResponse) Reset() {
-	*x = GetAllSubscriptionsResponse{}
-	mi := &file_mockserver_proto_msgTypes[8]
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_mockserver_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllSubscriptionsResponse) String() string {
+func (x *ListNamespacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllSubscriptionsResponse) ProtoMessage() {}
+func (*ListNamespacesResponse) ProtoMessage() {}
 
-func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[8]
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,44 +5562,41 @@ func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetAllSubscriptionsResponse) GetSubscriptions() []*Subscription {
+func (x *ListNamespacesResponse) GetNamespaces() []string {
 	if x != nil {
-		return x.Subscriptions
+		return x.Namespaces
 	}
 	return nil
 }
 
-// JSONBodyAssertion is used to specify matching rules for JSON body content.
-type JSONBodyAssertion struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The JSON structure to be matched.
-	Body *structpb.Struct `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
-	// Type of matching for the JSON body.
-	MatchType     JSONBodyAssertion_MatchType `protobuf:"varint,2,opt,name=match_type,json=matchType,proto3,enum=rmqrpc.mockserver.api.v1.JSONBodyAssertion_MatchType" json:"match_type,omitempty"`
+// DeleteNamespaceRequest is used to remove a namespace.
+type DeleteNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JSONBodyAssertion) Reset() {
-	*x = JSONBodyAssertion{}
-	mi := &file_mockserver_proto_msgTypes[9]
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_mockserver_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JSONBodyAssertion) String() string {
+func (x *DeleteNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JSONBodyAssertion) ProtoMessage() {}
+func (*DeleteNamespaceRequest) ProtoMessage() {}
 
-func (x *JSONBodyAssertion) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[9]
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,49 +5607,40 @@ func (x *JSONBodyAssertion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JSONBodyAssertion.ProtoReflect.Descriptor instead.
-func (*JSONBodyAssertion) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *JSONBodyAssertion) GetBody() *structpb.Struct {
-	if x != nil {
-		return x.Body
-	}
-	return nil
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *JSONBodyAssertion) GetMatchType() JSONBodyAssertion_MatchType {
+func (x *DeleteNamespaceRequest) GetNamespace() string {
 	if x != nil {
-		return x.MatchType
+		return x.Namespace
 	}
-	return JSONBodyAssertion_MATCH_TYPE_UNSPECIFIED
+	return ""
 }
 
-// RegexBodyAssertion is used to specify matching rules using regular expressions.
-type RegexBodyAssertion struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// regex is the pattern that should match the body of the request.
-	Regex         string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`
+// DeleteNamespaceResponse is returned after the namespace is removed.
+type DeleteNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegexBodyAssertion) Reset() {
-	*x = RegexBodyAssertion{}
-	mi := &file_mockserver_proto_msgTypes[10]
+func (x *DeleteNamespaceResponse) Reset() {
+	*x = DeleteNamespaceResponse{}
+	mi := &file_mockserver_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegexBodyAssertion) String() string {
+func (x *DeleteNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegexBodyAssertion) ProtoMessage() {}
+func (*DeleteNamespaceResponse) ProtoMessage() {}
 
-func (x *RegexBodyAssertion) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[10]
+func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,49 +5651,41 @@ func (x *RegexBodyAssertion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegexBodyAssertion.ProtoReflect.Descriptor instead.
-func (*RegexBodyAssertion) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *RegexBodyAssertion) GetRegex() string {
-	if x != nil {
-		return x.Regex
-	}
-	return ""
+// Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{88}
 }
 
-// Request represents an incoming request that the mockserver should expect.
-type Request struct {
+// Session owns the expectations and subscriptions created under it.
+type Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The exchange the message is sent to.
-	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	// The routing key the message is sent with.
-	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
-	// Types that are valid to be assigned to Body:
-	//
-	//	*Request_JsonBody
-	//	*Request_RegexBody
-	Body          isRequest_Body `protobuf_oneof:"body"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// namespace is the namespace the session was created in, empty for the default one.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// lease_seconds is how long the session lives without a heartbeat.
+	LeaseSeconds float32 `protobuf:"fixed32,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+	CreatedAt    string  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is when the lease expires, unless a heartbeat renews it before.
+	ExpiresAt     string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
-	mi := &file_mockserver_proto_msgTypes[11]
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_mockserver_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Request) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *Request) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[11]
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,92 +5696,70 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *Request) GetExchange() string {
+func (x *Session) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Request) GetRoutingKey() string {
+func (x *Session) GetNamespace() string {
 	if x != nil {
-		return x.RoutingKey
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *Request) GetBody() isRequest_Body {
+func (x *Session) GetLeaseSeconds() float32 {
 	if x != nil {
-		return x.Body
+		return x.LeaseSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *Request) GetJsonBody() *JSONBodyAssertion {
+func (x *Session) GetCreatedAt() string {
 	if x != nil {
-		if x, ok := x.Body.(*Request_JsonBody); ok {
-			return x.JsonBody
-		}
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Request) GetRegexBody() *RegexBodyAssertion {
+func (x *Session) GetExpiresAt() string {
 	if x != nil {
-		if x, ok := x.Body.(*Request_RegexBody); ok {
-			return x.RegexBody
-		}
+		return x.ExpiresAt
 	}
-	return nil
-}
-
-type isRequest_Body interface {
-	isRequest_Body()
-}
-
-type Request_JsonBody struct {
-	// Match the JSON body of the request.
-	JsonBody *JSONBodyAssertion `protobuf:"bytes,3,opt,name=json_body,json=jsonBody,proto3,oneof"`
-}
-
-type Request_RegexBody struct {
-	// Match the body of the request using a regular expression.
-	RegexBody *RegexBodyAssertion `protobuf:"bytes,4,opt,name=regex_body,json=regexBody,proto3,oneof"`
+	return ""
 }
 
-func (*Request_JsonBody) isRequest_Body() {}
-
-func (*Request_RegexBody) isRequest_Body() {}
-
-// Response represents a response that the mockserver should return when the expectation is met.
-type Response struct {
+// CreateSessionRequest is used to start a session.
+type CreateSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The response body to be returned.
-	Body          *structpb.Value `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// lease_seconds is how long the session lives without a heartbeat, defaults to 30 seconds.
+	LeaseSeconds  *float32 `protobuf:"fixed32,1,opt,name=lease_seconds,json=leaseSeconds,proto3,oneof" json:"lease_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
-	mi := &file_mockserver_proto_msgTypes[12]
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_mockserver_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Response) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[12]
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,45 +5770,41 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Response) GetBody() *structpb.Value {
-	if x != nil {
-		return x.Body
+func (x *CreateSessionRequest) GetLeaseSeconds() float32 {
+	if x != nil && x.LeaseSeconds != nil {
+		return *x.LeaseSeconds
 	}
-	return nil
+	return 0
 }
 
-// Times represents the number of times an expectation should/can be met
-type Times struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Times:
-	//
-	//	*Times_RemainingTimes
-	//	*Times_Unlimited
-	Times         isTimes_Times `protobuf_oneof:"times"`
+// CreateSessionResponse returns the newly created session.
+type CreateSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Times) Reset() {
-	*x = Times{}
-	mi := &file_mockserver_proto_msgTypes[13]
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
+	mi := &file_mockserver_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Times) String() string {
+func (x *CreateSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Times) ProtoMessage() {}
+func (*CreateSessionResponse) ProtoMessage() {}
 
-func (x *Times) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[13]
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,86 +5815,40 @@ func (x *Times) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Times.ProtoReflect.Descriptor instead.
-func (*Times) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Times) GetTimes() isTimes_Times {
-	if x != nil {
-		return x.Times
-	}
-	return nil
-}
-
-func (x *Times) GetRemainingTimes() uint32 {
-	if x != nil {
-		if x, ok := x.Times.(*Times_RemainingTimes); ok {
-			return x.RemainingTimes
-		}
-	}
-	return 0
-}
-
-func (x *Times) GetUnlimited() bool {
-	if x != nil {
-		if x, ok := x.Times.(*Times_Unlimited); ok {
-			return x.Unlimited
-		}
-	}
-	return false
-}
-
-type isTimes_Times interface {
-	isTimes_Times()
-}
-
-type Times_RemainingTimes struct {
-	// remaining_times is the number of times the expectation can be met.
-	RemainingTimes uint32 `protobuf:"varint,1,opt,name=remaining_times,json=remainingTimes,proto3,oneof"`
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{91}
 }
 
-type Times_Unlimited struct {
-	// unlimited indicates that the expectation can be met an unlimited number of times.
-	Unlimited bool `protobuf:"varint,2,opt,name=unlimited,proto3,oneof"`
+func (x *CreateSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
 }
 
-func (*Times_RemainingTimes) isTimes_Times() {}
-
-func (*Times_Unlimited) isTimes_Times() {}
-
-// CreateExpectationRequest is used to define an expectation for an incoming request.
-type CreateExpectationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// request is the expected request details.
-	Request *Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	// response is a response that should be returned when the expectation is met.
-	Response *Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	// times is a number of times the expectation should be met
-	// if is not set, the expectation is set to be met once
-	Times *Times `protobuf:"bytes,3,opt,name=times,proto3,oneof" json:"times,omitempty"`
-	// time_to_live_seconds is a time to live for the expectation in seconds
-	// if time_to_live_seconds is not set, then the expectation is set to be live forever
-	TimeToLiveSeconds *float32 `protobuf:"fixed32,4,opt,name=time_to_live_seconds,json=timeToLiveSeconds,proto3,oneof" json:"time_to_live_seconds,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// GetSessionsRequest is used to list the open sessions.
+type GetSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpectationRequest) Reset() {
-	*x = CreateExpectationRequest{}
-	mi := &file_mockserver_proto_msgTypes[14]
+func (x *GetSessionsRequest) Reset() {
+	*x = GetSessionsRequest{}
+	mi := &file_mockserver_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpectationRequest) String() string {
+func (x *GetSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpectationRequest) ProtoMessage() {}
+func (*GetSessionsRequest) ProtoMessage() {}
 
-func (x *CreateExpectationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[14]
+func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,73 +5859,79 @@ func (x *CreateExpectationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpectationRequest.ProtoReflect.Descriptor instead.
-func (*CreateExpectationRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *CreateExpectationRequest) GetRequest() *Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
+// GetSessionsResponse contains the open sessions, oldest first.
+type GetSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpectationRequest) GetResponse() *Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+func (x *GetSessionsResponse) Reset() {
+	*x = GetSessionsResponse{}
+	mi := &file_mockserver_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpectationRequest) GetTimes() *Times {
+func (x *GetSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsResponse) ProtoMessage() {}
+
+func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[93]
 	if x != nil {
-		return x.Times
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateExpectationRequest) GetTimeToLiveSeconds() float32 {
-	if x != nil && x.TimeToLiveSeconds != nil {
-		return *x.TimeToLiveSeconds
+// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
 	}
-	return 0
+	return nil
 }
 
-// Expectation represents an expectation for an incoming request.
-type Expectation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is a unique identifier for the expectation.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// request is the expected request details.
-	Request *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
-	// response is a response that should be returned when the expectation is met.
-	Response *Response `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-	// times is a number of times the expectation should be met
-	Times *Times `protobuf:"bytes,4,opt,name=times,proto3" json:"times,omitempty"`
-	// expires_at is a time when the expectation expires
-	ExpiresAt *string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
-	// created_at is a time when the expectation was created
-	CreatedAt     string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// GetSessionRequest is used to retrieve an open session.
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Expectation) Reset() {
-	*x = Expectation{}
-	mi := &file_mockserver_proto_msgTypes[15]
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_mockserver_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expectation) String() string {
+func (x *GetSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expectation) ProtoMessage() {}
+func (*GetSessionRequest) ProtoMessage() {}
 
-func (x *Expectation) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[15]
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,86 +5942,86 @@ func (x *Expectation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expectation.ProtoReflect.Descriptor instead.
-func (*Expectation) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *Expectation) GetId() string {
+func (x *GetSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *Expectation) GetRequest() *Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
+// GetSessionResponse contains the session.
+type GetSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Expectation) GetResponse() *Response {
-	if x != nil {
-		return x.Response
-	}
-	return nil
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_mockserver_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expectation) GetTimes() *Times {
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[95]
 	if x != nil {
-		return x.Times
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Expectation) GetExpiresAt() string {
-	if x != nil && x.ExpiresAt != nil {
-		return *x.ExpiresAt
-	}
-	return ""
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *Expectation) GetCreatedAt() string {
+func (x *GetSessionResponse) GetSession() *Session {
 	if x != nil {
-		return x.CreatedAt
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-// Assertion represents an assertion for an incoming request.
-type Assertion struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is a unique identifier for the assertion.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// candidate is a candidate request sent to the mockserver.
-	Candidate *Assertion_Candidate `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
-	// matched is a boolean indicating if the assertion matched an expectation.
-	Matched bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
-	// expectation is a matched expectation.
-	// have value if the assertion is matched and "included" in request is set to embed expectation
-	Expectation *Expectation `protobuf:"bytes,4,opt,name=expectation,proto3,oneof" json:"expectation,omitempty"`
-	// created_at is a time when the assertion was created.
-	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// HeartbeatSessionRequest is used to renew the lease of a session.
+type HeartbeatSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Assertion) Reset() {
-	*x = Assertion{}
-	mi := &file_mockserver_proto_msgTypes[16]
+func (x *HeartbeatSessionRequest) Reset() {
+	*x = HeartbeatSessionRequest{}
+	mi := &file_mockserver_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Assertion) String() string {
+func (x *HeartbeatSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assertion) ProtoMessage() {}
+func (*HeartbeatSessionRequest) ProtoMessage() {}
 
-func (x *Assertion) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[16]
+func (x *HeartbeatSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,74 +6032,86 @@ func (x *Assertion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assertion.ProtoReflect.Descriptor instead.
-func (*Assertion) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use HeartbeatSessionRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *Assertion) GetId() string {
+func (x *HeartbeatSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Id
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *Assertion) GetCandidate() *Assertion_Candidate {
-	if x != nil {
-		return x.Candidate
-	}
-	return nil
+// HeartbeatSessionResponse contains the session with its renewed lease.
+type HeartbeatSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *Session               `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Assertion) GetMatched() bool {
-	if x != nil {
-		return x.Matched
-	}
-	return false
+func (x *HeartbeatSessionResponse) Reset() {
+	*x = HeartbeatSessionResponse{}
+	mi := &file_mockserver_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Assertion) GetExpectation() *Expectation {
+func (x *HeartbeatSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatSessionResponse) ProtoMessage() {}
+
+func (x *HeartbeatSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[97]
 	if x != nil {
-		return x.Expectation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Assertion) GetCreatedAt() string {
+// Deprecated: Use HeartbeatSessionResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *HeartbeatSessionResponse) GetSession() *Session {
 	if x != nil {
-		return x.CreatedAt
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-// GetAssertionsRequest is used to retrieve history of assertions.
-type GetAssertionsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// expectation_id will return only assertions for the given expectation.
-	ExpectationId *string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3,oneof" json:"expectation_id,omitempty"`
-	// status will return only assertions with the given status. by default it returns all assertions.
-	Status *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"` // matched, unmatched
-	// include will return embedded entities related to the assertion.
-	Include       []string `protobuf:"bytes,3,rep,name=include,proto3" json:"include,omitempty"` // expectation
+// CloseSessionRequest is used to end a session.
+type CloseSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAssertionsRequest) Reset() {
-	*x = GetAssertionsRequest{}
-	mi := &file_mockserver_proto_msgTypes[17]
+func (x *CloseSessionRequest) Reset() {
+	*x = CloseSessionRequest{}
+	mi := &file_mockserver_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAssertionsRequest) String() string {
+func (x *CloseSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAssertionsRequest) ProtoMessage() {}
+func (*CloseSessionRequest) ProtoMessage() {}
 
-func (x *GetAssertionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[17]
+func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,55 +6122,77 @@ func (x *GetAssertionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAssertionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAssertionsRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CloseSessionRequest.ProtoReflect.Descriptor instead.
+func (*CloseSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetAssertionsRequest) GetExpectationId() string {
-	if x != nil && x.ExpectationId != nil {
-		return *x.ExpectationId
+func (x *CloseSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetAssertionsRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ""
+// CloseSessionResponse is returned after the session ended and what it owned was removed.
+type CloseSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAssertionsRequest) GetInclude() []string {
+func (x *CloseSessionResponse) Reset() {
+	*x = CloseSessionResponse{}
+	mi := &file_mockserver_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSessionResponse) ProtoMessage() {}
+
+func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[99]
 	if x != nil {
-		return x.Include
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// GetAssertionsResponse contains a list of assertions.
-type GetAssertionsResponse struct {
+// Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{99}
+}
+
+// GetVersionRequest is used to retrieve the version information of the mockserver application.
+type GetVersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assertions    []*Assertion           `protobuf:"bytes,1,rep,name=assertions,proto3" json:"assertions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *GetAssertionsResponse) Reset() {
-	*x = GetAssertionsResponse{}
-	mi := &file_mockserver_proto_msgTypes[18]
+
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	mi := &file_mockserver_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAssertionsResponse) String() string {
+func (x *GetVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAssertionsResponse) ProtoMessage() {}
+func (*GetVersionRequest) ProtoMessage() {}
 
-func (x *GetAssertionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[18]
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,42 +6203,39 @@ func (x *GetAssertionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAssertionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAssertionsResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetAssertionsResponse) GetAssertions() []*Assertion {
-	if x != nil {
-		return x.Assertions
-	}
-	return nil
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{100}
 }
 
-// GetExpectationsRequest is used to retrieve all expectations.
-type GetExpectationsRequest struct {
+// GetVersionResponse contains the version information of the mockserver application.
+type GetVersionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status will return only expectations with the given status. by default it returns all expectations.
-	Status        *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"` // active, expired
+	// version is the semantic version of the mockserver application.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// commit_hash is the commit hash of the mockserver application.
+	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// build_date is the date the mockserver application was built.
+	BuildDate     string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpectationsRequest) Reset() {
-	*x = GetExpectationsRequest{}
-	mi := &file_mockserver_proto_msgTypes[19]
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	mi := &file_mockserver_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpectationsRequest) String() string {
+func (x *GetVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpectationsRequest) ProtoMessage() {}
+func (*GetVersionResponse) ProtoMessage() {}
 
-func (x *GetExpectationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[19]
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,41 +6246,56 @@ func (x *GetExpectationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpectationsRequest.ProtoReflect.Descriptor instead.
-func (*GetExpectationsRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetExpectationsRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *GetVersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-// GetExpectationsResponse contains a list of expectations.
-type GetExpectationsResponse struct {
+func (x *GetVersionResponse) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+// UniformDelay is a delay uniformly distributed in the [min_ms, max_ms] range.
+type Delay_UniformDelay struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expectations  []*Expectation         `protobuf:"bytes,1,rep,name=expectations,proto3" json:"expectations,omitempty"`
+	MinMs         uint32                 `protobuf:"varint,1,opt,name=min_ms,json=minMs,proto3" json:"min_ms,omitempty"`
+	MaxMs         uint32                 `protobuf:"varint,2,opt,name=max_ms,json=maxMs,proto3" json:"max_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpectationsResponse) Reset() {
-	*x = GetExpectationsResponse{}
-	mi := &file_mockserver_proto_msgTypes[20]
+func (x *Delay_UniformDelay) Reset() {
+	*x = Delay_UniformDelay{}
+	mi := &file_mockserver_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpectationsResponse) String() string {
+func (x *Delay_UniformDelay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpectationsResponse) ProtoMessage() {}
+func (*Delay_UniformDelay) ProtoMessage() {}
 
-func (x *GetExpectationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[20]
+func (x *Delay_UniformDelay) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,42 +6306,51 @@ func (x *GetExpectationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpectationsResponse.ProtoReflect.Descriptor instead.
-func (*GetExpectationsResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Delay_UniformDelay.ProtoReflect.Descriptor instead.
+func (*Delay_UniformDelay) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *GetExpectationsResponse) GetExpectations() []*Expectation {
+func (x *Delay_UniformDelay) GetMinMs() uint32 {
 	if x != nil {
-		return x.Expectations
+		return x.MinMs
 	}
-	return nil
+	return 0
 }
 
-// GetExpectationRequest is used to retrieve a specific expectation by its ID.
-type GetExpectationRequest struct {
+func (x *Delay_UniformDelay) GetMaxMs() uint32 {
+	if x != nil {
+		return x.MaxMs
+	}
+	return 0
+}
+
+// LogNormalDelay is a delay following a log-normal distribution, which approximates real latencies with a long tail.
+type Delay_LogNormalDelay struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// expectation_id is a unique identifier for the expectation.
-	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	// median_ms is the median of the distribution in milliseconds.
+	MedianMs uint32 `protobuf:"varint,1,opt,name=median_ms,json=medianMs,proto3" json:"median_ms,omitempty"`
+	// sigma is the standard deviation of the underlying normal distribution, 0 means no variation.
+	Sigma         float64 `protobuf:"fixed64,2,opt,name=sigma,proto3" json:"sigma,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpectationRequest) Reset() {
-	*x = GetExpectationRequest{}
-	mi := &file_mockserver_proto_msgTypes[21]
+func (x *Delay_LogNormalDelay) Reset() {
+	*x = Delay_LogNormalDelay{}
+	mi := &file_mockserver_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpectationRequest) String() string {
+func (x *Delay_LogNormalDelay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpectationRequest) ProtoMessage() {}
+func (*Delay_LogNormalDelay) ProtoMessage() {}
 
-func (x *GetExpectationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[21]
+func (x *Delay_LogNormalDelay) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,41 +6361,55 @@ func (x *GetExpectationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpectationRequest.ProtoReflect.Descriptor instead.
-func (*GetExpectationRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Delay_LogNormalDelay.ProtoReflect.Descriptor instead.
+func (*Delay_LogNormalDelay) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{17, 1}
 }
 
-func (x *GetExpectationRequest) GetExpectationId() string {
+func (x *Delay_LogNormalDelay) GetMedianMs() uint32 {
 	if x != nil {
-		return x.ExpectationId
+		return x.MedianMs
 	}
-	return ""
+	return 0
 }
 
-// GetExpectationResponse contains the requested expectation.
-type GetExpectationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expectation   *Expectation           `protobuf:"bytes,1,opt,name=expectation,proto3" json:"expectation,omitempty"`
+func (x *Delay_LogNormalDelay) GetSigma() float64 {
+	if x != nil {
+		return x.Sigma
+	}
+	return 0
+}
+
+// ProxyResult represents a round trip to the real service.
+type Assertion_ProxyResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exchange the request was forwarded to.
+	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// The routing key the request was forwarded with.
+	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// The reply of the real service, empty if the call failed.
+	Response string `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// The reason the call failed, empty on success.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpectationResponse) Reset() {
-	*x = GetExpectationResponse{}
-	mi := &file_mockserver_proto_msgTypes[22]
+func (x *Assertion_ProxyResult) Reset() {
+	*x = Assertion_ProxyResult{}
+	mi := &file_mockserver_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpectationResponse) String() string {
+func (x *Assertion_ProxyResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpectationResponse) ProtoMessage() {}
+func (*Assertion_ProxyResult) ProtoMessage() {}
 
-func (x *GetExpectationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[22]
+func (x *Assertion_ProxyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,42 +6420,71 @@ func (x *GetExpectationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpectationResponse.ProtoReflect.Descriptor instead.
-func (*GetExpectationResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Assertion_ProxyResult.ProtoReflect.Descriptor instead.
+func (*Assertion_ProxyResult) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *GetExpectationResponse) GetExpectation() *Expectation {
+func (x *Assertion_ProxyResult) GetExchange() string {
 	if x != nil {
-		return x.Expectation
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-// CreateExpectationResponse is a response to CreateExpectationRequest
-type CreateExpectationResponse struct {
+func (x *Assertion_ProxyResult) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
+}
+
+func (x *Assertion_ProxyResult) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Assertion_ProxyResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// NearMiss explains why an expectation close to the request did not match it.
+// An expectation is close if it expects the same exchange and routing key, or the same body.
+type Assertion_NearMiss struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// expectation_id is a unique identifier for the created expectation.
+	// The ID of the expectation.
 	ExpectationId string `protobuf:"bytes,1,opt,name=expectation_id,json=expectationId,proto3" json:"expectation_id,omitempty"`
+	// Why the expectation did not match: exchange, routing key, header <name>, property <name>, body,
+	// expired, times exhausted or scenario state.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// The JSON patch turning the expected body into the received one, set if a JSON body did not match.
+	BodyDiff string `protobuf:"bytes,3,opt,name=body_diff,json=bodyDiff,proto3" json:"body_diff,omitempty"`
+	// The expectation at the time of the request, set if "include" in request is set to embed expectation.
+	Expectation   *Expectation `protobuf:"bytes,4,opt,name=expectation,proto3,oneof" json:"expectation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpectationResponse) Reset() {
-	*x = CreateExpectationResponse{}
-	mi := &file_mockserver_proto_msgTypes[23]
+func (x *Assertion_NearMiss) Reset() {
+	*x = Assertion_NearMiss{}
+	mi := &file_mockserver_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpectationResponse) String() string {
+func (x *Assertion_NearMiss) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpectationResponse) ProtoMessage() {}
+func (*Assertion_NearMiss) ProtoMessage() {}
 
-func (x *CreateExpectationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[23]
+func (x *Assertion_NearMiss) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,77 +6495,75 @@ func (x *CreateExpectationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpectationResponse.ProtoReflect.Descriptor instead.
-func (*CreateExpectationResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Assertion_NearMiss.ProtoReflect.Descriptor instead.
+func (*Assertion_NearMiss) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22, 1}
 }
 
-func (x *CreateExpectationResponse) GetExpectationId() string {
+func (x *Assertion_NearMiss) GetExpectationId() string {
 	if x != nil {
 		return x.ExpectationId
 	}
 	return ""
 }
 
-// ResetExpectationsRequest is used to reset all expectations.
-type ResetExpectationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResetExpectationsRequest) Reset() {
-	*x = ResetExpectationsRequest{}
-	mi := &file_mockserver_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ResetExpectationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assertion_NearMiss) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
 }
 
-func (*ResetExpectationsRequest) ProtoMessage() {}
-
-func (x *ResetExpectationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[24]
+func (x *Assertion_NearMiss) GetBodyDiff() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BodyDiff
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ResetExpectationsRequest.ProtoReflect.Descriptor instead.
-func (*ResetExpectationsRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{24}
+func (x *Assertion_NearMiss) GetExpectation() *Expectation {
+	if x != nil {
+		return x.Expectation
+	}
+	return nil
 }
 
-// ResetExpectationsResponse is returned after all expectations are successfully reset.
-type ResetExpectationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Reply represents the message published back to the caller of the request.
+type Assertion_Reply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The reply-to queue the reply was published to.
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// The reply headers.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The reply properties.
+	Properties *MessageProperties `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
+	// The raw reply body.
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// The time the reply was published, or publishing failed.
+	PublishedAt string `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// The time between receiving the request and publishing the reply, in milliseconds.
+	LatencyMs float64 `protobuf:"fixed64,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// The reason publishing failed, empty on success.
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetExpectationsResponse) Reset() {
-	*x = ResetExpectationsResponse{}
-	mi := &file_mockserver_proto_msgTypes[25]
+func (x *Assertion_Reply) Reset() {
+	*x = Assertion_Reply{}
+	mi := &file_mockserver_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetExpectationsResponse) String() string {
+func (x *Assertion_Reply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetExpectationsResponse) ProtoMessage() {}
+func (*Assertion_Reply) ProtoMessage() {}
 
-func (x *ResetExpectationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[25]
+func (x *Assertion_Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,70 +6574,90 @@ func (x *ResetExpectationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetExpectationsResponse.ProtoReflect.Descriptor instead.
-func (*ResetExpectationsResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Assertion_Reply.ProtoReflect.Descriptor instead.
+func (*Assertion_Reply) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22, 2}
 }
 
-// ResetSubscriptionsRequest is used to reset all subscriptions.
-type ResetSubscriptionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Assertion_Reply) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
 }
 
-func (x *ResetSubscriptionsRequest) Reset() {
-	*x = ResetSubscriptionsRequest{}
-	mi := &file_mockserver_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Assertion_Reply) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
 }
 
-func (x *ResetSubscriptionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assertion_Reply) GetProperties() *MessageProperties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
 }
 
-func (*ResetSubscriptionsRequest) ProtoMessage() {}
+func (x *Assertion_Reply) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
 
-func (x *ResetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[26]
+func (x *Assertion_Reply) GetPublishedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *Assertion_Reply) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ResetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*ResetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{26}
+func (x *Assertion_Reply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-// ResetSubscriptionsResponse is returned after all subscriptions are successfully reset.
-type ResetSubscriptionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Delivery represents the AMQP delivery metadata of the request.
+type Assertion_Delivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The consumer tag of the subscription that consumed the request.
+	ConsumerTag string `protobuf:"bytes,1,opt,name=consumer_tag,json=consumerTag,proto3" json:"consumer_tag,omitempty"`
+	// The delivery tag of the request on its channel.
+	DeliveryTag uint64 `protobuf:"varint,2,opt,name=delivery_tag,json=deliveryTag,proto3" json:"delivery_tag,omitempty"`
+	// Whether the request was delivered before and requeued.
+	Redelivered bool `protobuf:"varint,3,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	// The time the request was consumed, empty if unknown.
+	ReceivedAt    string `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetSubscriptionsResponse) Reset() {
-	*x = ResetSubscriptionsResponse{}
-	mi := &file_mockserver_proto_msgTypes[27]
+func (x *Assertion_Delivery) Reset() {
+	*x = Assertion_Delivery{}
+	mi := &file_mockserver_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetSubscriptionsResponse) String() string {
+func (x *Assertion_Delivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSubscriptionsResponse) ProtoMessage() {}
+func (*Assertion_Delivery) ProtoMessage() {}
 
-func (x *ResetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[27]
+func (x *Assertion_Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,70 +6668,77 @@ func (x *ResetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*ResetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{27}
-}
-
-// ResetAllRequest is used to reset both expectations and subscriptions.
-type ResetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use Assertion_Delivery.ProtoReflect.Descriptor instead.
+func (*Assertion_Delivery) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22, 3}
 }
 
-func (x *ResetAllRequest) Reset() {
-	*x = ResetAllRequest{}
-	mi := &file_mockserver_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Assertion_Delivery) GetConsumerTag() string {
+	if x != nil {
+		return x.ConsumerTag
+	}
+	return ""
 }
 
-func (x *ResetAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assertion_Delivery) GetDeliveryTag() uint64 {
+	if x != nil {
+		return x.DeliveryTag
+	}
+	return 0
 }
 
-func (*ResetAllRequest) ProtoMessage() {}
-
-func (x *ResetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[28]
+func (x *Assertion_Delivery) GetRedelivered() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Redelivered
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ResetAllRequest.ProtoReflect.Descriptor instead.
-func (*ResetAllRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{28}
+func (x *Assertion_Delivery) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
 }
 
-// ResetAllResponse is returned after both expectations and subscriptions are successfully reset.
-type ResetAllResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Candidate represents a candidate request sent to the mockserver.
+type Assertion_Candidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exchange the message is sent to.
+	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// The routing key the message is sent with.
+	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// The candidate JSON structure to be matched.
+	Body *structpb.Struct `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// The message headers, values are converted to strings.
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The message properties.
+	Properties *MessageProperties `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	// The queue the message was consumed from.
+	Queue string `protobuf:"bytes,6,opt,name=queue,proto3" json:"queue,omitempty"`
+	// The subscription that consumed the message, empty if unknown.
+	SubscriptionId string `protobuf:"bytes,7,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// The AMQP delivery metadata.
+	Delivery      *Assertion_Delivery `protobuf:"bytes,8,opt,name=delivery,proto3" json:"delivery,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetAllResponse) Reset() {
-	*x = ResetAllResponse{}
-	mi := &file_mockserver_proto_msgTypes[29]
+func (x *Assertion_Candidate) Reset() {
+	*x = Assertion_Candidate{}
+	mi := &file_mockserver_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetAllResponse) String() string {
+func (x *Assertion_Candidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetAllResponse) ProtoMessage() {}
+func (*Assertion_Candidate) ProtoMessage() {}
 
-func (x *ResetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[29]
+func (x *Assertion_Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,76 +6749,95 @@ func (x *ResetAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetAllResponse.ProtoReflect.Descriptor instead.
-func (*ResetAllResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Assertion_Candidate.ProtoReflect.Descriptor instead.
+func (*Assertion_Candidate) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{22, 4}
 }
 
-// GetVersionRequest is used to retrieve the version information of the mockserver application.
-type GetVersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Assertion_Candidate) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
 }
 
-func (x *GetVersionRequest) Reset() {
-	*x = GetVersionRequest{}
-	mi := &file_mockserver_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Assertion_Candidate) GetRoutingKey() string {
+	if x != nil {
+		return x.RoutingKey
+	}
+	return ""
 }
 
-func (x *GetVersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assertion_Candidate) GetBody() *structpb.Struct {
+	if x != nil {
+		return x.Body
+	}
+	return nil
 }
 
-func (*GetVersionRequest) ProtoMessage() {}
+func (x *Assertion_Candidate) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
 
-func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[30]
+func (x *Assertion_Candidate) GetProperties() *MessageProperties {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Properties
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{30}
+func (x *Assertion_Candidate) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
 }
 
-// GetVersionResponse contains the version information of the mockserver application.
-type GetVersionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// version is the semantic version of the mockserver application.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// commit_hash is the commit hash of the mockserver application.
-	CommitHash string `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
-	// build_date is the date the mockserver application was built.
-	BuildDate     string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+func (x *Assertion_Candidate) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Assertion_Candidate) GetDelivery() *Assertion_Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// SequenceEntry is a received request.
+type VerifySequenceResponse_SequenceEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Exchange   string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	RoutingKey string                 `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	// created_at is a time when the request was received.
+	CreatedAt string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// steps are the zero-based indexes of the steps the request matches.
+	Steps         []uint32 `protobuf:"varint,4,rep,packed,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionResponse) Reset() {
-	*x = GetVersionResponse{}
-	mi := &file_mockserver_proto_msgTypes[31]
+func (x *VerifySequenceResponse_SequenceEntry) Reset() {
+	*x = VerifySequenceResponse_SequenceEntry{}
+	mi := &file_mockserver_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionResponse) String() string {
+func (x *VerifySequenceResponse_SequenceEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionResponse) ProtoMessage() {}
+func (*VerifySequenceResponse_SequenceEntry) ProtoMessage() {}
 
-func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[31]
+func (x *VerifySequenceResponse_SequenceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,60 +6848,64 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
-func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use VerifySequenceResponse_SequenceEntry.ProtoReflect.Descriptor instead.
+func (*VerifySequenceResponse_SequenceEntry) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *GetVersionResponse) GetVersion() string {
+func (x *VerifySequenceResponse_SequenceEntry) GetExchange() string {
 	if x != nil {
-		return x.Version
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetVersionResponse) GetCommitHash() string {
+func (x *VerifySequenceResponse_SequenceEntry) GetRoutingKey() string {
 	if x != nil {
-		return x.CommitHash
+		return x.RoutingKey
 	}
 	return ""
 }
 
-func (x *GetVersionResponse) GetBuildDate() string {
+func (x *VerifySequenceResponse_SequenceEntry) GetCreatedAt() string {
 	if x != nil {
-		return x.BuildDate
+		return x.CreatedAt
 	}
 	return ""
 }
 
-// Candidate represents a candidate request sent to the mockserver.
-type Assertion_Candidate struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The exchange the message is sent to.
-	Exchange string `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	// The routing key the message is sent with.
-	RoutingKey string `protobuf:"bytes,2,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
-	// The candidate JSON structure to be matched.
-	Body          *structpb.Struct `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+func (x *VerifySequenceResponse_SequenceEntry) GetSteps() []uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// SkippedExpectation is an expectation skipped because it is inactive.
+type SimulateMatchResponse_SkippedExpectation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Expectation *Expectation           `protobuf:"bytes,1,opt,name=expectation,proto3" json:"expectation,omitempty"`
+	// reasons are why the expectation is inactive: expired, times exhausted or scenario state.
+	Reasons       []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Assertion_Candidate) Reset() {
-	*x = Assertion_Candidate{}
-	mi := &file_mockserver_proto_msgTypes[32]
+func (x *SimulateMatchResponse_SkippedExpectation) Reset() {
+	*x = SimulateMatchResponse_SkippedExpectation{}
+	mi := &file_mockserver_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Assertion_Candidate) String() string {
+func (x *SimulateMatchResponse_SkippedExpectation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assertion_Candidate) ProtoMessage() {}
+func (*SimulateMatchResponse_SkippedExpectation) ProtoMessage() {}
 
-func (x *Assertion_Candidate) ProtoReflect() protoreflect.Message {
-	mi := &file_mockserver_proto_msgTypes[32]
+func (x *SimulateMatchResponse_SkippedExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_mockserver_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,28 +6916,21 @@ func (x *Assertion_Candidate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assertion_Candidate.ProtoReflect.Descriptor instead.
-func (*Assertion_Candidate) Descriptor() ([]byte, []int) {
-	return file_mockserver_proto_rawDescGZIP(), []int{16, 0}
-}
-
-func (x *Assertion_Candidate) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use SimulateMatchResponse_SkippedExpectation.ProtoReflect.Descriptor instead.
+func (*SimulateMatchResponse_SkippedExpectation) Descriptor() ([]byte, []int) {
+	return file_mockserver_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *Assertion_Candidate) GetRoutingKey() string {
+func (x *SimulateMatchResponse_SkippedExpectation) GetExpectation() *Expectation {
 	if x != nil {
-		return x.RoutingKey
+		return x.Expectation
 	}
-	return ""
+	return nil
 }
 
-func (x *Assertion_Candidate) GetBody() *structpb.Struct {
+func (x *SimulateMatchResponse_SkippedExpectation) GetReasons() []string {
 	if x != nil {
-		return x.Body
+		return x.Reasons
 	}
 	return nil
 }
@@ -1786,15 +6939,32 @@ var File_mockserver_proto protoreflect.FileDescriptor
 
 const file_mockserver_proto_rawDesc = "" +
 	"\n" +
-	"\x10mockserver.proto\x12\x18rmqrpc.mockserver.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"4\n" +
+	"\x10mockserver.proto\x12\x18rmqrpc.mockserver.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"J\n" +
+	"\vProxyTarget\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vrouting_key\x18\x02 \x01(\tR\n" +
+	"routingKey\"\xb8\x02\n" +
 	"\fSubscription\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05queue\x18\x02 \x01(\tR\x05queue\"N\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12Q\n" +
+	"\x0ffallback_policy\x18\x03 \x01(\x0e2(.rmqrpc.mockserver.api.v1.FallbackPolicyR\x0efallbackPolicy\x12M\n" +
+	"\fproxy_target\x18\x04 \x01(\v2%.rmqrpc.mockserver.api.v1.ProxyTargetH\x00R\vproxyTarget\x88\x01\x01\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12\"\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tH\x01R\tsessionId\x88\x01\x01B\x0f\n" +
+	"\r_proxy_targetB\r\n" +
+	"\v_session_id\"\xb2\x02\n" +
 	"\x16AddSubscriptionRequest\x12\x14\n" +
 	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x1e\n" +
 	"\n" +
 	"idempotent\x18\x02 \x01(\bR\n" +
-	"idempotent\"e\n" +
+	"idempotent\x12Q\n" +
+	"\x0ffallback_policy\x18\x03 \x01(\x0e2(.rmqrpc.mockserver.api.v1.FallbackPolicyR\x0efallbackPolicy\x12M\n" +
+	"\fproxy_target\x18\x04 \x01(\v2%.rmqrpc.mockserver.api.v1.ProxyTargetH\x00R\vproxyTarget\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x01R\tnamespace\x88\x01\x01B\x0f\n" +
+	"\r_proxy_targetB\f\n" +
+	"\n" +
+	"_namespace\"e\n" +
 	"\x17AddSubscriptionResponse\x12J\n" +
 	"\fsubscription\x18\x01 \x01(\v2&.rmqrpc.mockserver.api.v1.SubscriptionR\fsubscription\"D\n" +
 	"\x19DeleteSubscriptionRequest\x12'\n" +
@@ -1815,28 +6985,110 @@ const file_mockserver_proto_rawDesc = "" +
 	"\x10MATCH_TYPE_EXACT\x10\x01\x12\x16\n" +
 	"\x12MATCH_TYPE_PARTIAL\x10\x02\"*\n" +
 	"\x12RegexBodyAssertion\x12\x14\n" +
-	"\x05regex\x18\x01 \x01(\tR\x05regex\"\xe9\x01\n" +
+	"\x05regex\x18\x01 \x01(\tR\x05regex\"\xfe\x01\n" +
+	"\x0eValueAssertion\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12Q\n" +
+	"\n" +
+	"match_type\x18\x02 \x01(\x0e22.rmqrpc.mockserver.api.v1.ValueAssertion.MatchTypeR\tmatchType\"\x82\x01\n" +
+	"\tMatchType\x12\x1a\n" +
+	"\x16MATCH_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10MATCH_TYPE_EXACT\x10\x01\x12\x14\n" +
+	"\x10MATCH_TYPE_REGEX\x10\x02\x12\x16\n" +
+	"\x12MATCH_TYPE_PRESENT\x10\x03\x12\x15\n" +
+	"\x11MATCH_TYPE_ABSENT\x10\x04\"\xa0\b\n" +
 	"\aRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
 	"\vrouting_key\x18\x02 \x01(\tR\n" +
 	"routingKey\x12J\n" +
 	"\tjson_body\x18\x03 \x01(\v2+.rmqrpc.mockserver.api.v1.JSONBodyAssertionH\x00R\bjsonBody\x12M\n" +
 	"\n" +
-	"regex_body\x18\x04 \x01(\v2,.rmqrpc.mockserver.api.v1.RegexBodyAssertionH\x00R\tregexBodyB\x06\n" +
-	"\x04body\"6\n" +
+	"regex_body\x18\x04 \x01(\v2,.rmqrpc.mockserver.api.v1.RegexBodyAssertionH\x00R\tregexBody\x12H\n" +
+	"\aheaders\x18\x05 \x03(\v2..rmqrpc.mockserver.api.v1.Request.HeadersEntryR\aheaders\x12Q\n" +
+	"\n" +
+	"properties\x18\x06 \x03(\v21.rmqrpc.mockserver.api.v1.Request.PropertiesEntryR\n" +
+	"properties\x12c\n" +
+	"\x13exchange_match_type\x18\a \x01(\x0e23.rmqrpc.mockserver.api.v1.Request.ExchangeMatchTypeR\x11exchangeMatchType\x12j\n" +
+	"\x16routing_key_match_type\x18\b \x01(\x0e25.rmqrpc.mockserver.api.v1.Request.RoutingKeyMatchTypeR\x13routingKeyMatchType\x1ad\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.rmqrpc.mockserver.api.v1.ValueAssertionR\x05value:\x028\x01\x1ag\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.rmqrpc.mockserver.api.v1.ValueAssertionR\x05value:\x028\x01\"t\n" +
+	"\x11ExchangeMatchType\x12#\n" +
+	"\x1fEXCHANGE_MATCH_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EXCHANGE_MATCH_TYPE_EXACT\x10\x01\x12\x1b\n" +
+	"\x17EXCHANGE_MATCH_TYPE_ANY\x10\x02\"\x81\x01\n" +
+	"\x13RoutingKeyMatchType\x12&\n" +
+	"\"ROUTING_KEY_MATCH_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cROUTING_KEY_MATCH_TYPE_EXACT\x10\x01\x12 \n" +
+	"\x1cROUTING_KEY_MATCH_TYPE_TOPIC\x10\x02B\x06\n" +
+	"\x04body\"\xe7\x02\n" +
+	"\x11MessageProperties\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12)\n" +
+	"\x10content_encoding\x18\x02 \x01(\tR\x0fcontentEncoding\x12#\n" +
+	"\rdelivery_mode\x18\x03 \x01(\rR\fdeliveryMode\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\rR\bpriority\x12%\n" +
+	"\x0ecorrelation_id\x18\x05 \x01(\tR\rcorrelationId\x12\x19\n" +
+	"\breply_to\x18\x06 \x01(\tR\areplyTo\x12\x1e\n" +
+	"\n" +
+	"expiration\x18\a \x01(\tR\n" +
+	"expiration\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\b \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04type\x18\t \x01(\tR\x04type\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\tR\x06userId\x12\x15\n" +
+	"\x06app_id\x18\v \x01(\tR\x05appId\"\xd9\x01\n" +
 	"\bResponse\x12*\n" +
-	"\x04body\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x04body\"[\n" +
+	"\x04body\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x04body\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12I\n" +
+	"\aheaders\x18\x03 \x03(\v2/.rmqrpc.mockserver.api.v1.Response.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
 	"\x05Times\x12)\n" +
 	"\x0fremaining_times\x18\x01 \x01(\rH\x00R\x0eremainingTimes\x12\x1e\n" +
 	"\tunlimited\x18\x02 \x01(\bH\x00R\tunlimitedB\a\n" +
-	"\x05times\"\xac\x02\n" +
+	"\x05times\"\xcb\x02\n" +
+	"\x05Delay\x12\x1b\n" +
+	"\bfixed_ms\x18\x01 \x01(\rH\x00R\afixedMs\x12H\n" +
+	"\auniform\x18\x02 \x01(\v2,.rmqrpc.mockserver.api.v1.Delay.UniformDelayH\x00R\auniform\x12O\n" +
+	"\n" +
+	"log_normal\x18\x03 \x01(\v2..rmqrpc.mockserver.api.v1.Delay.LogNormalDelayH\x00R\tlogNormal\x1a<\n" +
+	"\fUniformDelay\x12\x15\n" +
+	"\x06min_ms\x18\x01 \x01(\rR\x05minMs\x12\x15\n" +
+	"\x06max_ms\x18\x02 \x01(\rR\x05maxMs\x1aC\n" +
+	"\x0eLogNormalDelay\x12\x1b\n" +
+	"\tmedian_ms\x18\x01 \x01(\rR\bmedianMs\x12\x14\n" +
+	"\x05sigma\x18\x02 \x01(\x01R\x05sigmaB\a\n" +
+	"\x05delay\"b\n" +
+	"\bScenario\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0erequired_state\x18\x02 \x01(\tR\rrequiredState\x12\x1b\n" +
+	"\tnew_state\x18\x03 \x01(\tR\bnewState\"9\n" +
+	"\rScenarioState\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\xc1\x05\n" +
 	"\x18CreateExpectationRequest\x12;\n" +
 	"\arequest\x18\x01 \x01(\v2!.rmqrpc.mockserver.api.v1.RequestR\arequest\x12>\n" +
 	"\bresponse\x18\x02 \x01(\v2\".rmqrpc.mockserver.api.v1.ResponseR\bresponse\x12:\n" +
 	"\x05times\x18\x03 \x01(\v2\x1f.rmqrpc.mockserver.api.v1.TimesH\x00R\x05times\x88\x01\x01\x124\n" +
-	"\x14time_to_live_seconds\x18\x04 \x01(\x02H\x01R\x11timeToLiveSeconds\x88\x01\x01B\b\n" +
+	"\x14time_to_live_seconds\x18\x04 \x01(\x02H\x01R\x11timeToLiveSeconds\x88\x01\x01\x12:\n" +
+	"\x05delay\x18\x05 \x01(\v2\x1f.rmqrpc.mockserver.api.v1.DelayH\x02R\x05delay\x88\x01\x01\x128\n" +
+	"\x06action\x18\x06 \x01(\x0e2 .rmqrpc.mockserver.api.v1.ActionR\x06action\x12C\n" +
+	"\bscenario\x18\a \x01(\v2\".rmqrpc.mockserver.api.v1.ScenarioH\x03R\bscenario\x88\x01\x01\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12V\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2>.rmqrpc.mockserver.api.v1.CreateExpectationRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
 	"\x06_timesB\x17\n" +
-	"\x15_time_to_live_seconds\"\xa3\x02\n" +
+	"\x15_time_to_live_secondsB\b\n" +
+	"\x06_delayB\v\n" +
+	"\t_scenario\"\xc5\x06\n" +
 	"\vExpectation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\arequest\x18\x02 \x01(\v2!.rmqrpc.mockserver.api.v1.RequestR\arequest\x12>\n" +
@@ -1845,34 +7097,256 @@ const file_mockserver_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x05 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAtB\r\n" +
-	"\v_expires_at\"\xf6\x02\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12:\n" +
+	"\x05delay\x18\a \x01(\v2\x1f.rmqrpc.mockserver.api.v1.DelayH\x01R\x05delay\x88\x01\x01\x128\n" +
+	"\x06action\x18\b \x01(\x0e2 .rmqrpc.mockserver.api.v1.ActionR\x06action\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12C\n" +
+	"\bscenario\x18\n" +
+	" \x01(\v2\".rmqrpc.mockserver.api.v1.ScenarioH\x02R\bscenario\x88\x01\x01\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x12\n" +
+	"\x04name\x18\f \x01(\tR\x04name\x124\n" +
+	"\x14time_to_live_seconds\x18\r \x01(\x02H\x03R\x11timeToLiveSeconds\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\x0e \x01(\tH\x04R\tsessionId\x88\x01\x01\x12I\n" +
+	"\x06labels\x18\x0f \x03(\v21.rmqrpc.mockserver.api.v1.Expectation.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_expires_atB\b\n" +
+	"\x06_delayB\v\n" +
+	"\t_scenarioB\x17\n" +
+	"\x15_time_to_live_secondsB\r\n" +
+	"\v_session_id\"\xb2\x0e\n" +
 	"\tAssertion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12K\n" +
 	"\tcandidate\x18\x02 \x01(\v2-.rmqrpc.mockserver.api.v1.Assertion.CandidateR\tcandidate\x12\x18\n" +
 	"\amatched\x18\x03 \x01(\bR\amatched\x12L\n" +
 	"\vexpectation\x18\x04 \x01(\v2%.rmqrpc.mockserver.api.v1.ExpectationH\x00R\vexpectation\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\x1au\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\aproxied\x18\x06 \x01(\bR\aproxied\x12J\n" +
+	"\x05proxy\x18\a \x01(\v2/.rmqrpc.mockserver.api.v1.Assertion.ProxyResultH\x01R\x05proxy\x88\x01\x01\x12D\n" +
+	"\x05reply\x18\b \x01(\v2).rmqrpc.mockserver.api.v1.Assertion.ReplyH\x02R\x05reply\x88\x01\x01\x12M\n" +
+	"\vnear_misses\x18\t \x03(\v2,.rmqrpc.mockserver.api.v1.Assertion.NearMissR\n" +
+	"nearMisses\x1a|\n" +
+	"\vProxyResult\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vrouting_key\x18\x02 \x01(\tR\n" +
+	"routingKey\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x1a\xc6\x01\n" +
+	"\bNearMiss\x12%\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\x12\x1b\n" +
+	"\tbody_diff\x18\x03 \x01(\tR\bbodyDiff\x12L\n" +
+	"\vexpectation\x18\x04 \x01(\v2%.rmqrpc.mockserver.api.v1.ExpectationH\x00R\vexpectation\x88\x01\x01B\x0e\n" +
+	"\f_expectation\x1a\xe4\x02\n" +
+	"\x05Reply\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12P\n" +
+	"\aheaders\x18\x02 \x03(\v26.rmqrpc.mockserver.api.v1.Assertion.Reply.HeadersEntryR\aheaders\x12K\n" +
+	"\n" +
+	"properties\x18\x03 \x01(\v2+.rmqrpc.mockserver.api.v1.MessagePropertiesR\n" +
+	"properties\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12!\n" +
+	"\fpublished_at\x18\x05 \x01(\tR\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x06 \x01(\x01R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x93\x01\n" +
+	"\bDelivery\x12!\n" +
+	"\fconsumer_tag\x18\x01 \x01(\tR\vconsumerTag\x12!\n" +
+	"\fdelivery_tag\x18\x02 \x01(\x04R\vdeliveryTag\x12 \n" +
+	"\vredelivered\x18\x03 \x01(\bR\vredelivered\x12\x1f\n" +
+	"\vreceived_at\x18\x04 \x01(\tR\n" +
+	"receivedAt\x1a\xdd\x03\n" +
 	"\tCandidate\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
 	"\vrouting_key\x18\x02 \x01(\tR\n" +
 	"routingKey\x12+\n" +
-	"\x04body\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04bodyB\x0e\n" +
-	"\f_expectation\"\x97\x01\n" +
+	"\x04body\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04body\x12T\n" +
+	"\aheaders\x18\x04 \x03(\v2:.rmqrpc.mockserver.api.v1.Assertion.Candidate.HeadersEntryR\aheaders\x12K\n" +
+	"\n" +
+	"properties\x18\x05 \x01(\v2+.rmqrpc.mockserver.api.v1.MessagePropertiesR\n" +
+	"properties\x12\x14\n" +
+	"\x05queue\x18\x06 \x01(\tR\x05queue\x12'\n" +
+	"\x0fsubscription_id\x18\a \x01(\tR\x0esubscriptionId\x12H\n" +
+	"\bdelivery\x18\b \x01(\v2,.rmqrpc.mockserver.api.v1.Assertion.DeliveryR\bdelivery\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_expectationB\b\n" +
+	"\x06_proxyB\b\n" +
+	"\x06_reply\"\xeb\x05\n" +
 	"\x14GetAssertionsRequest\x12*\n" +
 	"\x0eexpectation_id\x18\x01 \x01(\tH\x00R\rexpectationId\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x18\n" +
-	"\ainclude\x18\x03 \x03(\tR\aincludeB\x11\n" +
+	"\ainclude\x18\x03 \x03(\tR\ainclude\x12\x1f\n" +
+	"\bexchange\x18\x04 \x01(\tH\x02R\bexchange\x88\x01\x01\x12$\n" +
+	"\vrouting_key\x18\x05 \x01(\tH\x03R\n" +
+	"routingKey\x88\x01\x01\x12\x19\n" +
+	"\x05queue\x18\x06 \x01(\tH\x04R\x05queue\x88\x01\x01\x12U\n" +
+	"\aheaders\x18\a \x03(\v2;.rmqrpc.mockserver.api.v1.GetAssertionsRequest.HeadersEntryR\aheaders\x12(\n" +
+	"\rbody_contains\x18\b \x01(\tH\x05R\fbodyContains\x88\x01\x01\x12\x19\n" +
+	"\x05since\x18\t \x01(\tH\x06R\x05since\x88\x01\x01\x12\x19\n" +
+	"\x05until\x18\n" +
+	" \x01(\tH\aR\x05until\x88\x01\x01\x12!\n" +
+	"\fnewest_first\x18\v \x01(\bR\vnewestFirst\x12\x1b\n" +
+	"\tpage_size\x18\f \x01(\rR\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tH\bR\tpageToken\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x0e \x01(\tH\tR\rlabelSelector\x88\x01\x01\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x11\n" +
 	"\x0f_expectation_idB\t\n" +
-	"\a_status\"\\\n" +
+	"\a_statusB\v\n" +
+	"\t_exchangeB\x0e\n" +
+	"\f_routing_keyB\b\n" +
+	"\x06_queueB\x10\n" +
+	"\x0e_body_containsB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_untilB\r\n" +
+	"\v_page_tokenB\x11\n" +
+	"\x0f_label_selector\"\x84\x01\n" +
 	"\x15GetAssertionsResponse\x12C\n" +
 	"\n" +
 	"assertions\x18\x01 \x03(\v2#.rmqrpc.mockserver.api.v1.AssertionR\n" +
-	"assertions\"@\n" +
+	"assertions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"8\n" +
+	"\x13GetAssertionRequest\x12!\n" +
+	"\fassertion_id\x18\x01 \x01(\tR\vassertionId\"Y\n" +
+	"\x14GetAssertionResponse\x12A\n" +
+	"\tassertion\x18\x01 \x01(\v2#.rmqrpc.mockserver.api.v1.AssertionR\tassertion\"\xb9\x04\n" +
+	"\x18WaitForAssertionsRequest\x12*\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tH\x01R\rexpectationId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bexchange\x18\x03 \x01(\tH\x03R\bexchange\x88\x01\x01\x12$\n" +
+	"\vrouting_key\x18\x04 \x01(\tH\x04R\n" +
+	"routingKey\x88\x01\x01\x12J\n" +
+	"\tjson_body\x18\x05 \x01(\v2+.rmqrpc.mockserver.api.v1.JSONBodyAssertionH\x00R\bjsonBody\x12M\n" +
+	"\n" +
+	"regex_body\x18\x06 \x01(\v2,.rmqrpc.mockserver.api.v1.RegexBodyAssertionH\x00R\tregexBody\x12\x14\n" +
+	"\x05count\x18\a \x01(\rR\x05count\x12,\n" +
+	"\x0ftimeout_seconds\x18\b \x01(\x02H\x05R\x0etimeoutSeconds\x88\x01\x01\x12\x18\n" +
+	"\ainclude\x18\t \x03(\tR\ainclude\x12*\n" +
+	"\x0elabel_selector\x18\n" +
+	" \x01(\tH\x06R\rlabelSelector\x88\x01\x01B\x06\n" +
+	"\x04bodyB\x11\n" +
+	"\x0f_expectation_idB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_exchangeB\x0e\n" +
+	"\f_routing_keyB\x12\n" +
+	"\x10_timeout_secondsB\x11\n" +
+	"\x0f_label_selector\"~\n" +
+	"\x19WaitForAssertionsResponse\x12\x1c\n" +
+	"\tsatisfied\x18\x01 \x01(\bR\tsatisfied\x12C\n" +
+	"\n" +
+	"assertions\x18\x02 \x03(\v2#.rmqrpc.mockserver.api.v1.AssertionR\n" +
+	"assertions\"\x18\n" +
+	"\x16ResetAssertionsRequest\"\x19\n" +
+	"\x17ResetAssertionsResponse\"\x1b\n" +
+	"\x19GetAssertionsStatsRequest\"\x91\x01\n" +
+	"\x1aGetAssertionsStatsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\x12\x18\n" +
+	"\aevicted\x18\x02 \x01(\x04R\aevicted\x12\x1b\n" +
+	"\tmax_count\x18\x03 \x01(\x04R\bmaxCount\x12&\n" +
+	"\x0fmax_age_seconds\x18\x04 \x01(\x02R\rmaxAgeSeconds\"\x97\x02\n" +
+	"\x12WatchEventsRequest\x129\n" +
+	"\x05types\x18\x01 \x03(\x0e2#.rmqrpc.mockserver.api.v1.EventTypeR\x05types\x12*\n" +
+	"\x0eexpectation_id\x18\x02 \x01(\tH\x00R\rexpectationId\x88\x01\x01\x12\x1f\n" +
+	"\bexchange\x18\x03 \x01(\tH\x01R\bexchange\x88\x01\x01\x12$\n" +
+	"\vrouting_key\x18\x04 \x01(\tH\x02R\n" +
+	"routingKey\x88\x01\x01\x12\x19\n" +
+	"\x05queue\x18\x05 \x01(\tH\x03R\x05queue\x88\x01\x01B\x11\n" +
+	"\x0f_expectation_idB\v\n" +
+	"\t_exchangeB\x0e\n" +
+	"\f_routing_keyB\b\n" +
+	"\x06_queue\"\xc0\x03\n" +
+	"\x05Event\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.rmqrpc.mockserver.api.v1.EventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12*\n" +
+	"\x0eexpectation_id\x18\x03 \x01(\tH\x00R\rexpectationId\x88\x01\x01\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vrouting_key\x18\x05 \x01(\tR\n" +
+	"routingKey\x12P\n" +
+	"\tcandidate\x18\x06 \x01(\v2-.rmqrpc.mockserver.api.v1.Assertion.CandidateH\x01R\tcandidate\x88\x01\x01\x12,\n" +
+	"\x0fsubscription_id\x18\a \x01(\tH\x02R\x0esubscriptionId\x88\x01\x01\x12\x19\n" +
+	"\x05queue\x18\b \x01(\tH\x03R\x05queue\x88\x01\x01\x12\x1c\n" +
+	"\tnamespace\x18\t \x01(\tR\tnamespaceB\x11\n" +
+	"\x0f_expectation_idB\f\n" +
+	"\n" +
+	"_candidateB\x12\n" +
+	"\x10_subscription_idB\b\n" +
+	"\x06_queue\"i\n" +
+	"\x19VerifyExpectationsRequest\x12L\n" +
+	"\rverifications\x18\x01 \x03(\v2&.rmqrpc.mockserver.api.v1.VerificationR\rverifications\"\xc3\x01\n" +
+	"\fVerification\x12'\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tH\x00R\rexpectationId\x12=\n" +
+	"\arequest\x18\x02 \x01(\v2!.rmqrpc.mockserver.api.v1.RequestH\x00R\arequest\x12A\n" +
+	"\x05times\x18\x03 \x01(\v2+.rmqrpc.mockserver.api.v1.VerificationTimesR\x05timesB\b\n" +
+	"\x06target\"\x95\x01\n" +
+	"\x11VerificationTimes\x12\x1d\n" +
+	"\aexactly\x18\x01 \x01(\rH\x00R\aexactly\x88\x01\x01\x12\x1e\n" +
+	"\bat_least\x18\x02 \x01(\rH\x01R\aatLeast\x88\x01\x01\x12\x1c\n" +
+	"\aat_most\x18\x03 \x01(\rH\x02R\x06atMost\x88\x01\x01B\n" +
+	"\n" +
+	"\b_exactlyB\v\n" +
+	"\t_at_leastB\n" +
+	"\n" +
+	"\b_at_most\"\x94\x01\n" +
+	"\x1aVerifyExpectationsResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12F\n" +
+	"\aresults\x18\x02 \x03(\v2,.rmqrpc.mockserver.api.v1.VerificationResultR\aresults\x12\x16\n" +
+	"\x06report\x18\x03 \x01(\tR\x06report\"Z\n" +
+	"\x12VerificationResult\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x16\n" +
+	"\x06report\x18\x03 \x01(\tR\x06report\"\x8c\x01\n" +
+	"\x15VerifySequenceRequest\x127\n" +
+	"\x05steps\x18\x01 \x03(\v2!.rmqrpc.mockserver.api.v1.RequestR\x05steps\x12:\n" +
+	"\x04mode\x18\x02 \x01(\x0e2&.rmqrpc.mockserver.api.v1.SequenceModeR\x04mode\"\xb5\x02\n" +
+	"\x16VerifySequenceResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06report\x18\x02 \x01(\tR\x06report\x12g\n" +
+	"\x0factual_sequence\x18\x03 \x03(\v2>.rmqrpc.mockserver.api.v1.VerifySequenceResponse.SequenceEntryR\x0eactualSequence\x1a\x81\x01\n" +
+	"\rSequenceEntry\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vrouting_key\x18\x02 \x01(\tR\n" +
+	"routingKey\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05steps\x18\x04 \x03(\rR\x05steps\"\x8c\x03\n" +
+	"\x14SimulateMatchRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vrouting_key\x18\x02 \x01(\tR\n" +
+	"routingKey\x12*\n" +
+	"\x04body\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x04body\x12\x1e\n" +
+	"\braw_body\x18\x04 \x01(\tH\x00R\arawBody\x88\x01\x01\x12U\n" +
+	"\aheaders\x18\x05 \x03(\v2;.rmqrpc.mockserver.api.v1.SimulateMatchRequest.HeadersEntryR\aheaders\x12K\n" +
+	"\n" +
+	"properties\x18\x06 \x01(\v2+.rmqrpc.mockserver.api.v1.MessagePropertiesR\n" +
+	"properties\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_raw_body\"\xa7\x03\n" +
+	"\x15SimulateMatchResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12L\n" +
+	"\vexpectation\x18\x02 \x01(\v2%.rmqrpc.mockserver.api.v1.ExpectationH\x00R\vexpectation\x88\x01\x01\x12?\n" +
+	"\amatches\x18\x03 \x03(\v2%.rmqrpc.mockserver.api.v1.ExpectationR\amatches\x12\\\n" +
+	"\askipped\x18\x04 \x03(\v2B.rmqrpc.mockserver.api.v1.SimulateMatchResponse.SkippedExpectationR\askipped\x1aw\n" +
+	"\x12SkippedExpectation\x12G\n" +
+	"\vexpectation\x18\x01 \x01(\v2%.rmqrpc.mockserver.api.v1.ExpectationR\vexpectation\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasonsB\x0e\n" +
+	"\f_expectation\"\x7f\n" +
 	"\x16GetExpectationsRequest\x12\x1b\n" +
-	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"d\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tH\x01R\rlabelSelector\x88\x01\x01B\t\n" +
+	"\a_statusB\x11\n" +
+	"\x0f_label_selector\"d\n" +
 	"\x17GetExpectationsResponse\x12I\n" +
 	"\fexpectations\x18\x01 \x03(\v2%.rmqrpc.mockserver.api.v1.ExpectationR\fexpectations\">\n" +
 	"\x15GetExpectationRequest\x12%\n" +
@@ -1880,31 +7354,181 @@ const file_mockserver_proto_rawDesc = "" +
 	"\x16GetExpectationResponse\x12G\n" +
 	"\vexpectation\x18\x01 \x01(\v2%.rmqrpc.mockserver.api.v1.ExpectationR\vexpectation\"B\n" +
 	"\x19CreateExpectationResponse\x12%\n" +
-	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\"\x1a\n" +
-	"\x18ResetExpectationsRequest\"\x1b\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\"\x97\x01\n" +
+	"\x18UpdateExpectationRequest\x12%\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\x12T\n" +
+	"\vexpectation\x18\x02 \x01(\v22.rmqrpc.mockserver.api.v1.CreateExpectationRequestR\vexpectation\"\x1b\n" +
+	"\x19UpdateExpectationResponse\"\xaf\x01\n" +
+	"\x18UpsertExpectationRequest\x12*\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tH\x00R\rexpectationId\x88\x01\x01\x12T\n" +
+	"\vexpectation\x18\x02 \x01(\v22.rmqrpc.mockserver.api.v1.CreateExpectationRequestR\vexpectationB\x11\n" +
+	"\x0f_expectation_id\"\\\n" +
+	"\x19UpsertExpectationResponse\x12%\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"A\n" +
+	"\x18DeleteExpectationRequest\x12%\n" +
+	"\x0eexpectation_id\x18\x01 \x01(\tR\rexpectationId\"\x1b\n" +
+	"\x19DeleteExpectationResponse\"Y\n" +
+	"\x18ResetExpectationsRequest\x12*\n" +
+	"\x0elabel_selector\x18\x01 \x01(\tH\x00R\rlabelSelector\x88\x01\x01B\x11\n" +
+	"\x0f_label_selector\"\x1b\n" +
 	"\x19ResetExpectationsResponse\"\x1b\n" +
+	"\x19GetDefaultResponseRequest\"\\\n" +
+	"\x1aGetDefaultResponseResponse\x12>\n" +
+	"\bresponse\x18\x01 \x01(\v2\".rmqrpc.mockserver.api.v1.ResponseR\bresponse\"[\n" +
+	"\x19SetDefaultResponseRequest\x12>\n" +
+	"\bresponse\x18\x01 \x01(\v2\".rmqrpc.mockserver.api.v1.ResponseR\bresponse\"\x1c\n" +
+	"\x1aSetDefaultResponseResponse\"\x1d\n" +
+	"\x1bResetDefaultResponseRequest\"\x1e\n" +
+	"\x1cResetDefaultResponseResponse\"f\n" +
+	"\x15StartRecordingRequest\x12B\n" +
+	"\x06target\x18\x01 \x01(\v2%.rmqrpc.mockserver.api.v1.ProxyTargetH\x00R\x06target\x88\x01\x01B\t\n" +
+	"\a_target\"\x18\n" +
+	"\x16StartRecordingResponse\"\x16\n" +
+	"\x14StopRecordingRequest\"\x17\n" +
+	"\x15StopRecordingResponse\"\x16\n" +
+	"\x14GetRecordingsRequest\"\x8d\x01\n" +
+	"\x15GetRecordingsResponse\x12\x1c\n" +
+	"\trecording\x18\x01 \x01(\bR\trecording\x12V\n" +
+	"\fexpectations\x18\x02 \x03(\v22.rmqrpc.mockserver.api.v1.CreateExpectationRequestR\fexpectations\"\x18\n" +
+	"\x16ResetRecordingsRequest\"\x19\n" +
+	"\x17ResetRecordingsResponse\"\x15\n" +
+	"\x13GetScenariosRequest\"]\n" +
+	"\x14GetScenariosResponse\x12E\n" +
+	"\tscenarios\x18\x01 \x03(\v2'.rmqrpc.mockserver.api.v1.ScenarioStateR\tscenarios\"(\n" +
+	"\x12GetScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"Z\n" +
+	"\x13GetScenarioResponse\x12C\n" +
+	"\bscenario\x18\x01 \x01(\v2'.rmqrpc.mockserver.api.v1.ScenarioStateR\bscenario\"C\n" +
+	"\x17SetScenarioStateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x1a\n" +
+	"\x18SetScenarioStateResponse\"*\n" +
+	"\x14ResetScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x17\n" +
+	"\x15ResetScenarioResponse\"\x17\n" +
+	"\x15ResetScenariosRequest\"\x18\n" +
+	"\x16ResetScenariosResponse\"\x1b\n" +
 	"\x19ResetSubscriptionsRequest\"\x1c\n" +
-	"\x1aResetSubscriptionsResponse\"\x11\n" +
-	"\x0fResetAllRequest\"\x12\n" +
-	"\x10ResetAllResponse\"\x13\n" +
+	"\x1aResetSubscriptionsResponse\"<\n" +
+	"\x0fResetAllRequest\x12)\n" +
+	"\x10clear_assertions\x18\x01 \x01(\bR\x0fclearAssertions\"\x12\n" +
+	"\x10ResetAllResponse\"\x17\n" +
+	"\x15ListNamespacesRequest\"8\n" +
+	"\x16ListNamespacesResponse\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\tR\n" +
+	"namespaces\"6\n" +
+	"\x16DeleteNamespaceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x19\n" +
+	"\x17DeleteNamespaceResponse\"\x9a\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12#\n" +
+	"\rlease_seconds\x18\x03 \x01(\x02R\fleaseSeconds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"R\n" +
+	"\x14CreateSessionRequest\x12(\n" +
+	"\rlease_seconds\x18\x01 \x01(\x02H\x00R\fleaseSeconds\x88\x01\x01B\x10\n" +
+	"\x0e_lease_seconds\"T\n" +
+	"\x15CreateSessionResponse\x12;\n" +
+	"\asession\x18\x01 \x01(\v2!.rmqrpc.mockserver.api.v1.SessionR\asession\"\x14\n" +
+	"\x12GetSessionsRequest\"T\n" +
+	"\x13GetSessionsResponse\x12=\n" +
+	"\bsessions\x18\x01 \x03(\v2!.rmqrpc.mockserver.api.v1.SessionR\bsessions\"2\n" +
+	"\x11GetSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"Q\n" +
+	"\x12GetSessionResponse\x12;\n" +
+	"\asession\x18\x01 \x01(\v2!.rmqrpc.mockserver.api.v1.SessionR\asession\"8\n" +
+	"\x17HeartbeatSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"W\n" +
+	"\x18HeartbeatSessionResponse\x12;\n" +
+	"\asession\x18\x01 \x01(\v2!.rmqrpc.mockserver.api.v1.SessionR\asession\"4\n" +
+	"\x13CloseSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x16\n" +
+	"\x14CloseSessionResponse\"\x13\n" +
 	"\x11GetVersionRequest\"n\n" +
 	"\x12GetVersionResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
 	"\vcommit_hash\x18\x02 \x01(\tR\n" +
 	"commitHash\x12\x1d\n" +
 	"\n" +
-	"build_date\x18\x03 \x01(\tR\tbuildDate2\x8f\x0f\n" +
+	"build_date\x18\x03 \x01(\tR\tbuildDate*\xba\x01\n" +
+	"\x0eFallbackPolicy\x12\x1f\n" +
+	"\x1bFALLBACK_POLICY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FALLBACK_POLICY_REPLY\x10\x01\x12\x18\n" +
+	"\x14FALLBACK_POLICY_DROP\x10\x02\x12\x1a\n" +
+	"\x16FALLBACK_POLICY_REJECT\x10\x03\x12\x1b\n" +
+	"\x17FALLBACK_POLICY_REQUEUE\x10\x04\x12\x19\n" +
+	"\x15FALLBACK_POLICY_PROXY\x10\x05*C\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fACTION_REPLY\x10\x01\x12\x0f\n" +
+	"\vACTION_DROP\x10\x02*\x88\x03\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eEVENT_TYPE_EXPECTATION_CREATED\x10\x01\x12\"\n" +
+	"\x1eEVENT_TYPE_EXPECTATION_UPDATED\x10\x02\x12\"\n" +
+	"\x1eEVENT_TYPE_EXPECTATION_DELETED\x10\x03\x12\x1f\n" +
+	"\x1bEVENT_TYPE_EXPECTATION_USED\x10\x04\x12$\n" +
+	" EVENT_TYPE_EXPECTATION_EXHAUSTED\x10\x05\x12\"\n" +
+	"\x1eEVENT_TYPE_EXPECTATION_EXPIRED\x10\x06\x12\x1e\n" +
+	"\x1aEVENT_TYPE_REQUEST_MATCHED\x10\a\x12 \n" +
+	"\x1cEVENT_TYPE_REQUEST_UNMATCHED\x10\b\x12!\n" +
+	"\x1dEVENT_TYPE_SUBSCRIPTION_ADDED\x10\t\x12#\n" +
+	"\x1fEVENT_TYPE_SUBSCRIPTION_REMOVED\x10\n" +
+	"*`\n" +
+	"\fSequenceMode\x12\x1d\n" +
+	"\x19SEQUENCE_MODE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SEQUENCE_MODE_LOOSE\x10\x01\x12\x18\n" +
+	"\x14SEQUENCE_MODE_STRICT\x10\x022\xc63\n" +
 	"\x15AmqpMockServerService\x12\x9d\x01\n" +
 	"\x11CreateExpectation\x122.rmqrpc.mockserver.api.v1.CreateExpectationRequest\x1a3.rmqrpc.mockserver.api.v1.CreateExpectationResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/expectations\x12\x8c\x01\n" +
-	"\rGetAssertions\x12..rmqrpc.mockserver.api.v1.GetAssertionsRequest\x1a/.rmqrpc.mockserver.api.v1.GetAssertionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/assertions\x12\x94\x01\n" +
+	"\rGetAssertions\x12..rmqrpc.mockserver.api.v1.GetAssertionsRequest\x1a/.rmqrpc.mockserver.api.v1.GetAssertionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/assertions\x12\x98\x01\n" +
+	"\fGetAssertion\x12-.rmqrpc.mockserver.api.v1.GetAssertionRequest\x1a..rmqrpc.mockserver.api.v1.GetAssertionResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/assertions/{assertion_id}\x12\xa0\x01\n" +
+	"\x11WaitForAssertions\x122.rmqrpc.mockserver.api.v1.WaitForAssertionsRequest\x1a3.rmqrpc.mockserver.api.v1.WaitForAssertionsResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/assertions/wait\x12\x92\x01\n" +
+	"\x0fResetAssertions\x120.rmqrpc.mockserver.api.v1.ResetAssertionsRequest\x1a1.rmqrpc.mockserver.api.v1.ResetAssertionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/assertions\x12\xa1\x01\n" +
+	"\x12GetAssertionsStats\x123.rmqrpc.mockserver.api.v1.GetAssertionsStatsRequest\x1a4.rmqrpc.mockserver.api.v1.GetAssertionsStatsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/assertions/stats\x12`\n" +
+	"\vWatchEvents\x12,.rmqrpc.mockserver.api.v1.WatchEventsRequest\x1a\x1f.rmqrpc.mockserver.api.v1.Event\"\x000\x01\x12\xa1\x01\n" +
+	"\x12VerifyExpectations\x123.rmqrpc.mockserver.api.v1.VerifyExpectationsRequest\x1a4.rmqrpc.mockserver.api.v1.VerifyExpectationsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/verifications\x12\x9e\x01\n" +
+	"\x0eVerifySequence\x12/.rmqrpc.mockserver.api.v1.VerifySequenceRequest\x1a0.rmqrpc.mockserver.api.v1.VerifySequenceResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/verifications/sequence\x12\x94\x01\n" +
 	"\x0fGetExpectations\x120.rmqrpc.mockserver.api.v1.GetExpectationsRequest\x1a1.rmqrpc.mockserver.api.v1.GetExpectationsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/expectations\x12\xaf\x01\n" +
 	"\x0eGetExpectation\x12/.rmqrpc.mockserver.api.v1.GetExpectationRequest\x1a0.rmqrpc.mockserver.api.v1.GetExpectationResponse\":\x82\xd3\xe4\x93\x024b\vexpectation\x12%/api/v1/expectations/{expectation_id}\x12\x9a\x01\n" +
+	"\rSimulateMatch\x12..rmqrpc.mockserver.api.v1.SimulateMatchRequest\x1a/.rmqrpc.mockserver.api.v1.SimulateMatchResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/expectations/simulate\x12\xb8\x01\n" +
+	"\x11UpdateExpectation\x122.rmqrpc.mockserver.api.v1.UpdateExpectationRequest\x1a3.rmqrpc.mockserver.api.v1.UpdateExpectationResponse\":\x82\xd3\xe4\x93\x024:\vexpectation\x1a%/api/v1/expectations/{expectation_id}\x12\x9d\x01\n" +
+	"\x11UpsertExpectation\x122.rmqrpc.mockserver.api.v1.UpsertExpectationRequest\x1a3.rmqrpc.mockserver.api.v1.UpsertExpectationResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/v1/expectations\x12\xab\x01\n" +
+	"\x11DeleteExpectation\x122.rmqrpc.mockserver.api.v1.DeleteExpectationRequest\x1a3.rmqrpc.mockserver.api.v1.DeleteExpectationResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/expectations/{expectation_id}\x12\x9a\x01\n" +
 	"\x11ResetExpectations\x122.rmqrpc.mockserver.api.v1.ResetExpectationsRequest\x1a3.rmqrpc.mockserver.api.v1.ResetExpectationsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/expectations\x12\xa6\x01\n" +
 	"\x0fAddSubscription\x120.rmqrpc.mockserver.api.v1.AddSubscriptionRequest\x1a1.rmqrpc.mockserver.api.v1.AddSubscriptionResponse\".\x82\xd3\xe4\x93\x02(:\x01*b\fsubscription\"\x15/api/v1/subscriptions\x12\xb0\x01\n" +
 	"\x12DeleteSubscription\x123.rmqrpc.mockserver.api.v1.DeleteSubscriptionRequest\x1a4.rmqrpc.mockserver.api.v1.DeleteSubscriptionResponse\"/\x82\xd3\xe4\x93\x02)*'/api/v1/subscriptions/{subscription_id}\x12\xb3\x01\n" +
 	"\x14UnsubscribeFromQueue\x125.rmqrpc.mockserver.api.v1.UnsubscribeFromQueueRequest\x1a6.rmqrpc.mockserver.api.v1.UnsubscribeFromQueueResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/subscriptions/queues/{queue}\x12\xb0\x01\n" +
 	"\x13GetAllSubscriptions\x124.rmqrpc.mockserver.api.v1.GetAllSubscriptionsRequest\x1a5.rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse\",\x82\xd3\xe4\x93\x02&b\rsubscriptions\x12\x15/api/v1/subscriptions\x12\x9e\x01\n" +
-	"\x12ResetSubscriptions\x123.rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest\x1a4.rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/subscriptions\x12x\n" +
+	"\x12ResetSubscriptions\x123.rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest\x1a4.rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/subscriptions\x12\xab\x01\n" +
+	"\x12GetDefaultResponse\x123.rmqrpc.mockserver.api.v1.GetDefaultResponseRequest\x1a4.rmqrpc.mockserver.api.v1.GetDefaultResponseResponse\"*\x82\xd3\xe4\x93\x02$b\bresponse\x12\x18/api/v1/default-response\x12\xab\x01\n" +
+	"\x12SetDefaultResponse\x123.rmqrpc.mockserver.api.v1.SetDefaultResponseRequest\x1a4.rmqrpc.mockserver.api.v1.SetDefaultResponseResponse\"*\x82\xd3\xe4\x93\x02$:\bresponse\x1a\x18/api/v1/default-response\x12\xa7\x01\n" +
+	"\x14ResetDefaultResponse\x125.rmqrpc.mockserver.api.v1.ResetDefaultResponseRequest\x1a6.rmqrpc.mockserver.api.v1.ResetDefaultResponseResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/default-response\x12\x97\x01\n" +
+	"\x0eStartRecording\x12/.rmqrpc.mockserver.api.v1.StartRecordingRequest\x1a0.rmqrpc.mockserver.api.v1.StartRecordingResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/recording/start\x12\x93\x01\n" +
+	"\rStopRecording\x12..rmqrpc.mockserver.api.v1.StopRecordingRequest\x1a/.rmqrpc.mockserver.api.v1.StopRecordingResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/recording/stop\x12\x8c\x01\n" +
+	"\rGetRecordings\x12..rmqrpc.mockserver.api.v1.GetRecordingsRequest\x1a/.rmqrpc.mockserver.api.v1.GetRecordingsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/recordings\x12\x92\x01\n" +
+	"\x0fResetRecordings\x120.rmqrpc.mockserver.api.v1.ResetRecordingsRequest\x1a1.rmqrpc.mockserver.api.v1.ResetRecordingsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/recordings\x12\x88\x01\n" +
+	"\fGetScenarios\x12-.rmqrpc.mockserver.api.v1.GetScenariosRequest\x1a..rmqrpc.mockserver.api.v1.GetScenariosResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/scenarios\x12\x96\x01\n" +
+	"\vGetScenario\x12,.rmqrpc.mockserver.api.v1.GetScenarioRequest\x1a-.rmqrpc.mockserver.api.v1.GetScenarioResponse\"*\x82\xd3\xe4\x93\x02$b\bscenario\x12\x18/api/v1/scenarios/{name}\x12\xa4\x01\n" +
+	"\x10SetScenarioState\x121.rmqrpc.mockserver.api.v1.SetScenarioStateRequest\x1a2.rmqrpc.mockserver.api.v1.SetScenarioStateResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/scenarios/{name}/state\x12\x92\x01\n" +
+	"\rResetScenario\x12..rmqrpc.mockserver.api.v1.ResetScenarioRequest\x1a/.rmqrpc.mockserver.api.v1.ResetScenarioResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/scenarios/{name}\x12\x8e\x01\n" +
+	"\x0eResetScenarios\x12/.rmqrpc.mockserver.api.v1.ResetScenariosRequest\x1a0.rmqrpc.mockserver.api.v1.ResetScenariosResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/api/v1/scenarios\x12\x8f\x01\n" +
+	"\x0eListNamespaces\x12/.rmqrpc.mockserver.api.v1.ListNamespacesRequest\x1a0.rmqrpc.mockserver.api.v1.ListNamespacesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/namespaces\x12\x9e\x01\n" +
+	"\x0fDeleteNamespace\x120.rmqrpc.mockserver.api.v1.DeleteNamespaceRequest\x1a1.rmqrpc.mockserver.api.v1.DeleteNamespaceResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/namespaces/{namespace}\x12\x8d\x01\n" +
+	"\rCreateSession\x12..rmqrpc.mockserver.api.v1.CreateSessionRequest\x1a/.rmqrpc.mockserver.api.v1.CreateSessionResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/sessions\x12\x84\x01\n" +
+	"\vGetSessions\x12,.rmqrpc.mockserver.api.v1.GetSessionsRequest\x1a-.rmqrpc.mockserver.api.v1.GetSessionsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/sessions\x12\x97\x01\n" +
+	"\n" +
+	"GetSession\x12+.rmqrpc.mockserver.api.v1.GetSessionRequest\x1a,.rmqrpc.mockserver.api.v1.GetSessionResponse\".\x82\xd3\xe4\x93\x02(b\asession\x12\x1d/api/v1/sessions/{session_id}\x12\xaa\x01\n" +
+	"\x10HeartbeatSession\x121.rmqrpc.mockserver.api.v1.HeartbeatSessionRequest\x1a2.rmqrpc.mockserver.api.v1.HeartbeatSessionResponse\"/\x82\xd3\xe4\x93\x02)\"'/api/v1/sessions/{session_id}/heartbeat\x12\x94\x01\n" +
+	"\fCloseSession\x12-.rmqrpc.mockserver.api.v1.CloseSessionRequest\x1a..rmqrpc.mockserver.api.v1.CloseSessionResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/sessions/{session_id}\x12x\n" +
 	"\bResetAll\x12).rmqrpc.mockserver.api.v1.ResetAllRequest\x1a*.rmqrpc.mockserver.api.v1.ResetAllResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/api/v1/reset\x12\x80\x01\n" +
 	"\n" +
 	"GetVersion\x12+.rmqrpc.mockserver.api.v1.GetVersionRequest\x1a,.rmqrpc.mockserver.api.v1.GetVersionResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/versionB;Z9github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1;v1b\x06proto3"
@@ -1921,95 +7545,314 @@ func file_mockserver_proto_rawDescGZIP() []byte {
 	return file_mockserver_proto_rawDescData
 }
 
-var file_mockserver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mockserver_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_mockserver_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_mockserver_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
 var file_mockserver_proto_goTypes = []any{
-	(JSONBodyAssertion_MatchType)(0),     // 0: rmqrpc.mockserver.api.v1.JSONBodyAssertion.MatchType
-	(*Subscription)(nil),                 // 1: rmqrpc.mockserver.api.v1.Subscription
-	(*AddSubscriptionRequest)(nil),       // 2: rmqrpc.mockserver.api.v1.AddSubscriptionRequest
-	(*AddSubscriptionResponse)(nil),      // 3: rmqrpc.mockserver.api.v1.AddSubscriptionResponse
-	(*DeleteSubscriptionRequest)(nil),    // 4: rmqrpc.mockserver.api.v1.DeleteSubscriptionRequest
-	(*DeleteSubscriptionResponse)(nil),   // 5: rmqrpc.mockserver.api.v1.DeleteSubscriptionResponse
-	(*UnsubscribeFromQueueRequest)(nil),  // 6: rmqrpc.mockserver.api.v1.UnsubscribeFromQueueRequest
-	(*UnsubscribeFromQueueResponse)(nil), // 7: rmqrpc.mockserver.api.v1.UnsubscribeFromQueueResponse
-	(*GetAllSubscriptionsRequest)(nil),   // 8: rmqrpc.mockserver.api.v1.GetAllSubscriptionsRequest
-	(*GetAllSubscriptionsResponse)(nil),  // 9: rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse
-	(*JSONBodyAssertion)(nil),            // 10: rmqrpc.mockserver.api.v1.JSONBodyAssertion
-	(*RegexBodyAssertion)(nil),           // 11: rmqrpc.mockserver.api.v1.RegexBodyAssertion
-	(*Request)(nil),                      // 12: rmqrpc.mockserver.api.v1.Request
-	(*Response)(nil),                     // 13: rmqrpc.mockserver.api.v1.Response
-	(*Times)(nil),                        // 14: rmqrpc.mockserver.api.v1.Times
-	(*CreateExpectationRequest)(nil),     // 15: rmqrpc.mockserver.api.v1.CreateExpectationRequest
-	(*Expectation)(nil),                  // 16: rmqrpc.mockserver.api.v1.Expectation
-	(*Assertion)(nil),                    // 17: rmqrpc.mockserver.api.v1.Assertion
-	(*GetAssertionsRequest)(nil),         // 18: rmqrpc.mockserver.api.v1.GetAssertionsRequest
-	(*GetAssertionsResponse)(nil),        // 19: rmqrpc.mockserver.api.v1.GetAssertionsResponse
-	(*GetExpectationsRequest)(nil),       // 20: rmqrpc.mockserver.api.v1.GetExpectationsRequest
-	(*GetExpectationsResponse)(nil),      // 21: rmqrpc.mockserver.api.v1.GetExpectationsResponse
-	(*GetExpectationRequest)(nil),        // 22: rmqrpc.mockserver.api.v1.GetExpectationRequest
-	(*GetExpectationResponse)(nil),       // 23: rmqrpc.mockserver.api.v1.GetExpectationResponse
-	(*CreateExpectationResponse)(nil),    // 24: rmqrpc.mockserver.api.v1.CreateExpectationResponse
-	(*ResetExpectationsRequest)(nil),     // 25: rmqrpc.mockserver.api.v1.ResetExpectationsRequest
-	(*ResetExpectationsResponse)(nil),    // 26: rmqrpc.mockserver.api.v1.ResetExpectationsResponse
-	(*ResetSubscriptionsRequest)(nil),    // 27: rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest
-	(*ResetSubscriptionsResponse)(nil),   // 28: rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse
-	(*ResetAllRequest)(nil),              // 29: rmqrpc.mockserver.api.v1.ResetAllRequest
-	(*ResetAllResponse)(nil),             // 30: rmqrpc.mockserver.api.v1.ResetAllResponse
-	(*GetVersionRequest)(nil),            // 31: rmqrpc.mockserver.api.v1.GetVersionRequest
-	(*GetVersionResponse)(nil),           // 32: rmqrpc.mockserver.api.v1.GetVersionResponse
-	(*Assertion_Candidate)(nil),          // 33: rmqrpc.mockserver.api.v1.Assertion.Candidate
-	(*structpb.Struct)(nil),              // 34: google.protobuf.Struct
-	(*structpb.Value)(nil),               // 35: google.protobuf.Value
+	(FallbackPolicy)(0),                          // 0: rmqrpc.mockserver.api.v1.FallbackPolicy
+	(Action)(0),                                  // 1: rmqrpc.mockserver.api.v1.Action
+	(EventType)(0),                               // 2: rmqrpc.mockserver.api.v1.EventType
+	(SequenceMode)(0),                            // 3: rmqrpc.mockserver.api.v1.SequenceMode
+	(JSONBodyAssertion_MatchType)(0),             // 4: rmqrpc.mockserver.api.v1.JSONBodyAssertion.MatchType
+	(ValueAssertion_MatchType)(0),                // 5: rmqrpc.mockserver.api.v1.ValueAssertion.MatchType
+	(Request_ExchangeMatchType)(0),               // 6: rmqrpc.mockserver.api.v1.Request.ExchangeMatchType
+	(Request_RoutingKeyMatchType)(0),             // 7: rmqrpc.mockserver.api.v1.Request.RoutingKeyMatchType
+	(*ProxyTarget)(nil),                          // 8: rmqrpc.mockserver.api.v1.ProxyTarget
+	(*Subscription)(nil),                         // 9: rmqrpc.mockserver.api.v1.Subscription
+	(*AddSubscriptionRequest)(nil),               // 10: rmqrpc.mockserver.api.v1.AddSubscriptionRequest
+	(*AddSubscriptionResponse)(nil),              // 11: rmqrpc.mockserver.api.v1.AddSubscriptionResponse
+	(*DeleteSubscriptionRequest)(nil),            // 12: rmqrpc.mockserver.api.v1.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),           // 13: rmqrpc.mockserver.api.v1.DeleteSubscriptionResponse
+	(*UnsubscribeFromQueueRequest)(nil),          // 14: rmqrpc.mockserver.api.v1.UnsubscribeFromQueueRequest
+	(*UnsubscribeFromQueueResponse)(nil),         // 15: rmqrpc.mockserver.api.v1.UnsubscribeFromQueueResponse
+	(*GetAllSubscriptionsRequest)(nil),           // 16: rmqrpc.mockserver.api.v1.GetAllSubscriptionsRequest
+	(*GetAllSubscriptionsResponse)(nil),          // 17: rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse
+	(*JSONBodyAssertion)(nil),                    // 18: rmqrpc.mockserver.api.v1.JSONBodyAssertion
+	(*RegexBodyAssertion)(nil),                   // 19: rmqrpc.mockserver.api.v1.RegexBodyAssertion
+	(*ValueAssertion)(nil),                       // 20: rmqrpc.mockserver.api.v1.ValueAssertion
+	(*Request)(nil),                              // 21: rmqrpc.mockserver.api.v1.Request
+	(*MessageProperties)(nil),                    // 22: rmqrpc.mockserver.api.v1.MessageProperties
+	(*Response)(nil),                             // 23: rmqrpc.mockserver.api.v1.Response
+	(*Times)(nil),                                // 24: rmqrpc.mockserver.api.v1.Times
+	(*Delay)(nil),                                // 25: rmqrpc.mockserver.api.v1.Delay
+	(*Scenario)(nil),                             // 26: rmqrpc.mockserver.api.v1.Scenario
+	(*ScenarioState)(nil),                        // 27: rmqrpc.mockserver.api.v1.ScenarioState
+	(*CreateExpectationRequest)(nil),             // 28: rmqrpc.mockserver.api.v1.CreateExpectationRequest
+	(*Expectation)(nil),                          // 29: rmqrpc.mockserver.api.v1.Expectation
+	(*Assertion)(nil),                            // 30: rmqrpc.mockserver.api.v1.Assertion
+	(*GetAssertionsRequest)(nil),                 // 31: rmqrpc.mockserver.api.v1.GetAssertionsRequest
+	(*GetAssertionsResponse)(nil),                // 32: rmqrpc.mockserver.api.v1.GetAssertionsResponse
+	(*GetAssertionRequest)(nil),                  // 33: rmqrpc.mockserver.api.v1.GetAssertionRequest
+	(*GetAssertionResponse)(nil),                 // 34: rmqrpc.mockserver.api.v1.GetAssertionResponse
+	(*WaitForAssertionsRequest)(nil),             // 35: rmqrpc.mockserver.api.v1.WaitForAssertionsRequest
+	(*WaitForAssertionsResponse)(nil),            // 36: rmqrpc.mockserver.api.v1.WaitForAssertionsResponse
+	(*ResetAssertionsRequest)(nil),               // 37: rmqrpc.mockserver.api.v1.ResetAssertionsRequest
+	(*ResetAssertionsResponse)(nil),              // 38: rmqrpc.mockserver.api.v1.ResetAssertionsResponse
+	(*GetAssertionsStatsRequest)(nil),            // 39: rmqrpc.mockserver.api.v1.GetAssertionsStatsRequest
+	(*GetAssertionsStatsResponse)(nil),           // 40: rmqrpc.mockserver.api.v1.GetAssertionsStatsResponse
+	(*WatchEventsRequest)(nil),                   // 41: rmqrpc.mockserver.api.v1.WatchEventsRequest
+	(*Event)(nil),                                // 42: rmqrpc.mockserver.api.v1.Event
+	(*VerifyExpectationsRequest)(nil),            // 43: rmqrpc.mockserver.api.v1.VerifyExpectationsRequest
+	(*Verification)(nil),                         // 44: rmqrpc.mockserver.api.v1.Verification
+	(*VerificationTimes)(nil),                    // 45: rmqrpc.mockserver.api.v1.VerificationTimes
+	(*VerifyExpectationsResponse)(nil),           // 46: rmqrpc.mockserver.api.v1.VerifyExpectationsResponse
+	(*VerificationResult)(nil),                   // 47: rmqrpc.mockserver.api.v1.VerificationResult
+	(*VerifySequenceRequest)(nil),                // 48: rmqrpc.mockserver.api.v1.VerifySequenceRequest
+	(*VerifySequenceResponse)(nil),               // 49: rmqrpc.mockserver.api.v1.VerifySequenceResponse
+	(*SimulateMatchRequest)(nil),                 // 50: rmqrpc.mockserver.api.v1.SimulateMatchRequest
+	(*SimulateMatchResponse)(nil),                // 51: rmqrpc.mockserver.api.v1.SimulateMatchResponse
+	(*GetExpectationsRequest)(nil),               // 52: rmqrpc.mockserver.api.v1.GetExpectationsRequest
+	(*GetExpectationsResponse)(nil),              // 53: rmqrpc.mockserver.api.v1.GetExpectationsResponse
+	(*GetExpectationRequest)(nil),                // 54: rmqrpc.mockserver.api.v1.GetExpectationRequest
+	(*GetExpectationResponse)(nil),               // 55: rmqrpc.mockserver.api.v1.GetExpectationResponse
+	(*CreateExpectationResponse)(nil),            // 56: rmqrpc.mockserver.api.v1.CreateExpectationResponse
+	(*UpdateExpectationRequest)(nil),             // 57: rmqrpc.mockserver.api.v1.UpdateExpectationRequest
+	(*UpdateExpectationResponse)(nil),            // 58: rmqrpc.mockserver.api.v1.UpdateExpectationResponse
+	(*UpsertExpectationRequest)(nil),             // 59: rmqrpc.mockserver.api.v1.UpsertExpectationRequest
+	(*UpsertExpectationResponse)(nil),            // 60: rmqrpc.mockserver.api.v1.UpsertExpectationResponse
+	(*DeleteExpectationRequest)(nil),             // 61: rmqrpc.mockserver.api.v1.DeleteExpectationRequest
+	(*DeleteExpectationResponse)(nil),            // 62: rmqrpc.mockserver.api.v1.DeleteExpectationResponse
+	(*ResetExpectationsRequest)(nil),             // 63: rmqrpc.mockserver.api.v1.ResetExpectationsRequest
+	(*ResetExpectationsResponse)(nil),            // 64: rmqrpc.mockserver.api.v1.ResetExpectationsResponse
+	(*GetDefaultResponseRequest)(nil),            // 65: rmqrpc.mockserver.api.v1.GetDefaultResponseRequest
+	(*GetDefaultResponseResponse)(nil),           // 66: rmqrpc.mockserver.api.v1.GetDefaultResponseResponse
+	(*SetDefaultResponseRequest)(nil),            // 67: rmqrpc.mockserver.api.v1.SetDefaultResponseRequest
+	(*SetDefaultResponseResponse)(nil),           // 68: rmqrpc.mockserver.api.v1.SetDefaultResponseResponse
+	(*ResetDefaultResponseRequest)(nil),          // 69: rmqrpc.mockserver.api.v1.ResetDefaultResponseRequest
+	(*ResetDefaultResponseResponse)(nil),         // 70: rmqrpc.mockserver.api.v1.ResetDefaultResponseResponse
+	(*StartRecordingRequest)(nil),                // 71: rmqrpc.mockserver.api.v1.StartRecordingRequest
+	(*StartRecordingResponse)(nil),               // 72: rmqrpc.mockserver.api.v1.StartRecordingResponse
+	(*StopRecordingRequest)(nil),                 // 73: rmqrpc.mockserver.api.v1.StopRecordingRequest
+	(*StopRecordingResponse)(nil),                // 74: rmqrpc.mockserver.api.v1.StopRecordingResponse
+	(*GetRecordingsRequest)(nil),                 // 75: rmqrpc.mockserver.api.v1.GetRecordingsRequest
+	(*GetRecordingsResponse)(nil),                // 76: rmqrpc.mockserver.api.v1.GetRecordingsResponse
+	(*ResetRecordingsRequest)(nil),               // 77: rmqrpc.mockserver.api.v1.ResetRecordingsRequest
+	(*ResetRecordingsResponse)(nil),              // 78: rmqrpc.mockserver.api.v1.ResetRecordingsResponse
+	(*GetScenariosRequest)(nil),                  // 79: rmqrpc.mockserver.api.v1.GetScenariosRequest
+	(*GetScenariosResponse)(nil),                 // 80: rmqrpc.mockserver.api.v1.GetScenariosResponse
+	(*GetScenarioRequest)(nil),                   // 81: rmqrpc.mockserver.api.v1.GetScenarioRequest
+	(*GetScenarioResponse)(nil),                  // 82: rmqrpc.mockserver.api.v1.GetScenarioResponse
+	(*SetScenarioStateRequest)(nil),              // 83: rmqrpc.mockserver.api.v1.SetScenarioStateRequest
+	(*SetScenarioStateResponse)(nil),             // 84: rmqrpc.mockserver.api.v1.SetScenarioStateResponse
+	(*ResetScenarioRequest)(nil),                 // 85: rmqrpc.mockserver.api.v1.ResetScenarioRequest
+	(*ResetScenarioResponse)(nil),                // 86: rmqrpc.mockserver.api.v1.ResetScenarioResponse
+	(*ResetScenariosRequest)(nil),                // 87: rmqrpc.mockserver.api.v1.ResetScenariosRequest
+	(*ResetScenariosResponse)(nil),               // 88: rmqrpc.mockserver.api.v1.ResetScenariosResponse
+	(*ResetSubscriptionsRequest)(nil),            // 89: rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest
+	(*ResetSubscriptionsResponse)(nil),           // 90: rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse
+	(*ResetAllRequest)(nil),                      // 91: rmqrpc.mockserver.api.v1.ResetAllRequest
+	(*ResetAllResponse)(nil),                     // 92: rmqrpc.mockserver.api.v1.ResetAllResponse
+	(*ListNamespacesRequest)(nil),                // 93: rmqrpc.mockserver.api.v1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),               // 94: rmqrpc.mockserver.api.v1.ListNamespacesResponse
+	(*DeleteNamespaceRequest)(nil),               // 95: rmqrpc.mockserver.api.v1.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),              // 96: rmqrpc.mockserver.api.v1.DeleteNamespaceResponse
+	(*Session)(nil),                              // 97: rmqrpc.mockserver.api.v1.Session
+	(*CreateSessionRequest)(nil),                 // 98: rmqrpc.mockserver.api.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                // 99: rmqrpc.mockserver.api.v1.CreateSessionResponse
+	(*GetSessionsRequest)(nil),                   // 100: rmqrpc.mockserver.api.v1.GetSessionsRequest
+	(*GetSessionsResponse)(nil),                  // 101: rmqrpc.mockserver.api.v1.GetSessionsResponse
+	(*GetSessionRequest)(nil),                    // 102: rmqrpc.mockserver.api.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),                   // 103: rmqrpc.mockserver.api.v1.GetSessionResponse
+	(*HeartbeatSessionRequest)(nil),              // 104: rmqrpc.mockserver.api.v1.HeartbeatSessionRequest
+	(*HeartbeatSessionResponse)(nil),             // 105: rmqrpc.mockserver.api.v1.HeartbeatSessionResponse
+	(*CloseSessionRequest)(nil),                  // 106: rmqrpc.mockserver.api.v1.CloseSessionRequest
+	(*CloseSessionResponse)(nil),                 // 107: rmqrpc.mockserver.api.v1.CloseSessionResponse
+	(*GetVersionRequest)(nil),                    // 108: rmqrpc.mockserver.api.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),                   // 109: rmqrpc.mockserver.api.v1.GetVersionResponse
+	nil,                                          // 110: rmqrpc.mockserver.api.v1.Request.HeadersEntry
+	nil,                                          // 111: rmqrpc.mockserver.api.v1.Request.PropertiesEntry
+	nil,                                          // 112: rmqrpc.mockserver.api.v1.Response.HeadersEntry
+	(*Delay_UniformDelay)(nil),                   // 113: rmqrpc.mockserver.api.v1.Delay.UniformDelay
+	(*Delay_LogNormalDelay)(nil),                 // 114: rmqrpc.mockserver.api.v1.Delay.LogNormalDelay
+	nil,                                          // 115: rmqrpc.mockserver.api.v1.CreateExpectationRequest.LabelsEntry
+	nil,                                          // 116: rmqrpc.mockserver.api.v1.Expectation.LabelsEntry
+	(*Assertion_ProxyResult)(nil),                // 117: rmqrpc.mockserver.api.v1.Assertion.ProxyResult
+	(*Assertion_NearMiss)(nil),                   // 118: rmqrpc.mockserver.api.v1.Assertion.NearMiss
+	(*Assertion_Reply)(nil),                      // 119: rmqrpc.mockserver.api.v1.Assertion.Reply
+	(*Assertion_Delivery)(nil),                   // 120: rmqrpc.mockserver.api.v1.Assertion.Delivery
+	(*Assertion_Candidate)(nil),                  // 121: rmqrpc.mockserver.api.v1.Assertion.Candidate
+	nil,                                          // 122: rmqrpc.mockserver.api.v1.Assertion.Reply.HeadersEntry
+	nil,                                          // 123: rmqrpc.mockserver.api.v1.Assertion.Candidate.HeadersEntry
+	nil,                                          // 124: rmqrpc.mockserver.api.v1.GetAssertionsRequest.HeadersEntry
+	(*VerifySequenceResponse_SequenceEntry)(nil), // 125: rmqrpc.mockserver.api.v1.VerifySequenceResponse.SequenceEntry
+	nil, // 126: rmqrpc.mockserver.api.v1.SimulateMatchRequest.HeadersEntry
+	(*SimulateMatchResponse_SkippedExpectation)(nil), // 127: rmqrpc.mockserver.api.v1.SimulateMatchResponse.SkippedExpectation
+	(*structpb.Struct)(nil),                          // 128: google.protobuf.Struct
+	(*structpb.Value)(nil),                           // 129: google.protobuf.Value
 }
 var file_mockserver_proto_depIdxs = []int32{
-	1,  // 0: rmqrpc.mockserver.api.v1.AddSubscriptionResponse.subscription:type_name -> rmqrpc.mockserver.api.v1.Subscription
-	1,  // 1: rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse.subscriptions:type_name -> rmqrpc.mockserver.api.v1.Subscription
-	34, // 2: rmqrpc.mockserver.api.v1.JSONBodyAssertion.body:type_name -> google.protobuf.Struct
-	0,  // 3: rmqrpc.mockserver.api.v1.JSONBodyAssertion.match_type:type_name -> rmqrpc.mockserver.api.v1.JSONBodyAssertion.MatchType
-	10, // 4: rmqrpc.mockserver.api.v1.Request.json_body:type_name -> rmqrpc.mockserver.api.v1.JSONBodyAssertion
-	11, // 5: rmqrpc.mockserver.api.v1.Request.regex_body:type_name -> rmqrpc.mockserver.api.v1.RegexBodyAssertion
-	35, // 6: rmqrpc.mockserver.api.v1.Response.body:type_name -> google.protobuf.Value
-	12, // 7: rmqrpc.mockserver.api.v1.CreateExpectationRequest.request:type_name -> rmqrpc.mockserver.api.v1.Request
-	13, // 8: rmqrpc.mockserver.api.v1.CreateExpectationRequest.response:type_name -> rmqrpc.mockserver.api.v1.Response
-	14, // 9: rmqrpc.mockserver.api.v1.CreateExpectationRequest.times:type_name -> rmqrpc.mockserver.api.v1.Times
-	12, // 10: rmqrpc.mockserver.api.v1.Expectation.request:type_name -> rmqrpc.mockserver.api.v1.Request
-	13, // 11: rmqrpc.mockserver.api.v1.Expectation.response:type_name -> rmqrpc.mockserver.api.v1.Response
-	14, // 12: rmqrpc.mockserver.api.v1.Expectation.times:type_name -> rmqrpc.mockserver.api.v1.Times
-	33, // 13: rmqrpc.mockserver.api.v1.Assertion.candidate:type_name -> rmqrpc.mockserver.api.v1.Assertion.Candidate
-	16, // 14: rmqrpc.mockserver.api.v1.Assertion.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
-	17, // 15: rmqrpc.mockserver.api.v1.GetAssertionsResponse.assertions:type_name -> rmqrpc.mockserver.api.v1.Assertion
-	16, // 16: rmqrpc.mockserver.api.v1.GetExpectationsResponse.expectations:type_name -> rmqrpc.mockserver.api.v1.Expectation
-	16, // 17: rmqrpc.mockserver.api.v1.GetExpectationResponse.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
-	34, // 18: rmqrpc.mockserver.api.v1.Assertion.Candidate.body:type_name -> google.protobuf.Struct
-	15, // 19: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateExpectation:input_type -> rmqrpc.mockserver.api.v1.CreateExpectationRequest
-	18, // 20: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertions:input_type -> rmqrpc.mockserver.api.v1.GetAssertionsRequest
-	20, // 21: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectations:input_type -> rmqrpc.mockserver.api.v1.GetExpectationsRequest
-	22, // 22: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectation:input_type -> rmqrpc.mockserver.api.v1.GetExpectationRequest
-	25, // 23: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetExpectations:input_type -> rmqrpc.mockserver.api.v1.ResetExpectationsRequest
-	2,  // 24: rmqrpc.mockserver.api.v1.AmqpMockServerService.AddSubscription:input_type -> rmqrpc.mockserver.api.v1.AddSubscriptionRequest
-	4,  // 25: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteSubscription:input_type -> rmqrpc.mockserver.api.v1.DeleteSubscriptionRequest
-	6,  // 26: rmqrpc.mockserver.api.v1.AmqpMockServerService.UnsubscribeFromQueue:input_type -> rmqrpc.mockserver.api.v1.UnsubscribeFromQueueRequest
-	8,  // 27: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAllSubscriptions:input_type -> rmqrpc.mockserver.api.v1.GetAllSubscriptionsRequest
-	27, // 28: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetSubscriptions:input_type -> rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest
-	29, // 29: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAll:input_type -> rmqrpc.mockserver.api.v1.ResetAllRequest
-	31, // 30: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetVersion:input_type -> rmqrpc.mockserver.api.v1.GetVersionRequest
-	24, // 31: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateExpectation:output_type -> rmqrpc.mockserver.api.v1.CreateExpectationResponse
-	19, // 32: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertions:output_type -> rmqrpc.mockserver.api.v1.GetAssertionsResponse
-	21, // 33: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectations:output_type -> rmqrpc.mockserver.api.v1.GetExpectationsResponse
-	23, // 34: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectation:output_type -> rmqrpc.mockserver.api.v1.GetExpectationResponse
-	26, // 35: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetExpectations:output_type -> rmqrpc.mockserver.api.v1.ResetExpectationsResponse
-	3,  // 36: rmqrpc.mockserver.api.v1.AmqpMockServerService.AddSubscription:output_type -> rmqrpc.mockserver.api.v1.AddSubscriptionResponse
-	5,  // 37: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteSubscription:output_type -> rmqrpc.mockserver.api.v1.DeleteSubscriptionResponse
-	7,  // 38: rmqrpc.mockserver.api.v1.AmqpMockServerService.UnsubscribeFromQueue:output_type -> rmqrpc.mockserver.api.v1.UnsubscribeFromQueueResponse
-	9,  // 39: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAllSubscriptions:output_type -> rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse
-	28, // 40: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetSubscriptions:output_type -> rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse
-	30, // 41: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAll:output_type -> rmqrpc.mockserver.api.v1.ResetAllResponse
-	32, // 42: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetVersion:output_type -> rmqrpc.mockserver.api.v1.GetVersionResponse
-	31, // [31:43] is the sub-list for method output_type
-	19, // [19:31] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	0,   // 0: rmqrpc.mockserver.api.v1.Subscription.fallback_policy:type_name -> rmqrpc.mockserver.api.v1.FallbackPolicy
+	8,   // 1: rmqrpc.mockserver.api.v1.Subscription.proxy_target:type_name -> rmqrpc.mockserver.api.v1.ProxyTarget
+	0,   // 2: rmqrpc.mockserver.api.v1.AddSubscriptionRequest.fallback_policy:type_name -> rmqrpc.mockserver.api.v1.FallbackPolicy
+	8,   // 3: rmqrpc.mockserver.api.v1.AddSubscriptionRequest.proxy_target:type_name -> rmqrpc.mockserver.api.v1.ProxyTarget
+	9,   // 4: rmqrpc.mockserver.api.v1.AddSubscriptionResponse.subscription:type_name -> rmqrpc.mockserver.api.v1.Subscription
+	9,   // 5: rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse.subscriptions:type_name -> rmqrpc.mockserver.api.v1.Subscription
+	128, // 6: rmqrpc.mockserver.api.v1.JSONBodyAssertion.body:type_name -> google.protobuf.Struct
+	4,   // 7: rmqrpc.mockserver.api.v1.JSONBodyAssertion.match_type:type_name -> rmqrpc.mockserver.api.v1.JSONBodyAssertion.MatchType
+	5,   // 8: rmqrpc.mockserver.api.v1.ValueAssertion.match_type:type_name -> rmqrpc.mockserver.api.v1.ValueAssertion.MatchType
+	18,  // 9: rmqrpc.mockserver.api.v1.Request.json_body:type_name -> rmqrpc.mockserver.api.v1.JSONBodyAssertion
+	19,  // 10: rmqrpc.mockserver.api.v1.Request.regex_body:type_name -> rmqrpc.mockserver.api.v1.RegexBodyAssertion
+	110, // 11: rmqrpc.mockserver.api.v1.Request.headers:type_name -> rmqrpc.mockserver.api.v1.Request.HeadersEntry
+	111, // 12: rmqrpc.mockserver.api.v1.Request.properties:type_name -> rmqrpc.mockserver.api.v1.Request.PropertiesEntry
+	6,   // 13: rmqrpc.mockserver.api.v1.Request.exchange_match_type:type_name -> rmqrpc.mockserver.api.v1.Request.ExchangeMatchType
+	7,   // 14: rmqrpc.mockserver.api.v1.Request.routing_key_match_type:type_name -> rmqrpc.mockserver.api.v1.Request.RoutingKeyMatchType
+	129, // 15: rmqrpc.mockserver.api.v1.Response.body:type_name -> google.protobuf.Value
+	112, // 16: rmqrpc.mockserver.api.v1.Response.headers:type_name -> rmqrpc.mockserver.api.v1.Response.HeadersEntry
+	113, // 17: rmqrpc.mockserver.api.v1.Delay.uniform:type_name -> rmqrpc.mockserver.api.v1.Delay.UniformDelay
+	114, // 18: rmqrpc.mockserver.api.v1.Delay.log_normal:type_name -> rmqrpc.mockserver.api.v1.Delay.LogNormalDelay
+	21,  // 19: rmqrpc.mockserver.api.v1.CreateExpectationRequest.request:type_name -> rmqrpc.mockserver.api.v1.Request
+	23,  // 20: rmqrpc.mockserver.api.v1.CreateExpectationRequest.response:type_name -> rmqrpc.mockserver.api.v1.Response
+	24,  // 21: rmqrpc.mockserver.api.v1.CreateExpectationRequest.times:type_name -> rmqrpc.mockserver.api.v1.Times
+	25,  // 22: rmqrpc.mockserver.api.v1.CreateExpectationRequest.delay:type_name -> rmqrpc.mockserver.api.v1.Delay
+	1,   // 23: rmqrpc.mockserver.api.v1.CreateExpectationRequest.action:type_name -> rmqrpc.mockserver.api.v1.Action
+	26,  // 24: rmqrpc.mockserver.api.v1.CreateExpectationRequest.scenario:type_name -> rmqrpc.mockserver.api.v1.Scenario
+	115, // 25: rmqrpc.mockserver.api.v1.CreateExpectationRequest.labels:type_name -> rmqrpc.mockserver.api.v1.CreateExpectationRequest.LabelsEntry
+	21,  // 26: rmqrpc.mockserver.api.v1.Expectation.request:type_name -> rmqrpc.mockserver.api.v1.Request
+	23,  // 27: rmqrpc.mockserver.api.v1.Expectation.response:type_name -> rmqrpc.mockserver.api.v1.Response
+	24,  // 28: rmqrpc.mockserver.api.v1.Expectation.times:type_name -> rmqrpc.mockserver.api.v1.Times
+	25,  // 29: rmqrpc.mockserver.api.v1.Expectation.delay:type_name -> rmqrpc.mockserver.api.v1.Delay
+	1,   // 30: rmqrpc.mockserver.api.v1.Expectation.action:type_name -> rmqrpc.mockserver.api.v1.Action
+	26,  // 31: rmqrpc.mockserver.api.v1.Expectation.scenario:type_name -> rmqrpc.mockserver.api.v1.Scenario
+	116, // 32: rmqrpc.mockserver.api.v1.Expectation.labels:type_name -> rmqrpc.mockserver.api.v1.Expectation.LabelsEntry
+	121, // 33: rmqrpc.mockserver.api.v1.Assertion.candidate:type_name -> rmqrpc.mockserver.api.v1.Assertion.Candidate
+	29,  // 34: rmqrpc.mockserver.api.v1.Assertion.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	117, // 35: rmqrpc.mockserver.api.v1.Assertion.proxy:type_name -> rmqrpc.mockserver.api.v1.Assertion.ProxyResult
+	119, // 36: rmqrpc.mockserver.api.v1.Assertion.reply:type_name -> rmqrpc.mockserver.api.v1.Assertion.Reply
+	118, // 37: rmqrpc.mockserver.api.v1.Assertion.near_misses:type_name -> rmqrpc.mockserver.api.v1.Assertion.NearMiss
+	124, // 38: rmqrpc.mockserver.api.v1.GetAssertionsRequest.headers:type_name -> rmqrpc.mockserver.api.v1.GetAssertionsRequest.HeadersEntry
+	30,  // 39: rmqrpc.mockserver.api.v1.GetAssertionsResponse.assertions:type_name -> rmqrpc.mockserver.api.v1.Assertion
+	30,  // 40: rmqrpc.mockserver.api.v1.GetAssertionResponse.assertion:type_name -> rmqrpc.mockserver.api.v1.Assertion
+	18,  // 41: rmqrpc.mockserver.api.v1.WaitForAssertionsRequest.json_body:type_name -> rmqrpc.mockserver.api.v1.JSONBodyAssertion
+	19,  // 42: rmqrpc.mockserver.api.v1.WaitForAssertionsRequest.regex_body:type_name -> rmqrpc.mockserver.api.v1.RegexBodyAssertion
+	30,  // 43: rmqrpc.mockserver.api.v1.WaitForAssertionsResponse.assertions:type_name -> rmqrpc.mockserver.api.v1.Assertion
+	2,   // 44: rmqrpc.mockserver.api.v1.WatchEventsRequest.types:type_name -> rmqrpc.mockserver.api.v1.EventType
+	2,   // 45: rmqrpc.mockserver.api.v1.Event.type:type_name -> rmqrpc.mockserver.api.v1.EventType
+	121, // 46: rmqrpc.mockserver.api.v1.Event.candidate:type_name -> rmqrpc.mockserver.api.v1.Assertion.Candidate
+	44,  // 47: rmqrpc.mockserver.api.v1.VerifyExpectationsRequest.verifications:type_name -> rmqrpc.mockserver.api.v1.Verification
+	21,  // 48: rmqrpc.mockserver.api.v1.Verification.request:type_name -> rmqrpc.mockserver.api.v1.Request
+	45,  // 49: rmqrpc.mockserver.api.v1.Verification.times:type_name -> rmqrpc.mockserver.api.v1.VerificationTimes
+	47,  // 50: rmqrpc.mockserver.api.v1.VerifyExpectationsResponse.results:type_name -> rmqrpc.mockserver.api.v1.VerificationResult
+	21,  // 51: rmqrpc.mockserver.api.v1.VerifySequenceRequest.steps:type_name -> rmqrpc.mockserver.api.v1.Request
+	3,   // 52: rmqrpc.mockserver.api.v1.VerifySequenceRequest.mode:type_name -> rmqrpc.mockserver.api.v1.SequenceMode
+	125, // 53: rmqrpc.mockserver.api.v1.VerifySequenceResponse.actual_sequence:type_name -> rmqrpc.mockserver.api.v1.VerifySequenceResponse.SequenceEntry
+	129, // 54: rmqrpc.mockserver.api.v1.SimulateMatchRequest.body:type_name -> google.protobuf.Value
+	126, // 55: rmqrpc.mockserver.api.v1.SimulateMatchRequest.headers:type_name -> rmqrpc.mockserver.api.v1.SimulateMatchRequest.HeadersEntry
+	22,  // 56: rmqrpc.mockserver.api.v1.SimulateMatchRequest.properties:type_name -> rmqrpc.mockserver.api.v1.MessageProperties
+	29,  // 57: rmqrpc.mockserver.api.v1.SimulateMatchResponse.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	29,  // 58: rmqrpc.mockserver.api.v1.SimulateMatchResponse.matches:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	127, // 59: rmqrpc.mockserver.api.v1.SimulateMatchResponse.skipped:type_name -> rmqrpc.mockserver.api.v1.SimulateMatchResponse.SkippedExpectation
+	29,  // 60: rmqrpc.mockserver.api.v1.GetExpectationsResponse.expectations:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	29,  // 61: rmqrpc.mockserver.api.v1.GetExpectationResponse.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	28,  // 62: rmqrpc.mockserver.api.v1.UpdateExpectationRequest.expectation:type_name -> rmqrpc.mockserver.api.v1.CreateExpectationRequest
+	28,  // 63: rmqrpc.mockserver.api.v1.UpsertExpectationRequest.expectation:type_name -> rmqrpc.mockserver.api.v1.CreateExpectationRequest
+	23,  // 64: rmqrpc.mockserver.api.v1.GetDefaultResponseResponse.response:type_name -> rmqrpc.mockserver.api.v1.Response
+	23,  // 65: rmqrpc.mockserver.api.v1.SetDefaultResponseRequest.response:type_name -> rmqrpc.mockserver.api.v1.Response
+	8,   // 66: rmqrpc.mockserver.api.v1.StartRecordingRequest.target:type_name -> rmqrpc.mockserver.api.v1.ProxyTarget
+	28,  // 67: rmqrpc.mockserver.api.v1.GetRecordingsResponse.expectations:type_name -> rmqrpc.mockserver.api.v1.CreateExpectationRequest
+	27,  // 68: rmqrpc.mockserver.api.v1.GetScenariosResponse.scenarios:type_name -> rmqrpc.mockserver.api.v1.ScenarioState
+	27,  // 69: rmqrpc.mockserver.api.v1.GetScenarioResponse.scenario:type_name -> rmqrpc.mockserver.api.v1.ScenarioState
+	97,  // 70: rmqrpc.mockserver.api.v1.CreateSessionResponse.session:type_name -> rmqrpc.mockserver.api.v1.Session
+	97,  // 71: rmqrpc.mockserver.api.v1.GetSessionsResponse.sessions:type_name -> rmqrpc.mockserver.api.v1.Session
+	97,  // 72: rmqrpc.mockserver.api.v1.GetSessionResponse.session:type_name -> rmqrpc.mockserver.api.v1.Session
+	97,  // 73: rmqrpc.mockserver.api.v1.HeartbeatSessionResponse.session:type_name -> rmqrpc.mockserver.api.v1.Session
+	20,  // 74: rmqrpc.mockserver.api.v1.Request.HeadersEntry.value:type_name -> rmqrpc.mockserver.api.v1.ValueAssertion
+	20,  // 75: rmqrpc.mockserver.api.v1.Request.PropertiesEntry.value:type_name -> rmqrpc.mockserver.api.v1.ValueAssertion
+	29,  // 76: rmqrpc.mockserver.api.v1.Assertion.NearMiss.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	122, // 77: rmqrpc.mockserver.api.v1.Assertion.Reply.headers:type_name -> rmqrpc.mockserver.api.v1.Assertion.Reply.HeadersEntry
+	22,  // 78: rmqrpc.mockserver.api.v1.Assertion.Reply.properties:type_name -> rmqrpc.mockserver.api.v1.MessageProperties
+	128, // 79: rmqrpc.mockserver.api.v1.Assertion.Candidate.body:type_name -> google.protobuf.Struct
+	123, // 80: rmqrpc.mockserver.api.v1.Assertion.Candidate.headers:type_name -> rmqrpc.mockserver.api.v1.Assertion.Candidate.HeadersEntry
+	22,  // 81: rmqrpc.mockserver.api.v1.Assertion.Candidate.properties:type_name -> rmqrpc.mockserver.api.v1.MessageProperties
+	120, // 82: rmqrpc.mockserver.api.v1.Assertion.Candidate.delivery:type_name -> rmqrpc.mockserver.api.v1.Assertion.Delivery
+	29,  // 83: rmqrpc.mockserver.api.v1.SimulateMatchResponse.SkippedExpectation.expectation:type_name -> rmqrpc.mockserver.api.v1.Expectation
+	28,  // 84: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateExpectation:input_type -> rmqrpc.mockserver.api.v1.CreateExpectationRequest
+	31,  // 85: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertions:input_type -> rmqrpc.mockserver.api.v1.GetAssertionsRequest
+	33,  // 86: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertion:input_type -> rmqrpc.mockserver.api.v1.GetAssertionRequest
+	35,  // 87: rmqrpc.mockserver.api.v1.AmqpMockServerService.WaitForAssertions:input_type -> rmqrpc.mockserver.api.v1.WaitForAssertionsRequest
+	37,  // 88: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAssertions:input_type -> rmqrpc.mockserver.api.v1.ResetAssertionsRequest
+	39,  // 89: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertionsStats:input_type -> rmqrpc.mockserver.api.v1.GetAssertionsStatsRequest
+	41,  // 90: rmqrpc.mockserver.api.v1.AmqpMockServerService.WatchEvents:input_type -> rmqrpc.mockserver.api.v1.WatchEventsRequest
+	43,  // 91: rmqrpc.mockserver.api.v1.AmqpMockServerService.VerifyExpectations:input_type -> rmqrpc.mockserver.api.v1.VerifyExpectationsRequest
+	48,  // 92: rmqrpc.mockserver.api.v1.AmqpMockServerService.VerifySequence:input_type -> rmqrpc.mockserver.api.v1.VerifySequenceRequest
+	52,  // 93: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectations:input_type -> rmqrpc.mockserver.api.v1.GetExpectationsRequest
+	54,  // 94: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectation:input_type -> rmqrpc.mockserver.api.v1.GetExpectationRequest
+	50,  // 95: rmqrpc.mockserver.api.v1.AmqpMockServerService.SimulateMatch:input_type -> rmqrpc.mockserver.api.v1.SimulateMatchRequest
+	57,  // 96: rmqrpc.mockserver.api.v1.AmqpMockServerService.UpdateExpectation:input_type -> rmqrpc.mockserver.api.v1.UpdateExpectationRequest
+	59,  // 97: rmqrpc.mockserver.api.v1.AmqpMockServerService.UpsertExpectation:input_type -> rmqrpc.mockserver.api.v1.UpsertExpectationRequest
+	61,  // 98: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteExpectation:input_type -> rmqrpc.mockserver.api.v1.DeleteExpectationRequest
+	63,  // 99: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetExpectations:input_type -> rmqrpc.mockserver.api.v1.ResetExpectationsRequest
+	10,  // 100: rmqrpc.mockserver.api.v1.AmqpMockServerService.AddSubscription:input_type -> rmqrpc.mockserver.api.v1.AddSubscriptionRequest
+	12,  // 101: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteSubscription:input_type -> rmqrpc.mockserver.api.v1.DeleteSubscriptionRequest
+	14,  // 102: rmqrpc.mockserver.api.v1.AmqpMockServerService.UnsubscribeFromQueue:input_type -> rmqrpc.mockserver.api.v1.UnsubscribeFromQueueRequest
+	16,  // 103: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAllSubscriptions:input_type -> rmqrpc.mockserver.api.v1.GetAllSubscriptionsRequest
+	89,  // 104: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetSubscriptions:input_type -> rmqrpc.mockserver.api.v1.ResetSubscriptionsRequest
+	65,  // 105: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetDefaultResponse:input_type -> rmqrpc.mockserver.api.v1.GetDefaultResponseRequest
+	67,  // 106: rmqrpc.mockserver.api.v1.AmqpMockServerService.SetDefaultResponse:input_type -> rmqrpc.mockserver.api.v1.SetDefaultResponseRequest
+	69,  // 107: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetDefaultResponse:input_type -> rmqrpc.mockserver.api.v1.ResetDefaultResponseRequest
+	71,  // 108: rmqrpc.mockserver.api.v1.AmqpMockServerService.StartRecording:input_type -> rmqrpc.mockserver.api.v1.StartRecordingRequest
+	73,  // 109: rmqrpc.mockserver.api.v1.AmqpMockServerService.StopRecording:input_type -> rmqrpc.mockserver.api.v1.StopRecordingRequest
+	75,  // 110: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetRecordings:input_type -> rmqrpc.mockserver.api.v1.GetRecordingsRequest
+	77,  // 111: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetRecordings:input_type -> rmqrpc.mockserver.api.v1.ResetRecordingsRequest
+	79,  // 112: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetScenarios:input_type -> rmqrpc.mockserver.api.v1.GetScenariosRequest
+	81,  // 113: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetScenario:input_type -> rmqrpc.mockserver.api.v1.GetScenarioRequest
+	83,  // 114: rmqrpc.mockserver.api.v1.AmqpMockServerService.SetScenarioState:input_type -> rmqrpc.mockserver.api.v1.SetScenarioStateRequest
+	85,  // 115: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetScenario:input_type -> rmqrpc.mockserver.api.v1.ResetScenarioRequest
+	87,  // 116: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetScenarios:input_type -> rmqrpc.mockserver.api.v1.ResetScenariosRequest
+	93,  // 117: rmqrpc.mockserver.api.v1.AmqpMockServerService.ListNamespaces:input_type -> rmqrpc.mockserver.api.v1.ListNamespacesRequest
+	95,  // 118: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteNamespace:input_type -> rmqrpc.mockserver.api.v1.DeleteNamespaceRequest
+	98,  // 119: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateSession:input_type -> rmqrpc.mockserver.api.v1.CreateSessionRequest
+	100, // 120: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetSessions:input_type -> rmqrpc.mockserver.api.v1.GetSessionsRequest
+	102, // 121: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetSession:input_type -> rmqrpc.mockserver.api.v1.GetSessionRequest
+	104, // 122: rmqrpc.mockserver.api.v1.AmqpMockServerService.HeartbeatSession:input_type -> rmqrpc.mockserver.api.v1.HeartbeatSessionRequest
+	106, // 123: rmqrpc.mockserver.api.v1.AmqpMockServerService.CloseSession:input_type -> rmqrpc.mockserver.api.v1.CloseSessionRequest
+	91,  // 124: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAll:input_type -> rmqrpc.mockserver.api.v1.ResetAllRequest
+	108, // 125: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetVersion:input_type -> rmqrpc.mockserver.api.v1.GetVersionRequest
+	56,  // 126: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateExpectation:output_type -> rmqrpc.mockserver.api.v1.CreateExpectationResponse
+	32,  // 127: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertions:output_type -> rmqrpc.mockserver.api.v1.GetAssertionsResponse
+	34,  // 128: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertion:output_type -> rmqrpc.mockserver.api.v1.GetAssertionResponse
+	36,  // 129: rmqrpc.mockserver.api.v1.AmqpMockServerService.WaitForAssertions:output_type -> rmqrpc.mockserver.api.v1.WaitForAssertionsResponse
+	38,  // 130: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAssertions:output_type -> rmqrpc.mockserver.api.v1.ResetAssertionsResponse
+	40,  // 131: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAssertionsStats:output_type -> rmqrpc.mockserver.api.v1.GetAssertionsStatsResponse
+	42,  // 132: rmqrpc.mockserver.api.v1.AmqpMockServerService.WatchEvents:output_type -> rmqrpc.mockserver.api.v1.Event
+	46,  // 133: rmqrpc.mockserver.api.v1.AmqpMockServerService.VerifyExpectations:output_type -> rmqrpc.mockserver.api.v1.VerifyExpectationsResponse
+	49,  // 134: rmqrpc.mockserver.api.v1.AmqpMockServerService.VerifySequence:output_type -> rmqrpc.mockserver.api.v1.VerifySequenceResponse
+	53,  // 135: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectations:output_type -> rmqrpc.mockserver.api.v1.GetExpectationsResponse
+	55,  // 136: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetExpectation:output_type -> rmqrpc.mockserver.api.v1.GetExpectationResponse
+	51,  // 137: rmqrpc.mockserver.api.v1.AmqpMockServerService.SimulateMatch:output_type -> rmqrpc.mockserver.api.v1.SimulateMatchResponse
+	58,  // 138: rmqrpc.mockserver.api.v1.AmqpMockServerService.UpdateExpectation:output_type -> rmqrpc.mockserver.api.v1.UpdateExpectationResponse
+	60,  // 139: rmqrpc.mockserver.api.v1.AmqpMockServerService.UpsertExpectation:output_type -> rmqrpc.mockserver.api.v1.UpsertExpectationResponse
+	62,  // 140: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteExpectation:output_type -> rmqrpc.mockserver.api.v1.DeleteExpectationResponse
+	64,  // 141: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetExpectations:output_type -> rmqrpc.mockserver.api.v1.ResetExpectationsResponse
+	11,  // 142: rmqrpc.mockserver.api.v1.AmqpMockServerService.AddSubscription:output_type -> rmqrpc.mockserver.api.v1.AddSubscriptionResponse
+	13,  // 143: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteSubscription:output_type -> rmqrpc.mockserver.api.v1.DeleteSubscriptionResponse
+	15,  // 144: rmqrpc.mockserver.api.v1.AmqpMockServerService.UnsubscribeFromQueue:output_type -> rmqrpc.mockserver.api.v1.UnsubscribeFromQueueResponse
+	17,  // 145: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetAllSubscriptions:output_type -> rmqrpc.mockserver.api.v1.GetAllSubscriptionsResponse
+	90,  // 146: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetSubscriptions:output_type -> rmqrpc.mockserver.api.v1.ResetSubscriptionsResponse
+	66,  // 147: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetDefaultResponse:output_type -> rmqrpc.mockserver.api.v1.GetDefaultResponseResponse
+	68,  // 148: rmqrpc.mockserver.api.v1.AmqpMockServerService.SetDefaultResponse:output_type -> rmqrpc.mockserver.api.v1.SetDefaultResponseResponse
+	70,  // 149: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetDefaultResponse:output_type -> rmqrpc.mockserver.api.v1.ResetDefaultResponseResponse
+	72,  // 150: rmqrpc.mockserver.api.v1.AmqpMockServerService.StartRecording:output_type -> rmqrpc.mockserver.api.v1.StartRecordingResponse
+	74,  // 151: rmqrpc.mockserver.api.v1.AmqpMockServerService.StopRecording:output_type -> rmqrpc.mockserver.api.v1.StopRecordingResponse
+	76,  // 152: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetRecordings:output_type -> rmqrpc.mockserver.api.v1.GetRecordingsResponse
+	78,  // 153: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetRecordings:output_type -> rmqrpc.mockserver.api.v1.ResetRecordingsResponse
+	80,  // 154: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetScenarios:output_type -> rmqrpc.mockserver.api.v1.GetScenariosResponse
+	82,  // 155: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetScenario:output_type -> rmqrpc.mockserver.api.v1.GetScenarioResponse
+	84,  // 156: rmqrpc.mockserver.api.v1.AmqpMockServerService.SetScenarioState:output_type -> rmqrpc.mockserver.api.v1.SetScenarioStateResponse
+	86,  // 157: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetScenario:output_type -> rmqrpc.mockserver.api.v1.ResetScenarioResponse
+	88,  // 158: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetScenarios:output_type -> rmqrpc.mockserver.api.v1.ResetScenariosResponse
+	94,  // 159: rmqrpc.mockserver.api.v1.AmqpMockServerService.ListNamespaces:output_type -> rmqrpc.mockserver.api.v1.ListNamespacesResponse
+	96,  // 160: rmqrpc.mockserver.api.v1.AmqpMockServerService.DeleteNamespace:output_type -> rmqrpc.mockserver.api.v1.DeleteNamespaceResponse
+	99,  // 161: rmqrpc.mockserver.api.v1.AmqpMockServerService.CreateSession:output_type -> rmqrpc.mockserver.api.v1.CreateSessionResponse
+	101, // 162: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetSessions:output_type -> rmqrpc.mockserver.api.v1.GetSessionsResponse
+	103, // 163: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetSession:output_type -> rmqrpc.mockserver.api.v1.GetSessionResponse
+	105, // 164: rmqrpc.mockserver.api.v1.AmqpMockServerService.HeartbeatSession:output_type -> rmqrpc.mockserver.api.v1.HeartbeatSessionResponse
+	107, // 165: rmqrpc.mockserver.api.v1.AmqpMockServerService.CloseSession:output_type -> rmqrpc.mockserver.api.v1.CloseSessionResponse
+	92,  // 166: rmqrpc.mockserver.api.v1.AmqpMockServerService.ResetAll:output_type -> rmqrpc.mockserver.api.v1.ResetAllResponse
+	109, // 167: rmqrpc.mockserver.api.v1.AmqpMockServerService.GetVersion:output_type -> rmqrpc.mockserver.api.v1.GetVersionResponse
+	126, // [126:168] is the sub-list for method output_type
+	84,  // [84:126] is the sub-list for method input_type
+	84,  // [84:84] is the sub-list for extension type_name
+	84,  // [84:84] is the sub-list for extension extendee
+	0,   // [0:84] is the sub-list for field type_name
 }
 
 func init() { file_mockserver_proto_init() }
@@ -2017,26 +7860,51 @@ func file_mockserver_proto_init() {
 	if File_mockserver_proto != nil {
 		return
 	}
-	file_mockserver_proto_msgTypes[11].OneofWrappers = []any{
+	file_mockserver_proto_msgTypes[1].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[2].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[13].OneofWrappers = []any{
 		(*Request_JsonBody)(nil),
 		(*Request_RegexBody)(nil),
 	}
-	file_mockserver_proto_msgTypes[13].OneofWrappers = []any{
+	file_mockserver_proto_msgTypes[16].OneofWrappers = []any{
 		(*Times_RemainingTimes)(nil),
 		(*Times_Unlimited)(nil),
 	}
-	file_mockserver_proto_msgTypes[14].OneofWrappers = []any{}
-	file_mockserver_proto_msgTypes[15].OneofWrappers = []any{}
-	file_mockserver_proto_msgTypes[16].OneofWrappers = []any{}
-	file_mockserver_proto_msgTypes[17].OneofWrappers = []any{}
-	file_mockserver_proto_msgTypes[19].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[17].OneofWrappers = []any{
+		(*Delay_FixedMs)(nil),
+		(*Delay_Uniform)(nil),
+		(*Delay_LogNormal)(nil),
+	}
+	file_mockserver_proto_msgTypes[20].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[21].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[22].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[23].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[27].OneofWrappers = []any{
+		(*WaitForAssertionsRequest_JsonBody)(nil),
+		(*WaitForAssertionsRequest_RegexBody)(nil),
+	}
+	file_mockserver_proto_msgTypes[33].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[34].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[36].OneofWrappers = []any{
+		(*Verification_ExpectationId)(nil),
+		(*Verification_Request)(nil),
+	}
+	file_mockserver_proto_msgTypes[37].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[42].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[43].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[44].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[51].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[55].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[63].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[90].OneofWrappers = []any{}
+	file_mockserver_proto_msgTypes[110].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mockserver_proto_rawDesc), len(file_mockserver_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   33,
+			NumEnums:      8,
+			NumMessages:   120,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,14 +21,44 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	AmqpMockServerService_CreateExpectation_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/CreateExpectation"
 	AmqpMockServerService_GetAssertions_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetAssertions"
+	AmqpMockServerService_GetAssertion_FullMethodName         = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetAssertion"
+	AmqpMockServerService_WaitForAssertions_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/WaitForAssertions"
+	AmqpMockServerService_ResetAssertions_FullMethodName      = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetAssertions"
+	AmqpMockServerService_GetAssertionsStats_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetAssertionsStats"
+	AmqpMockServerService_WatchEvents_FullMethodName          = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/WatchEvents"
+	AmqpMockServerService_VerifyExpectations_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/VerifyExpectations"
+	AmqpMockServerService_VerifySequence_FullMethodName       = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/VerifySequence"
 	AmqpMockServerService_GetExpectations_FullMethodName      = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetExpectations"
 	AmqpMockServerService_GetExpectation_FullMethodName       = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetExpectation"
+	AmqpMockServerService_SimulateMatch_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/SimulateMatch"
+	AmqpMockServerService_UpdateExpectation_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/UpdateExpectation"
+	AmqpMockServerService_UpsertExpectation_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/UpsertExpectation"
+	AmqpMockServerService_DeleteExpectation_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/DeleteExpectation"
 	AmqpMockServerService_ResetExpectations_FullMethodName    = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetExpectations"
 	AmqpMockServerService_AddSubscription_FullMethodName      = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/AddSubscription"
 	AmqpMockServerService_DeleteSubscription_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/DeleteSubscription"
 	AmqpMockServerService_UnsubscribeFromQueue_FullMethodName = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/UnsubscribeFromQueue"
 	AmqpMockServerService_GetAllSubscriptions_FullMethodName  = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetAllSubscriptions"
 	AmqpMockServerService_ResetSubscriptions_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetSubscriptions"
+	AmqpMockServerService_GetDefaultResponse_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetDefaultResponse"
+	AmqpMockServerService_SetDefaultResponse_FullMethodName   = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/SetDefaultResponse"
+	AmqpMockServerService_ResetDefaultResponse_FullMethodName = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetDefaultResponse"
+	AmqpMockServerService_StartRecording_FullMethodName       = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/StartRecording"
+	AmqpMockServerService_StopRecording_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/StopRecording"
+	AmqpMockServerService_GetRecordings_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetRecordings"
+	AmqpMockServerService_ResetRecordings_FullMethodName      = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetRecordings"
+	AmqpMockServerService_GetScenarios_FullMethodName         = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetScenarios"
+	AmqpMockServerService_GetScenario_FullMethodName          = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetScenario"
+	AmqpMockServerService_SetScenarioState_FullMethodName     = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/SetScenarioState"
+	AmqpMockServerService_ResetScenario_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetScenario"
+	AmqpMockServerService_ResetScenarios_FullMethodName       = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetScenarios"
+	AmqpMockServerService_ListNamespaces_FullMethodName       = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ListNamespaces"
+	AmqpMockServerService_DeleteNamespace_FullMethodName      = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/DeleteNamespace"
+	AmqpMockServerService_CreateSession_FullMethodName        = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/CreateSession"
+	AmqpMockServerService_GetSessions_FullMethodName          = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetSessions"
+	AmqpMockServerService_GetSession_FullMethodName           = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetSession"
+	AmqpMockServerService_HeartbeatSession_FullMethodName     = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/HeartbeatSession"
+	AmqpMockServerService_CloseSession_FullMethodName         = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/CloseSession"
 	AmqpMockServerService_ResetAll_FullMethodName             = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/ResetAll"
 	AmqpMockServerService_GetVersion_FullMethodName           = "/rmqrpc.mockserver.api.v1.AmqpMockServerService/GetVersion"
 )
@@ -43,12 +73,41 @@ type AmqpMockServerServiceClient interface {
 	CreateExpectation(ctx context.Context, in *CreateExpectationRequest, opts ...grpc.CallOption) (*CreateExpectationResponse, error)
 	// GetAssertions retrieves a list of all historical assertions.
 	GetAssertions(ctx context.Context, in *GetAssertionsRequest, opts ...grpc.CallOption) (*GetAssertionsResponse, error)
+	// GetAssertion retrieves a single assertion with its full candidate and the snapshot of the matched expectation.
+	GetAssertion(ctx context.Context, in *GetAssertionRequest, opts ...grpc.CallOption) (*GetAssertionResponse, error)
+	// WaitForAssertions blocks until the given number of assertions matching the filter have been recorded,
+	// or the timeout elapses, so that tests of asynchronous flows do not need to poll GetAssertions.
+	WaitForAssertions(ctx context.Context, in *WaitForAssertionsRequest, opts ...grpc.CallOption) (*WaitForAssertionsResponse, error)
+	// ResetAssertions clears the history of assertions.
+	ResetAssertions(ctx context.Context, in *ResetAssertionsRequest, opts ...grpc.CallOption) (*ResetAssertionsResponse, error)
+	// GetAssertionsStats returns the size and the retention limits of the history of assertions,
+	// along with the number of assertions evicted because of the limits.
+	GetAssertionsStats(ctx context.Context, in *GetAssertionsStatsRequest, opts ...grpc.CallOption) (*GetAssertionsStatsResponse, error)
+	// WatchEvents streams the events of the mockserver as they happen, such as expectations being created or used
+	// and requests being matched. The same stream is served over HTTP as server-sent events on GET /api/v1/events.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	// VerifyExpectations checks how many times expectations were matched or requests arrived,
+	// and reports the closest actual requests for the verifications that failed.
+	VerifyExpectations(ctx context.Context, in *VerifyExpectationsRequest, opts ...grpc.CallOption) (*VerifyExpectationsResponse, error)
+	// VerifySequence checks that requests matching the steps arrived in the order of the steps,
+	// and returns the actual sequence of requests if they did not.
+	VerifySequence(ctx context.Context, in *VerifySequenceRequest, opts ...grpc.CallOption) (*VerifySequenceResponse, error)
 	// GetAllExpectations retrieves a list of all active expectations.
 	GetExpectations(ctx context.Context, in *GetExpectationsRequest, opts ...grpc.CallOption) (*GetExpectationsResponse, error)
 	// GetExpectation retrieves a specific expectation by its ID.
 	GetExpectation(ctx context.Context, in *GetExpectationRequest, opts ...grpc.CallOption) (*GetExpectationResponse, error)
+	// SimulateMatch tells which expectations a request would match, without sending AMQP traffic.
+	// It has no side effects: the expectations are not used, the scenarios do not move and no assertion is recorded.
+	SimulateMatch(ctx context.Context, in *SimulateMatchRequest, opts ...grpc.CallOption) (*SimulateMatchResponse, error)
+	// UpdateExpectation replaces the definition of an expectation, keeping its ID.
+	UpdateExpectation(ctx context.Context, in *UpdateExpectationRequest, opts ...grpc.CallOption) (*UpdateExpectationResponse, error)
+	// UpsertExpectation replaces the expectation with the given ID or name, or creates it if there is none.
+	UpsertExpectation(ctx context.Context, in *UpsertExpectationRequest, opts ...grpc.CallOption) (*UpsertExpectationResponse, error)
+	// DeleteExpectation removes a single expectation by its ID, leaving the others untouched.
+	DeleteExpectation(ctx context.Context, in *DeleteExpectationRequest, opts ...grpc.CallOption) (*DeleteExpectationResponse, error)
 	// ResetExpectations resets all expectations, effectively removing all mock configurations.
 	// Use this to clear existing expectations when starting a new test cycle.
+	// With a label selector, only the selected expectations are removed and the scenarios are kept.
 	ResetExpectations(ctx context.Context, in *ResetExpectationsRequest, opts ...grpc.CallOption) (*ResetExpectationsResponse, error)
 	// AddSubscription subscribes to an existing RabbitMQ queue.
 	// This allows the mockserver to receive requests from specific queues.
@@ -62,7 +121,52 @@ type AmqpMockServerServiceClient interface {
 	GetAllSubscriptions(ctx context.Context, in *GetAllSubscriptionsRequest, opts ...grpc.CallOption) (*GetAllSubscriptionsResponse, error)
 	// ResetSubscriptions unsubscribes the mockserver from all queues.
 	ResetSubscriptions(ctx context.Context, in *ResetSubscriptionsRequest, opts ...grpc.CallOption) (*ResetSubscriptionsResponse, error)
-	// ResetAll resets both expectations and subscriptions, bringing the mockserver to its initial state.
+	// GetDefaultResponse retrieves the response published for requests that match no expectation.
+	GetDefaultResponse(ctx context.Context, in *GetDefaultResponseRequest, opts ...grpc.CallOption) (*GetDefaultResponseResponse, error)
+	// SetDefaultResponse replaces the response published for requests that match no expectation.
+	// It is used by subscriptions with the FALLBACK_POLICY_REPLY policy.
+	SetDefaultResponse(ctx context.Context, in *SetDefaultResponseRequest, opts ...grpc.CallOption) (*SetDefaultResponseResponse, error)
+	// ResetDefaultResponse restores the built-in response for requests that match no expectation.
+	ResetDefaultResponse(ctx context.Context, in *ResetDefaultResponseRequest, opts ...grpc.CallOption) (*ResetDefaultResponseResponse, error)
+	// StartRecording turns the record mode on.
+	// While recording, every request is forwarded to the real service, its reply is relayed to the caller
+	// and the request/reply pair is captured as an expectation.
+	StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingResponse, error)
+	// StopRecording turns the record mode off, the recordings are kept.
+	StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingResponse, error)
+	// GetRecordings exports the recorded expectations in the shape accepted by CreateExpectation.
+	GetRecordings(ctx context.Context, in *GetRecordingsRequest, opts ...grpc.CallOption) (*GetRecordingsResponse, error)
+	// ResetRecordings removes all recorded expectations.
+	ResetRecordings(ctx context.Context, in *ResetRecordingsRequest, opts ...grpc.CallOption) (*ResetRecordingsResponse, error)
+	// GetScenarios retrieves the current state of all scenarios used by the expectations or moved to a state.
+	GetScenarios(ctx context.Context, in *GetScenariosRequest, opts ...grpc.CallOption) (*GetScenariosResponse, error)
+	// GetScenario retrieves the current state of a scenario.
+	GetScenario(ctx context.Context, in *GetScenarioRequest, opts ...grpc.CallOption) (*GetScenarioResponse, error)
+	// SetScenarioState forces a scenario into the given state.
+	SetScenarioState(ctx context.Context, in *SetScenarioStateRequest, opts ...grpc.CallOption) (*SetScenarioStateResponse, error)
+	// ResetScenario moves a scenario back to the "Started" state.
+	ResetScenario(ctx context.Context, in *ResetScenarioRequest, opts ...grpc.CallOption) (*ResetScenarioResponse, error)
+	// ResetScenarios moves all scenarios back to the "Started" state.
+	ResetScenarios(ctx context.Context, in *ResetScenariosRequest, opts ...grpc.CallOption) (*ResetScenariosResponse, error)
+	// ListNamespaces lists the namespaces created so far, besides the default one.
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// DeleteNamespace removes a namespace with its expectations, assertions, scenarios and subscriptions.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// CreateSession starts a session owning the expectations and subscriptions created with its ID
+	// in the x-mockserver-session header. They are removed when the session is closed or its lease expires.
+	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	// GetSessions lists the open sessions.
+	GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error)
+	// GetSession retrieves an open session.
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	// HeartbeatSession renews the lease of a session.
+	HeartbeatSession(ctx context.Context, in *HeartbeatSessionRequest, opts ...grpc.CallOption) (*HeartbeatSessionResponse, error)
+	// CloseSession ends a session, removing the expectations and subscriptions it owns.
+	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
+	// ResetAll resets expectations, scenarios, subscriptions, recordings and the default response,
+	// bringing the mockserver to its initial state. The history of assertions is kept unless clear_assertions is set.
+	// In a namespace other than the default one, only the expectations, scenarios, subscriptions and assertions
+	// of the namespace are reset.
 	ResetAll(ctx context.Context, in *ResetAllRequest, opts ...grpc.CallOption) (*ResetAllResponse, error)
 	// GetVersion returns the version information of the mockserver application.
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
@@ -96,6 +200,85 @@ func (c *amqpMockServerServiceClient) GetAssertions(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *amqpMockServerServiceClient) GetAssertion(ctx context.Context, in *GetAssertionRequest, opts ...grpc.CallOption) (*GetAssertionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssertionResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetAssertion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) WaitForAssertions(ctx context.Context, in *WaitForAssertionsRequest, opts ...grpc.CallOption) (*WaitForAssertionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForAssertionsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_WaitForAssertions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ResetAssertions(ctx context.Context, in *ResetAssertionsRequest, opts ...grpc.CallOption) (*ResetAssertionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetAssertionsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ResetAssertions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetAssertionsStats(ctx context.Context, in *GetAssertionsStatsRequest, opts ...grpc.CallOption) (*GetAssertionsStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssertionsStatsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetAssertionsStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AmqpMockServerService_ServiceDesc.Streams[0], AmqpMockServerService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AmqpMockServerService_WatchEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *amqpMockServerServiceClient) VerifyExpectations(ctx context.Context, in *VerifyExpectationsRequest, opts ...grpc.CallOption) (*VerifyExpectationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyExpectationsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_VerifyExpectations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) VerifySequence(ctx context.Context, in *VerifySequenceRequest, opts ...grpc.CallOption) (*VerifySequenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySequenceResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_VerifySequence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *amqpMockServerServiceClient) GetExpectations(ctx context.Context, in *GetExpectationsRequest, opts ...grpc.CallOption) (*GetExpectationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetExpectationsResponse)
@@ -116,6 +299,46 @@ func (c *amqpMockServerServiceClient) GetExpectation(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *amqpMockServerServiceClient) SimulateMatch(ctx context.Context, in *SimulateMatchRequest, opts ...grpc.CallOption) (*SimulateMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateMatchResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_SimulateMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) UpdateExpectation(ctx context.Context, in *UpdateExpectationRequest, opts ...grpc.CallOption) (*UpdateExpectationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExpectationResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_UpdateExpectation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) UpsertExpectation(ctx context.Context, in *UpsertExpectationRequest, opts ...grpc.CallOption) (*UpsertExpectationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertExpectationResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_UpsertExpectation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) DeleteExpectation(ctx context.Context, in *DeleteExpectationRequest, opts ...grpc.CallOption) (*DeleteExpectationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExpectationResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_DeleteExpectation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *amqpMockServerServiceClient) ResetExpectations(ctx context.Context, in *ResetExpectationsRequest, opts ...grpc.CallOption) (*ResetExpectationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetExpectationsResponse)
@@ -176,6 +399,196 @@ func (c *amqpMockServerServiceClient) ResetSubscriptions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *amqpMockServerServiceClient) GetDefaultResponse(ctx context.Context, in *GetDefaultResponseRequest, opts ...grpc.CallOption) (*GetDefaultResponseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDefaultResponseResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetDefaultResponse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) SetDefaultResponse(ctx context.Context, in *SetDefaultResponseRequest, opts ...grpc.CallOption) (*SetDefaultResponseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultResponseResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_SetDefaultResponse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ResetDefaultResponse(ctx context.Context, in *ResetDefaultResponseRequest, opts ...grpc.CallOption) (*ResetDefaultResponseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetDefaultResponseResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ResetDefaultResponse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartRecordingResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_StartRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopRecordingResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_StopRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetRecordings(ctx context.Context, in *GetRecordingsRequest, opts ...grpc.CallOption) (*GetRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecordingsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ResetRecordings(ctx context.Context, in *ResetRecordingsRequest, opts ...grpc.CallOption) (*ResetRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetRecordingsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ResetRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetScenarios(ctx context.Context, in *GetScenariosRequest, opts ...grpc.CallOption) (*GetScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScenariosResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetScenario(ctx context.Context, in *GetScenarioRequest, opts ...grpc.CallOption) (*GetScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScenarioResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) SetScenarioState(ctx context.Context, in *SetScenarioStateRequest, opts ...grpc.CallOption) (*SetScenarioStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetScenarioStateResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_SetScenarioState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ResetScenario(ctx context.Context, in *ResetScenarioRequest, opts ...grpc.CallOption) (*ResetScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetScenarioResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ResetScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ResetScenarios(ctx context.Context, in *ResetScenariosRequest, opts ...grpc.CallOption) (*ResetScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetScenariosResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ResetScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_DeleteNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSessionResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_CreateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionsResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) HeartbeatSession(ctx context.Context, in *HeartbeatSessionRequest, opts ...grpc.CallOption) (*HeartbeatSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatSessionResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_HeartbeatSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amqpMockServerServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseSessionResponse)
+	err := c.cc.Invoke(ctx, AmqpMockServerService_CloseSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *amqpMockServerServiceClient) ResetAll(ctx context.Context, in *ResetAllRequest, opts ...grpc.CallOption) (*ResetAllResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetAllResponse)
@@ -206,12 +619,41 @@ type AmqpMockServerServiceServer interface {
 	CreateExpectation(context.Context, *CreateExpectationRequest) (*CreateExpectationResponse, error)
 	// GetAssertions retrieves a list of all historical assertions.
 	GetAssertions(context.Context, *GetAssertionsRequest) (*GetAssertionsResponse, error)
+	// GetAssertion retrieves a single assertion with its full candidate and the snapshot of the matched expectation.
+	GetAssertion(context.Context, *GetAssertionRequest) (*GetAssertionResponse, error)
+	// WaitForAssertions blocks until the given number of assertions matching the filter have been recorded,
+	// or the timeout elapses, so that tests of asynchronous flows do not need to poll GetAssertions.
+	WaitForAssertions(context.Context, *WaitForAssertionsRequest) (*WaitForAssertionsResponse, error)
+	// ResetAssertions clears the history of assertions.
+	ResetAssertions(context.Context, *ResetAssertionsRequest) (*ResetAssertionsResponse, error)
+	// GetAssertionsStats returns the size and the retention limits of the history of assertions,
+	// along with the number of assertions evicted because of the limits.
+	GetAssertionsStats(context.Context, *GetAssertionsStatsRequest) (*GetAssertionsStatsResponse, error)
+	// WatchEvents streams the events of the mockserver as they happen, such as expectations being created or used
+	// and requests being matched. The same stream is served over HTTP as server-sent events on GET /api/v1/events.
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error
+	// VerifyExpectations checks how many times expectations were matched or requests arrived,
+	// and reports the closest actual requests for the verifications that failed.
+	VerifyExpectations(context.Context, *VerifyExpectationsRequest) (*VerifyExpectationsResponse, error)
+	// VerifySequence checks that requests matching the steps arrived in the order of the steps,
+	// and returns the actual sequence of requests if they did not.
+	VerifySequence(context.Context, *VerifySequenceRequest) (*VerifySequenceResponse, error)
 	// GetAllExpectations retrieves a list of all active expectations.
 	GetExpectations(context.Context, *GetExpectationsRequest) (*GetExpectationsResponse, error)
 	// GetExpectation retrieves a specific expectation by its ID.
 	GetExpectation(context.Context, *GetExpectationRequest) (*GetExpectationResponse, error)
+	// SimulateMatch tells which expectations a request would match, without sending AMQP traffic.
+	// It has no side effects: the expectations are not used, the scenarios do not move and no assertion is recorded.
+	SimulateMatch(context.Context, *SimulateMatchRequest) (*SimulateMatchResponse, error)
+	// UpdateExpectation replaces the definition of an expectation, keeping its ID.
+	UpdateExpectation(context.Context, *UpdateExpectationRequest) (*UpdateExpectationResponse, error)
+	// UpsertExpectation replaces the expectation with the given ID or name, or creates it if there is none.
+	UpsertExpectation(context.Context, *UpsertExpectationRequest) (*UpsertExpectationResponse, error)
+	// DeleteExpectation removes a single expectation by its ID, leaving the others untouched.
+	DeleteExpectation(context.Context, *DeleteExpectationRequest) (*DeleteExpectationResponse, error)
 	// ResetExpectations resets all expectations, effectively removing all mock configurations.
 	// Use this to clear existing expectations when starting a new test cycle.
+	// With a label selector, only the selected expectations are removed and the scenarios are kept.
 	ResetExpectations(context.Context, *ResetExpectationsRequest) (*ResetExpectationsResponse, error)
 	// AddSubscription subscribes to an existing RabbitMQ queue.
 	// This allows the mockserver to receive requests from specific queues.
@@ -225,7 +667,52 @@ type AmqpMockServerServiceServer interface {
 	GetAllSubscriptions(context.Context, *GetAllSubscriptionsRequest) (*GetAllSubscriptionsResponse, error)
 	// ResetSubscriptions unsubscribes the mockserver from all queues.
 	ResetSubscriptions(context.Context, *ResetSubscriptionsRequest) (*ResetSubscriptionsResponse, error)
-	// ResetAll resets both expectations and subscriptions, bringing the mockserver to its initial state.
+	// GetDefaultResponse retrieves the response published for requests that match no expectation.
+	GetDefaultResponse(context.Context, *GetDefaultResponseRequest) (*GetDefaultResponseResponse, error)
+	// SetDefaultResponse replaces the response published for requests that match no expectation.
+	// It is used by subscriptions with the FALLBACK_POLICY_REPLY policy.
+	SetDefaultResponse(context.Context, *SetDefaultResponseRequest) (*SetDefaultResponseResponse, error)
+	// ResetDefaultResponse restores the built-in response for requests that match no expectation.
+	ResetDefaultResponse(context.Context, *ResetDefaultResponseRequest) (*ResetDefaultResponseResponse, error)
+	// StartRecording turns the record mode on.
+	// While recording, every request is forwarded to the real service, its reply is relayed to the caller
+	// and the request/reply pair is captured as an expectation.
+	StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingResponse, error)
+	// StopRecording turns the record mode off, the recordings are kept.
+	StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingResponse, error)
+	// GetRecordings exports the recorded expectations in the shape accepted by CreateExpectation.
+	GetRecordings(context.Context, *GetRecordingsRequest) (*GetRecordingsResponse, error)
+	// ResetRecordings removes all recorded expectations.
+	ResetRecordings(context.Context, *ResetRecordingsRequest) (*ResetRecordingsResponse, error)
+	// GetScenarios retrieves the current state of all scenarios used by the expectations or moved to a state.
+	GetScenarios(context.Context, *GetScenariosRequest) (*GetScenariosResponse, error)
+	// GetScenario retrieves the current state of a scenario.
+	GetScenario(context.Context, *GetScenarioRequest) (*GetScenarioResponse, error)
+	// SetScenarioState forces a scenario into the given state.
+	SetScenarioState(context.Context, *SetScenarioStateRequest) (*SetScenarioStateResponse, error)
+	// ResetScenario moves a scenario back to the "Started" state.
+	ResetScenario(context.Context, *ResetScenarioRequest) (*ResetScenarioResponse, error)
+	// ResetScenarios moves all scenarios back to the "Started" state.
+	ResetScenarios(context.Context, *ResetScenariosRequest) (*ResetScenariosResponse, error)
+	// ListNamespaces lists the namespaces created so far, besides the default one.
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// DeleteNamespace removes a namespace with its expectations, assertions, scenarios and subscriptions.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// CreateSession starts a session owning the expectations and subscriptions created with its ID
+	// in the x-mockserver-session header. They are removed when the session is closed or its lease expires.
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	// GetSessions lists the open sessions.
+	GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error)
+	// GetSession retrieves an open session.
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	// HeartbeatSession renews the lease of a session.
+	HeartbeatSession(context.Context, *HeartbeatSessionRequest) (*HeartbeatSessionResponse, error)
+	// CloseSession ends a session, removing the expectations and subscriptions it owns.
+	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
+	// ResetAll resets expectations, scenarios, subscriptions, recordings and the default response,
+	// bringing the mockserver to its initial state. The history of assertions is kept unless clear_assertions is set.
+	// In a namespace other than the default one, only the expectations, scenarios, subscriptions and assertions
+	// of the namespace are reset.
 	ResetAll(context.Context, *ResetAllRequest) (*ResetAllResponse, error)
 	// GetVersion returns the version information of the mockserver application.
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
@@ -245,12 +732,45 @@ func (UnimplementedAmqpMockServerServiceServer) CreateExpectation(context.Contex
 func (UnimplementedAmqpMockServerServiceServer) GetAssertions(context.Context, *GetAssertionsRequest) (*GetAssertionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAssertions not implemented")
 }
+func (UnimplementedAmqpMockServerServiceServer) GetAssertion(context.Context, *GetAssertionRequest) (*GetAssertionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAssertion not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) WaitForAssertions(context.Context, *WaitForAssertionsRequest) (*WaitForAssertionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WaitForAssertions not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ResetAssertions(context.Context, *ResetAssertionsRequest) (*ResetAssertionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetAssertions not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetAssertionsStats(context.Context, *GetAssertionsStatsRequest) (*GetAssertionsStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAssertionsStats not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) VerifyExpectations(context.Context, *VerifyExpectationsRequest) (*VerifyExpectationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyExpectations not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) VerifySequence(context.Context, *VerifySequenceRequest) (*VerifySequenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifySequence not implemented")
+}
 func (UnimplementedAmqpMockServerServiceServer) GetExpectations(context.Context, *GetExpectationsRequest) (*GetExpectationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExpectations not implemented")
 }
 func (UnimplementedAmqpMockServerServiceServer) GetExpectation(context.Context, *GetExpectationRequest) (*GetExpectationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExpectation not implemented")
 }
+func (UnimplementedAmqpMockServerServiceServer) SimulateMatch(context.Context, *SimulateMatchRequest) (*SimulateMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateMatch not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) UpdateExpectation(context.Context, *UpdateExpectationRequest) (*UpdateExpectationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExpectation not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) UpsertExpectation(context.Context, *UpsertExpectationRequest) (*UpsertExpectationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertExpectation not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) DeleteExpectation(context.Context, *DeleteExpectationRequest) (*DeleteExpectationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExpectation not implemented")
+}
 func (UnimplementedAmqpMockServerServiceServer) ResetExpectations(context.Context, *ResetExpectationsRequest) (*ResetExpectationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetExpectations not implemented")
 }
@@ -269,6 +789,63 @@ func (UnimplementedAmqpMockServerServiceServer) GetAllSubscriptions(context.Cont
 func (UnimplementedAmqpMockServerServiceServer) ResetSubscriptions(context.Context, *ResetSubscriptionsRequest) (*ResetSubscriptionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetSubscriptions not implemented")
 }
+func (UnimplementedAmqpMockServerServiceServer) GetDefaultResponse(context.Context, *GetDefaultResponseRequest) (*GetDefaultResponseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDefaultResponse not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) SetDefaultResponse(context.Context, *SetDefaultResponseRequest) (*SetDefaultResponseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDefaultResponse not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ResetDefaultResponse(context.Context, *ResetDefaultResponseRequest) (*ResetDefaultResponseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetDefaultResponse not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetRecordings(context.Context, *GetRecordingsRequest) (*GetRecordingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecordings not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ResetRecordings(context.Context, *ResetRecordingsRequest) (*ResetRecordingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetRecordings not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetScenarios(context.Context, *GetScenariosRequest) (*GetScenariosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScenarios not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetScenario(context.Context, *GetScenarioRequest) (*GetScenarioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScenario not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) SetScenarioState(context.Context, *SetScenarioStateRequest) (*SetScenarioStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetScenarioState not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ResetScenario(context.Context, *ResetScenarioRequest) (*ResetScenarioResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetScenario not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ResetScenarios(context.Context, *ResetScenariosRequest) (*ResetScenariosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetScenarios not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) HeartbeatSession(context.Context, *HeartbeatSessionRequest) (*HeartbeatSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HeartbeatSession not implemented")
+}
+func (UnimplementedAmqpMockServerServiceServer) CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseSession not implemented")
+}
 func (UnimplementedAmqpMockServerServiceServer) ResetAll(context.Context, *ResetAllRequest) (*ResetAllResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetAll not implemented")
 }
@@ -332,44 +909,235 @@ func _AmqpMockServerService_GetAssertions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AmqpMockServerService_GetExpectations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExpectationsRequest)
+func _AmqpMockServerService_GetAssertion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssertionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AmqpMockServerServiceServer).GetExpectations(ctx, in)
+		return srv.(AmqpMockServerServiceServer).GetAssertion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AmqpMockServerService_GetExpectations_FullMethodName,
+		FullMethod: AmqpMockServerService_GetAssertion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AmqpMockServerServiceServer).GetExpectations(ctx, req.(*GetExpectationsRequest))
+		return srv.(AmqpMockServerServiceServer).GetAssertion(ctx, req.(*GetAssertionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AmqpMockServerService_GetExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExpectationRequest)
+func _AmqpMockServerService_WaitForAssertions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForAssertionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AmqpMockServerServiceServer).GetExpectation(ctx, in)
+		return srv.(AmqpMockServerServiceServer).WaitForAssertions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AmqpMockServerService_GetExpectation_FullMethodName,
+		FullMethod: AmqpMockServerService_WaitForAssertions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AmqpMockServerServiceServer).GetExpectation(ctx, req.(*GetExpectationRequest))
+		return srv.(AmqpMockServerServiceServer).WaitForAssertions(ctx, req.(*WaitForAssertionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AmqpMockServerService_ResetExpectations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetExpectationsRequest)
+func _AmqpMockServerService_ResetAssertions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetAssertionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ResetAssertions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ResetAssertions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ResetAssertions(ctx, req.(*ResetAssertionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetAssertionsStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssertionsStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetAssertionsStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetAssertionsStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetAssertionsStats(ctx, req.(*GetAssertionsStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AmqpMockServerServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AmqpMockServerService_WatchEventsServer = grpc.ServerStreamingServer[Event]
+
+func _AmqpMockServerService_VerifyExpectations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyExpectationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).VerifyExpectations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_VerifyExpectations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).VerifyExpectations(ctx, req.(*VerifyExpectationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_VerifySequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).VerifySequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_VerifySequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).VerifySequence(ctx, req.(*VerifySequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetExpectations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpectationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetExpectations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetExpectations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetExpectations(ctx, req.(*GetExpectationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpectationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetExpectation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetExpectation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetExpectation(ctx, req.(*GetExpectationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_SimulateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).SimulateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_SimulateMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).SimulateMatch(ctx, req.(*SimulateMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_UpdateExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpectationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).UpdateExpectation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_UpdateExpectation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).UpdateExpectation(ctx, req.(*UpdateExpectationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_UpsertExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertExpectationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).UpsertExpectation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_UpsertExpectation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).UpsertExpectation(ctx, req.(*UpsertExpectationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_DeleteExpectation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpectationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).DeleteExpectation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_DeleteExpectation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).DeleteExpectation(ctx, req.(*DeleteExpectationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ResetExpectations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetExpectationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -476,6 +1244,348 @@ func _AmqpMockServerService_ResetSubscriptions_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AmqpMockServerService_GetDefaultResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetDefaultResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetDefaultResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetDefaultResponse(ctx, req.(*GetDefaultResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_SetDefaultResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).SetDefaultResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_SetDefaultResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).SetDefaultResponse(ctx, req.(*SetDefaultResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ResetDefaultResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetDefaultResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ResetDefaultResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ResetDefaultResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ResetDefaultResponse(ctx, req.(*ResetDefaultResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_StartRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).StartRecording(ctx, req.(*StartRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_StopRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).StopRecording(ctx, req.(*StopRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetRecordings(ctx, req.(*GetRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ResetRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ResetRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ResetRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ResetRecordings(ctx, req.(*ResetRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetScenarios(ctx, req.(*GetScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetScenario(ctx, req.(*GetScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_SetScenarioState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScenarioStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).SetScenarioState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_SetScenarioState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).SetScenarioState(ctx, req.(*SetScenarioStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ResetScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ResetScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ResetScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ResetScenario(ctx, req.(*ResetScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ResetScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ResetScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ResetScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ResetScenarios(ctx, req.(*ResetScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetSessions(ctx, req.(*GetSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_HeartbeatSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).HeartbeatSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_HeartbeatSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).HeartbeatSession(ctx, req.(*HeartbeatSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmqpMockServerService_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmqpMockServerServiceServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmqpMockServerService_CloseSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmqpMockServerServiceServer).CloseSession(ctx, req.(*CloseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AmqpMockServerService_ResetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetAllRequest)
 	if err := dec(in); err != nil {
@@ -527,6 +1637,30 @@ var AmqpMockServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssertions",
 			Handler:    _AmqpMockServerService_GetAssertions_Handler,
 		},
+		{
+			MethodName: "GetAssertion",
+			Handler:    _AmqpMockServerService_GetAssertion_Handler,
+		},
+		{
+			MethodName: "WaitForAssertions",
+			Handler:    _AmqpMockServerService_WaitForAssertions_Handler,
+		},
+		{
+			MethodName: "ResetAssertions",
+			Handler:    _AmqpMockServerService_ResetAssertions_Handler,
+		},
+		{
+			MethodName: "GetAssertionsStats",
+			Handler:    _AmqpMockServerService_GetAssertionsStats_Handler,
+		},
+		{
+			MethodName: "VerifyExpectations",
+			Handler:    _AmqpMockServerService_VerifyExpectations_Handler,
+		},
+		{
+			MethodName: "VerifySequence",
+			Handler:    _AmqpMockServerService_VerifySequence_Handler,
+		},
 		{
 			MethodName: "GetExpectations",
 			Handler:    _AmqpMockServerService_GetExpectations_Handler,
@@ -535,6 +1669,22 @@ var AmqpMockServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExpectation",
 			Handler:    _AmqpMockServerService_GetExpectation_Handler,
 		},
+		{
+			MethodName: "SimulateMatch",
+			Handler:    _AmqpMockServerService_SimulateMatch_Handler,
+		},
+		{
+			MethodName: "UpdateExpectation",
+			Handler:    _AmqpMockServerService_UpdateExpectation_Handler,
+		},
+		{
+			MethodName: "UpsertExpectation",
+			Handler:    _AmqpMockServerService_UpsertExpectation_Handler,
+		},
+		{
+			MethodName: "DeleteExpectation",
+			Handler:    _AmqpMockServerService_DeleteExpectation_Handler,
+		},
 		{
 			MethodName: "ResetExpectations",
 			Handler:    _AmqpMockServerService_ResetExpectations_Handler,
@@ -559,6 +1709,82 @@ var AmqpMockServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetSubscriptions",
 			Handler:    _AmqpMockServerService_ResetSubscriptions_Handler,
 		},
+		{
+			MethodName: "GetDefaultResponse",
+			Handler:    _AmqpMockServerService_GetDefaultResponse_Handler,
+		},
+		{
+			MethodName: "SetDefaultResponse",
+			Handler:    _AmqpMockServerService_SetDefaultResponse_Handler,
+		},
+		{
+			MethodName: "ResetDefaultResponse",
+			Handler:    _AmqpMockServerService_ResetDefaultResponse_Handler,
+		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _AmqpMockServerService_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _AmqpMockServerService_StopRecording_Handler,
+		},
+		{
+			MethodName: "GetRecordings",
+			Handler:    _AmqpMockServerService_GetRecordings_Handler,
+		},
+		{
+			MethodName: "ResetRecordings",
+			Handler:    _AmqpMockServerService_ResetRecordings_Handler,
+		},
+		{
+			MethodName: "GetScenarios",
+			Handler:    _AmqpMockServerService_GetScenarios_Handler,
+		},
+		{
+			MethodName: "GetScenario",
+			Handler:    _AmqpMockServerService_GetScenario_Handler,
+		},
+		{
+			MethodName: "SetScenarioState",
+			Handler:    _AmqpMockServerService_SetScenarioState_Handler,
+		},
+		{
+			MethodName: "ResetScenario",
+			Handler:    _AmqpMockServerService_ResetScenario_Handler,
+		},
+		{
+			MethodName: "ResetScenarios",
+			Handler:    _AmqpMockServerService_ResetScenarios_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _AmqpMockServerService_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AmqpMockServerService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _AmqpMockServerService_CreateSession_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _AmqpMockServerService_GetSessions_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _AmqpMockServerService_GetSession_Handler,
+		},
+		{
+			MethodName: "HeartbeatSession",
+			Handler:    _AmqpMockServerService_HeartbeatSession_Handler,
+		},
+		{
+			MethodName: "CloseSession",
+			Handler:    _AmqpMockServerService_CloseSession_Handler,
+		},
 		{
 			MethodName: "ResetAll",
 			Handler:    _AmqpMockServerService_ResetAll_Handler,
@@ -568,6 +1794,12 @@ var AmqpMockServerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AmqpMockServerService_GetVersion_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _AmqpMockServerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "mockserver.proto",
 }
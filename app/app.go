@@ -10,8 +10,12 @@ import (
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/config"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/amqp"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/files"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/grpc"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/state"
 	gocoreamqp "github.com/dialecticanet-com/rmq-rpc-mockserver/lib/amqp"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/lib/components"
 )
@@ -22,7 +26,43 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		ReplaceAttr: nil,
 	})))
 
-	expectationsSvc := app.NewExpectationsService()
+	stateStore, err := newStateStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	changes := app.NewChanges()
+	events := app.NewEvents()
+	retention := expectations.Retention{
+		MaxCount: cfg.AssertionsMaxCount,
+		MaxAge:   cfg.AssertionsMaxAge(),
+	}
+	expectationsSvc := app.NewExpectationsService(
+		app.WithExpectationsChanges(changes),
+		app.WithExpectationsEvents(events),
+		app.WithAssertionsRetention(retention),
+	)
+
+	// the namespaces other than the default one are neither persisted nor loaded from files
+	namespaces := app.NewNamespaces(expectationsSvc,
+		app.WithExpectationsEvents(events),
+		app.WithAssertionsRetention(retention),
+	)
+
+	// load the expectation files before the consumers start
+	var runnables []components.Component
+	if cfg.ExpectationsDir != "" {
+		loader := files.NewExpectationsLoader(cfg.ExpectationsDir, cfg.ExpectationsPollInterval(), expectationsSvc)
+		if err := loader.Load(); err != nil {
+			return fmt.Errorf("failed to load expectation files: %w", err)
+		}
+		runnables = append(runnables, loader)
+	}
+
+	fallbackSvc, err := newFallbackService(cfg)
+	if err != nil {
+		return err
+	}
 
 	amqpCon, err := connectToRabbitMQ(ctx, cfg)
 	if err != nil {
@@ -37,14 +77,38 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		}
 	}()
 
-	amqpConsumer, err := amqp.NewConsumer(amqpCon, expectationsSvc)
+	rpcClient, err := gocoreamqp.NewRPCClient(amqpCon, gocoreamqp.RPCClientWithTimeout(cfg.ProxyTimeout()))
+	if err != nil {
+		return fmt.Errorf("failed to create RabbitMQ RPC client: %w", err)
+	}
+
+	recordingSvc := app.NewRecordingService(proxyTarget(cfg))
+
+	amqpConsumer, err := amqp.NewConsumer(amqpCon, namespaces, fallbackSvc,
+		amqp.WithForwarder(rpcClient),
+		amqp.WithRecorder(recordingSvc),
+		amqp.WithPrefetchCount(cfg.AMQPPrefetchCount),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create RabbitMQ consumer: %w", err)
 	}
 
-	subscriptionsSvc := app.NewSubscriptionsService(amqpConsumer)
+	subscriptionsSvc := app.NewSubscriptionsService(amqpConsumer, app.WithSubscriptionsChanges(changes), app.WithSubscriptionsEvents(events))
+
+	sessionsSvc := app.NewSessionsService(namespaces, subscriptionsSvc)
+
+	// restore the state of the previous run before the configured queues are subscribed to
+	if stateStore != nil {
+		stateSvc := app.NewStateService(stateStore, expectationsSvc, subscriptionsSvc, changes, stateOptions(cfg)...)
+		if err := stateSvc.Restore(); err != nil {
+			return fmt.Errorf("failed to restore state: %w", err)
+		}
+		runnables = append(runnables, stateSvc)
+	}
+
 	for _, queue := range cfg.AMQPQueues() {
-		if _, err := subscriptionsSvc.Subscribe(queue, false); err != nil {
+		// idempotent, so that the queues restored from the state are not subscribed to twice
+		if _, err := subscriptionsSvc.Subscribe(queue, true); err != nil {
 			return fmt.Errorf("failed to subscribe to queue %s: %w", queue, err)
 		}
 	}
@@ -54,8 +118,10 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("failed to create infrastructure server: %w", err)
 	}
 
-	amqpMockserverService := grpc.NewAmqpMockServerServiceServer(expectationsSvc, subscriptionsSvc, &cfg.ServiceInfo)
+	amqpMockserverService := grpc.NewAmqpMockServerServiceServer(expectationsSvc, subscriptionsSvc, fallbackSvc, recordingSvc, events,
+		grpcNamespaces{namespaces}, sessionsSvc, &cfg.ServiceInfo)
 	grpcApi.RegisterAmqpMockServerServiceServer(infraSrv.grpcServer.Server, amqpMockserverService)
+	infraSrv.mux.Handle("GET /api/v1/events", amqpMockserverService.EventsHandler())
 	err = infraSrv.grpcGateway.RegisterServiceHandlerFromEndpoint(ctx, grpcApi.RegisterAmqpMockServerServiceHandlerFromEndpoint)
 	if err != nil {
 		return fmt.Errorf("failed to register gRPC gateway: %w", err)
@@ -67,7 +133,73 @@ func Run(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("failed to create components : %w", err)
 	}
 
-	return cmp.Run(ctx, infraSrv.grpcServer, infraSrv.httpServer, amqpConsumer)
+	runnables = append(runnables, infraSrv.grpcServer, infraSrv.httpServer, amqpConsumer)
+
+	return cmp.Run(ctx, runnables...)
+}
+
+// grpcNamespaces adapts the namespaces to the interface of the gRPC server.
+type grpcNamespaces struct {
+	*app.Namespaces
+}
+
+func (n grpcNamespaces) Get(namespace string) grpc.ExpectationsService {
+	return n.Namespaces.Get(namespace)
+}
+
+// newStateStore returns the configured store persisting the state across restarts, or nil if there is none.
+func newStateStore(cfg *config.Config) (app.StateStore, error) {
+	switch {
+	case cfg.StateFile != "" && cfg.StateDir != "":
+		return nil, fmt.Errorf("only one of STATE_FILE and STATE_DIR can be set")
+	case cfg.StateFile != "":
+		return state.NewFileStore(cfg.StateFile), nil
+	case cfg.StateDir != "":
+		return state.NewDirStore(cfg.StateDir), nil
+	default:
+		return nil, nil // nolint: nilnil
+	}
+}
+
+func stateOptions(cfg *config.Config) []app.StateOption {
+	var opts []app.StateOption
+	if cfg.StateSaveInterval() > 0 {
+		opts = append(opts, app.WithSaveInterval(cfg.StateSaveInterval()))
+	}
+
+	if cfg.StatePersistAssertions {
+		opts = append(opts, app.WithPersistedAssertions())
+	}
+
+	return opts
+}
+
+func newFallbackService(cfg *config.Config) (*app.FallbackService, error) {
+	unmatchedPolicy, err := subscriptions.ParseFallbackPolicy(cfg.UnmatchedPolicyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unmatched policy: %w", err)
+	}
+
+	var opts []app.FallbackOption
+	if target := proxyTarget(cfg); target != nil {
+		opts = append(opts, app.WithDefaultProxyTarget(target))
+	} else if unmatchedPolicy == subscriptions.FallbackPolicyProxy {
+		return nil, fmt.Errorf("PROXY_EXCHANGE is required for the %s unmatched policy", unmatchedPolicy)
+	}
+
+	return app.NewFallbackService(unmatchedPolicy, opts...), nil
+}
+
+// proxyTarget returns the configured real service for the proxy and record modes, or nil if there is none.
+func proxyTarget(cfg *config.Config) *subscriptions.ProxyTarget {
+	if cfg.ProxyExchange == "" {
+		return nil
+	}
+
+	return &subscriptions.ProxyTarget{
+		Exchange:   cfg.ProxyExchange,
+		RoutingKey: cfg.ProxyRoutingKey,
+	}
 }
 
 func connectToRabbitMQ(ctx context.Context, cfg *config.Config) (*gocoreamqp.Connection, error) {
@@ -12,6 +12,8 @@ type infraServer struct {
 	grpcServer  *gocoregrpc.Server
 	httpServer  *gocorehttp.Server
 	grpcGateway *gocoregrpc.Gateway
+	// mux routes the HTTP requests, the ones without a handler of their own go to the gateway.
+	mux *http.ServeMux
 }
 
 func newInfraServer(httpPort, grpcPort int) (*infraServer, error) {
@@ -43,5 +45,6 @@ func newInfraServer(httpPort, grpcPort int) (*infraServer, error) {
 		grpcServer:  grpcServer,
 		httpServer:  httpServer,
 		grpcGateway: grpcGateway,
+		mux:         mux,
 	}, nil
 }
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/google/uuid"
+)
+
+// EventType is the kind of a server event.
+type EventType string
+
+const (
+	EventExpectationCreated   EventType = "expectation.created"
+	EventExpectationUpdated   EventType = "expectation.updated"
+	EventExpectationDeleted   EventType = "expectation.deleted"
+	EventExpectationUsed      EventType = "expectation.used"
+	EventExpectationExhausted EventType = "expectation.exhausted"
+	EventExpectationExpired   EventType = "expectation.expired"
+	EventRequestMatched       EventType = "request.matched"
+	EventRequestUnmatched     EventType = "request.unmatched"
+	EventSubscriptionAdded    EventType = "subscription.added"
+	EventSubscriptionRemoved  EventType = "subscription.removed"
+)
+
+// eventBuffer is the number of events a slow watcher can fall behind before events are dropped for it.
+const eventBuffer = 256
+
+// Event is something that happened in the server, published to the watchers.
+type Event struct {
+	Type      EventType
+	CreatedAt time.Time
+	// ExpectationID is set for expectation events and matched requests.
+	ExpectationID *uuid.UUID
+	// Exchange and RoutingKey are the ones of the request, or of the expectation for expectation events.
+	Exchange   string
+	RoutingKey string
+	// Candidate is set for request events.
+	Candidate *expectations.Candidate
+	// SubscriptionID and Queue are set for subscription events.
+	SubscriptionID *uuid.UUID
+	Queue          string
+	// Namespace is the namespace the event happened in, empty for the default one.
+	Namespace string
+}
+
+func newExpectationEvent(t EventType, exp *expectations.Expectation) Event {
+	return Event{
+		Type:          t,
+		CreatedAt:     time.Now(),
+		ExpectationID: &exp.ID,
+		Exchange:      exp.Request.Exchange,
+		RoutingKey:    exp.Request.RoutingKey,
+	}
+}
+
+func newRequestEvent(t EventType, cnd *expectations.Candidate, exp *expectations.Expectation) Event {
+	e := Event{
+		Type:       t,
+		CreatedAt:  time.Now(),
+		Exchange:   cnd.Exchange,
+		RoutingKey: cnd.RoutingKey,
+		Candidate:  cnd,
+	}
+
+	if exp != nil {
+		e.ExpectationID = &exp.ID
+	}
+
+	return e
+}
+
+// EventFilter selects the events of a watcher, unset fields match any event.
+// Namespace is always matched, so that watchers only see the events of their own namespace.
+type EventFilter struct {
+	Namespace     string
+	Types         []EventType
+	ExpectationID *uuid.UUID
+	Exchange      string
+	RoutingKey    string
+	Queue         string
+}
+
+func (f EventFilter) matches(e Event) bool {
+	if e.Namespace != f.Namespace {
+		return false
+	}
+
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.Type) {
+		return false
+	}
+
+	if f.ExpectationID != nil && (e.ExpectationID == nil || *e.ExpectationID != *f.ExpectationID) {
+		return false
+	}
+
+	if f.Exchange != "" && e.Exchange != f.Exchange {
+		return false
+	}
+
+	if f.RoutingKey != "" && e.RoutingKey != f.RoutingKey {
+		return false
+	}
+
+	return f.Queue == "" || e.Queue == f.Queue
+}
+
+// Events fans the server events out to the watchers. A nil *Events ignores them.
+type Events struct {
+	m        sync.Mutex
+	watchers map[*EventWatcher]struct{}
+}
+
+// NewEvents creates a new Events instance.
+func NewEvents() *Events {
+	return &Events{
+		watchers: make(map[*EventWatcher]struct{}),
+	}
+}
+
+// Publish sends the event to the watchers whose filter it matches, without blocking.
+// Watchers that fall too far behind miss the event.
+func (e *Events) Publish(event Event) {
+	if e == nil {
+		return
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	for w := range e.watchers {
+		if !w.filter.matches(event) {
+			continue
+		}
+
+		select {
+		case w.ch <- event:
+		default:
+			slog.Warn("event dropped for a slow watcher", "type", event.Type)
+		}
+	}
+}
+
+// Watch starts watching the events matching the filter. The watcher must be closed when done.
+func (e *Events) Watch(filter EventFilter) *EventWatcher {
+	w := &EventWatcher{
+		events: e,
+		filter: filter,
+		ch:     make(chan Event, eventBuffer),
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	e.watchers[w] = struct{}{}
+
+	return w
+}
+
+// EventWatcher receives the events matching its filter.
+type EventWatcher struct {
+	events *Events
+	filter EventFilter
+	ch     chan Event
+}
+
+// C returns the channel the events are delivered to.
+func (w *EventWatcher) C() <-chan Event {
+	return w.ch
+}
+
+// Close stops the delivery of events.
+func (w *EventWatcher) Close() {
+	w.events.m.Lock()
+	defer w.events.m.Unlock()
+
+	delete(w.events.watchers, w)
+}
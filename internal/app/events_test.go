@@ -0,0 +1,157 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_ExpectationsService(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	svc := NewExpectationsService(WithExpectationsEvents(events))
+
+	watcher := events.Watch(EventFilter{})
+	defer watcher.Close()
+
+	exp := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithLimitedTimes(1))
+	require.NoError(t, svc.Create(exp))
+
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte(`"foo"`)))
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte(`"foo"`)))
+
+	require.NoError(t, svc.Delete(exp.ID))
+
+	want := []EventType{
+		EventExpectationCreated,
+		EventRequestMatched,
+		EventExpectationUsed,
+		EventExpectationExhausted,
+		EventRequestUnmatched,
+		EventExpectationDeleted,
+	}
+	for _, wantType := range want {
+		event := receiveEvent(t, watcher)
+		assert.Equal(t, wantType, event.Type)
+		assert.Equal(t, "exchange", event.Exchange)
+		assert.Equal(t, "rk", event.RoutingKey)
+
+		if wantType == EventRequestUnmatched {
+			assert.Nil(t, event.ExpectationID)
+		} else {
+			require.NotNil(t, event.ExpectationID)
+			assert.Equal(t, exp.ID, *event.ExpectationID)
+		}
+	}
+}
+
+func TestEvents_Expired(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	svc := NewExpectationsService(WithExpectationsEvents(events))
+
+	watcher := events.Watch(EventFilter{Types: []EventType{EventExpectationExpired}})
+	defer watcher.Close()
+
+	exp := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithTimeToLive(10*time.Millisecond))
+	require.NoError(t, svc.Create(exp))
+
+	event := receiveEvent(t, watcher)
+	assert.Equal(t, EventExpectationExpired, event.Type)
+	assert.Equal(t, exp.ID, *event.ExpectationID)
+}
+
+func TestEvents_ExpiredRemoved(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	svc := NewExpectationsService(WithExpectationsEvents(events))
+
+	watcher := events.Watch(EventFilter{Types: []EventType{EventExpectationExpired}})
+	defer watcher.Close()
+
+	deleted := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithTimeToLive(10*time.Millisecond))
+	require.NoError(t, svc.Create(deleted))
+	require.NoError(t, svc.Delete(deleted.ID))
+
+	updated := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithTimeToLive(10*time.Millisecond))
+	require.NoError(t, svc.Create(updated))
+	require.NoError(t, svc.Update(updated.ID, newTestExpectation(t, "exchange", "rk", []byte(`{}`))))
+
+	sessionID := uuid.New()
+	ended := newTestExpectation(t, "exchange", "rk", []byte(`{}`),
+		expectations.WithTimeToLive(10*time.Millisecond), expectations.WithSessionID(sessionID))
+	require.NoError(t, svc.Create(ended))
+	svc.DeleteBySession(sessionID)
+
+	kept := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithTimeToLive(50*time.Millisecond))
+	require.NoError(t, svc.Create(kept))
+
+	// Only the expectation still present expires.
+	event := receiveEvent(t, watcher)
+	assert.Equal(t, kept.ID, *event.ExpectationID)
+	assert.Empty(t, watcher.C())
+}
+
+func TestEvents_SubscriptionsService(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	svc := NewSubscriptionsService(&testConsumer{}, WithSubscriptionsEvents(events))
+
+	watcher := events.Watch(EventFilter{Queue: "queue"})
+	defer watcher.Close()
+
+	_, err := svc.Subscribe("other", false)
+	require.NoError(t, err)
+	sub, err := svc.Subscribe("queue", false)
+	require.NoError(t, err)
+	require.NoError(t, svc.UnsubscribeByID(sub.ID()))
+
+	event := receiveEvent(t, watcher)
+	assert.Equal(t, EventSubscriptionAdded, event.Type)
+	assert.Equal(t, sub.ID(), *event.SubscriptionID)
+
+	event = receiveEvent(t, watcher)
+	assert.Equal(t, EventSubscriptionRemoved, event.Type)
+	assert.Equal(t, sub.ID(), *event.SubscriptionID)
+}
+
+func TestEvents_Filter(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	svc := NewExpectationsService(WithExpectationsEvents(events))
+
+	watcher := events.Watch(EventFilter{Types: []EventType{EventRequestUnmatched}, RoutingKey: "rk2"})
+
+	svc.Match(newTestCandidate(t, "exchange", "rk1", []byte(`"foo"`)))
+	svc.Match(newTestCandidate(t, "exchange", "rk2", []byte(`"foo"`)))
+
+	event := receiveEvent(t, watcher)
+	assert.Equal(t, "rk2", event.RoutingKey)
+	require.NotNil(t, event.Candidate)
+
+	watcher.Close()
+	svc.Match(newTestCandidate(t, "exchange", "rk2", []byte(`"foo"`)))
+	assert.Empty(t, watcher.C())
+}
+
+func receiveEvent(t *testing.T, watcher *EventWatcher) Event {
+	t.Helper()
+
+	select {
+	case event := <-watcher.C():
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "no event received")
+		return Event{}
+	}
+}
@@ -1,8 +1,13 @@
 package app
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -11,16 +16,66 @@ import (
 	"github.com/google/uuid"
 )
 
+var (
+	ErrExpectationNotFound      = errors.New("expectation not found")
+	ErrDuplicateExpectationName = errors.New("expectation name already exists")
+	ErrWaitTimeout              = errors.New("timed out waiting for assertions")
+	ErrInvalidPageToken         = errors.New("invalid page token")
+	ErrAssertionNotFound        = errors.New("assertion not found")
+)
+
 // ExpectationsService is the application level service to manage expectations.
 type ExpectationsService struct {
 	m            sync.RWMutex
 	expectations []*expectations.Expectation
 	assertions   expectations.Assertions
+	scenarios    expectations.Scenarios
+	changes      *Changes
+	events       *Events
+	namespace    string
+	// asserted is closed and cleared whenever an assertion is added or updated, waking up the waiters.
+	asserted chan struct{}
+}
+
+// ExpectationsOption is a function that configures an ExpectationsService.
+type ExpectationsOption func(s *ExpectationsService)
+
+// WithExpectationsChanges makes the service notify every change of the expectations and assertions.
+func WithExpectationsChanges(c *Changes) ExpectationsOption {
+	return func(s *ExpectationsService) {
+		s.changes = c
+	}
+}
+
+// WithExpectationsEvents makes the service publish the expectation and request events.
+func WithExpectationsEvents(e *Events) ExpectationsOption {
+	return func(s *ExpectationsService) {
+		s.events = e
+	}
+}
+
+// WithExpectationsNamespace names the namespace the service holds the expectations of, in its events and logs.
+func WithExpectationsNamespace(namespace string) ExpectationsOption {
+	return func(s *ExpectationsService) {
+		s.namespace = namespace
+	}
+}
+
+// WithAssertionsRetention limits the history of assertions, the ones beyond the limits are evicted.
+func WithAssertionsRetention(r expectations.Retention) ExpectationsOption {
+	return func(s *ExpectationsService) {
+		s.assertions.SetRetention(r)
+	}
 }
 
 // NewExpectationsService creates a new ExpectationsService instance.
-func NewExpectationsService() *ExpectationsService {
-	return &ExpectationsService{}
+func NewExpectationsService(opts ...ExpectationsOption) *ExpectationsService {
+	s := &ExpectationsService{}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // Create creates a new expectation.
@@ -28,47 +83,219 @@ func (s *ExpectationsService) Create(exp *expectations.Expectation) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
+	if exp.Name != "" && s.indexByName(exp.Name) >= 0 {
+		return fmt.Errorf("%w: %s", ErrDuplicateExpectationName, exp.Name)
+	}
+
+	s.create(exp)
+
+	return nil
+}
+
+// Update replaces the definition of an existing expectation, keeping its ID, source, session and place in the match order.
+// The usage count and the time to live of the new definition start over.
+func (s *ExpectationsService) Update(id uuid.UUID, exp *expectations.Expectation) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	i := s.indexByID(id)
+	if i < 0 {
+		return fmt.Errorf("%w: %s", ErrExpectationNotFound, id)
+	}
+
+	if exp.Name != "" {
+		if j := s.indexByName(exp.Name); j >= 0 && j != i {
+			return fmt.Errorf("%w: %s", ErrDuplicateExpectationName, exp.Name)
+		}
+	}
+
+	s.update(i, exp)
+
+	return nil
+}
+
+// Upsert replaces the expectation with the same ID, or else the one with the same name if the expectation has a name,
+// and creates the expectation if there is none. It reports whether the expectation was created.
+func (s *ExpectationsService) Upsert(exp *expectations.Expectation) (bool, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	i := s.indexByID(exp.ID)
+	if exp.Name != "" {
+		j := s.indexByName(exp.Name)
+		switch {
+		case i < 0:
+			i = j
+		case j >= 0 && j != i:
+			return false, fmt.Errorf("%w: %s", ErrDuplicateExpectationName, exp.Name)
+		}
+	}
+
+	if i < 0 {
+		s.create(exp)
+		return true, nil
+	}
+
+	s.update(i, exp)
+
+	return false, nil
+}
+
+// Delete removes a single expectation.
+func (s *ExpectationsService) Delete(id uuid.UUID) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	i := s.indexByID(id)
+	if i < 0 {
+		return fmt.Errorf("%w: %s", ErrExpectationNotFound, id)
+	}
+
+	s.publish(newExpectationEvent(EventExpectationDeleted, s.expectations[i]))
+	s.expectations = append(s.expectations[:i], s.expectations[i+1:]...)
+	s.changes.Notify()
+	s.log(fmt.Sprintf("Expectation deleted. ExpectationID=%s", id))
+
+	return nil
+}
+
+// DeleteBySession removes the expectations owned by the session and returns how many were removed.
+func (s *ExpectationsService) DeleteBySession(sessionID uuid.UUID) int {
+	if sessionID == uuid.Nil {
+		return 0
+	}
+
+	return s.deleteWhere(func(exp *expectations.Expectation) bool { return exp.SessionID == sessionID },
+		fmt.Sprintf("with its session. SessionID=%s", sessionID))
+}
+
+// DeleteBySelector removes the expectations with labels matching the selector and returns how many were removed.
+// Unlike Reset, it leaves the scenarios as they are.
+func (s *ExpectationsService) DeleteBySelector(selector expectations.LabelSelector) int {
+	return s.deleteWhere(func(exp *expectations.Expectation) bool { return selector.Matches(exp.Labels) },
+		"by label selector.")
+}
+
+// deleteWhere removes the expectations matching the predicate, the reason completes their log line.
+func (s *ExpectationsService) deleteWhere(match func(exp *expectations.Expectation) bool, reason string) int {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	kept := s.expectations[:0]
+	n := 0
+	for _, exp := range s.expectations {
+		if !match(exp) {
+			kept = append(kept, exp)
+			continue
+		}
+
+		n++
+		s.publish(newExpectationEvent(EventExpectationDeleted, exp))
+		s.log(fmt.Sprintf("Expectation deleted %s ExpectationID=%s", reason, exp.ID))
+	}
+
+	// clear the tail, so that the removed expectations are not kept alive by the underlying array
+	clear(s.expectations[len(kept):])
+	s.expectations = kept
+	if n > 0 {
+		s.changes.Notify()
+	}
+
+	return n
+}
+
+func (s *ExpectationsService) create(exp *expectations.Expectation) {
 	s.expectations = append(s.expectations, exp)
+	s.changes.Notify()
+	s.publish(newExpectationEvent(EventExpectationCreated, exp))
 	s.log(
 		fmt.Sprintf("Expectation created. ExpectationID=%s, Exchange=%s, RoutingKey=%s", exp.ID, exp.Request.Exchange, exp.Request.RoutingKey),
 		fmt.Sprintf("REQUEST:\n   %s", exp.Request.FormattedBody(3)),
 	)
 
 	if exp.TimeToLive != nil && exp.TimeToLive.TTL > 0 {
-		go s.informExpectationExpired(exp.ID, exp.TimeToLive.TTL)
+		go s.informExpectationExpired(exp)
 	}
+}
 
-	return nil
+func (s *ExpectationsService) update(i int, exp *expectations.Expectation) {
+	exp.ID = s.expectations[i].ID
+	exp.Source = s.expectations[i].Source
+	exp.SessionID = s.expectations[i].SessionID
+	s.expectations[i] = exp
+	s.changes.Notify()
+	s.publish(newExpectationEvent(EventExpectationUpdated, exp))
+	s.log(
+		fmt.Sprintf("Expectation updated. ExpectationID=%s, Exchange=%s, RoutingKey=%s", exp.ID, exp.Request.Exchange, exp.Request.RoutingKey),
+		fmt.Sprintf("REQUEST:\n   %s", exp.Request.FormattedBody(3)),
+	)
+
+	if exp.TimeToLive != nil && exp.TimeToLive.TTL > 0 {
+		go s.informExpectationExpired(exp)
+	}
 }
 
-// Match matches a candidate against the expectations.
-func (s *ExpectationsService) Match(candidate *expectations.Candidate) *expectations.Response {
+func (s *ExpectationsService) indexByID(id uuid.UUID) int {
+	for i, exp := range s.expectations {
+		if exp.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *ExpectationsService) indexByName(name string) int {
+	for i, exp := range s.expectations {
+		if exp.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ReplaceBySourcePrefix atomically replaces all expectations whose source starts with the prefix.
+// Expectations from other sources, including the ones created through the API, are left untouched.
+func (s *ExpectationsService) ReplaceBySourcePrefix(prefix string, exps []*expectations.Expectation) {
 	s.m.Lock()
 	defer s.m.Unlock()
 
-	matches := make([]*expectations.Expectation, 0)
+	kept := make([]*expectations.Expectation, 0, len(s.expectations)+len(exps))
 	for _, exp := range s.expectations {
-		if exp.Matches(candidate) {
-			matches = append(matches, exp)
+		if exp.Source == "" || !strings.HasPrefix(exp.Source, prefix) {
+			kept = append(kept, exp)
 		}
 	}
 
+	s.expectations = append(kept, exps...)
+	s.changes.Notify()
+	s.log(fmt.Sprintf("Expectations replaced. Source=%s*, Count=%d", prefix, len(exps)))
+}
+
+// Match matches a candidate against the expectations.
+// The matching expectation with the highest priority wins, on equal priorities the one created first.
+// Expectations that are part of a scenario only match while the scenario is in their required state,
+// and the matched expectation moves its scenario to the new state.
+// It returns a snapshot of the matched expectation taken right after it was used, or nil if nothing matched.
+func (s *ExpectationsService) Match(candidate *expectations.Candidate) *expectations.Expectation {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	matches := s.matches(candidate)
 	if len(matches) == 0 {
-		s.assertions.Add(expectations.NewUnmatchedAssertion(candidate))
-		s.log(
-			fmt.Sprintf("NO MATCH FOUND. Exchange: %s, RoutingKey: %s", candidate.Exchange, candidate.RoutingKey),
-			fmt.Sprintf("REQUEST:\n   %s", candidate.FormattedBody(3)),
-		)
+		assertion := expectations.NewUnmatchedAssertion(candidate)
+		assertion.NearMisses = expectations.FindNearMisses(candidate, s.expectations, &s.scenarios)
+		s.addUnmatched(assertion, formatNearMisses(assertion.NearMisses)...)
 		return nil
 	}
 
-	// sort matches by priority
-	sort.Slice(matches, func(i, j int) bool {
-		return matches[i].Priority > matches[j].Priority
-	})
-
 	matches[0].Use()
-	s.assertions.Add(expectations.NewMatchedAssertion(candidate, matches[0]))
+	assertion := expectations.NewMatchedAssertion(candidate, matches[0])
+	s.addAssertion(assertion)
+	s.changes.Notify()
+	s.publish(newRequestEvent(EventRequestMatched, candidate, matches[0]))
+	s.publish(newExpectationEvent(EventExpectationUsed, matches[0]))
 	s.log(
 		fmt.Sprintf("MATCH FOUND. ExpectationID=%s, Exchange: %s, RoutingKey: %s", matches[0].ID, candidate.Exchange, candidate.RoutingKey),
 		fmt.Sprintf("REQUEST:\n   %s", candidate.FormattedBody(3)),
@@ -76,23 +303,298 @@ func (s *ExpectationsService) Match(candidate *expectations.Candidate) *expectat
 	)
 
 	if !matches[0].IsActive() {
+		s.publish(newExpectationEvent(EventExpectationExhausted, matches[0]))
 		s.log(fmt.Sprintf("Expectation usage limit reached. ExpectationID=%s", matches[0].ID))
 	}
 
-	return matches[0].Response
+	if sc := matches[0].Scenario; sc != nil && sc.NewState != "" {
+		s.scenarios.Set(sc.Name, sc.NewState)
+		s.log(fmt.Sprintf("Scenario state changed. Scenario=%s, State=%s", sc.Name, sc.NewState))
+	}
+
+	return assertion.Expectation
+}
+
+// recordUnknownNamespace records the candidate as unmatched, since its namespace does not exist.
+func (s *ExpectationsService) recordUnknownNamespace(candidate *expectations.Candidate, namespace string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.addUnmatched(expectations.NewUnmatchedAssertion(candidate), fmt.Sprintf("UNKNOWN NAMESPACE: %s", namespace))
+}
+
+func (s *ExpectationsService) addUnmatched(assertion *expectations.Assertion, details ...string) {
+	candidate := assertion.Candidate
+	s.addAssertion(assertion)
+	s.changes.Notify()
+	s.publish(newRequestEvent(EventRequestUnmatched, candidate, nil))
+	s.log(append([]string{
+		fmt.Sprintf("NO MATCH FOUND. Exchange: %s, RoutingKey: %s", candidate.Exchange, candidate.RoutingKey),
+		fmt.Sprintf("REQUEST:\n   %s", candidate.FormattedBody(3)),
+	}, details...)...)
+}
+
+// matches returns the expectations that can match the candidate, in the order they are tried.
+func (s *ExpectationsService) matches(candidate *expectations.Candidate) []*expectations.Expectation {
+	matches := make([]*expectations.Expectation, 0)
+	for _, exp := range s.expectations {
+		if exp.Matches(candidate) && s.inScenarioState(exp) {
+			matches = append(matches, exp)
+		}
+	}
+
+	// sort matches by priority, ties keep the order the expectations were created in
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Priority > matches[j].Priority
+	})
+
+	return matches
+}
+
+// MatchSimulation is the outcome of matching a candidate without side effects.
+type MatchSimulation struct {
+	// Matches are the expectations matching the candidate in priority order, the first one would win.
+	Matches []*expectations.Expectation
+	// Skipped are the expectations whose request matches the candidate, but which cannot match at the moment
+	// because they are used up, expired or wait for another scenario state.
+	Skipped []*expectations.NearMiss
+}
+
+// SimulateMatch matches the candidate the same way as Match, without using the expectations,
+// moving the scenarios or recording an assertion.
+func (s *ExpectationsService) SimulateMatch(candidate *expectations.Candidate) *MatchSimulation {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	sim := &MatchSimulation{}
+	for _, exp := range s.matches(candidate) {
+		sim.Matches = append(sim.Matches, exp.Copy())
+	}
+
+	for _, exp := range s.expectations {
+		if !exp.Request.Matches(candidate) {
+			continue
+		}
+
+		if reasons := expectations.InactiveReasons(exp, &s.scenarios); len(reasons) > 0 {
+			sim.Skipped = append(sim.Skipped, &expectations.NearMiss{Expectation: exp.Copy(), Reasons: reasons})
+		}
+	}
+
+	return sim
+}
+
+func (s *ExpectationsService) addAssertion(assertion *expectations.Assertion) {
+	s.assertions.Add(assertion)
+	s.wakeWaiters()
+}
+
+func (s *ExpectationsService) wakeWaiters() {
+	if s.asserted != nil {
+		close(s.asserted)
+		s.asserted = nil
+	}
+}
+
+func (s *ExpectationsService) inScenarioState(exp *expectations.Expectation) bool {
+	return exp.Scenario == nil || exp.Scenario.Allows(s.scenarios.State(exp.Scenario.Name))
+}
+
+// RecordProxy attaches the outcome of forwarding an unmatched candidate to the real service to its assertion.
+func (s *ExpectationsService) RecordProxy(candidate *expectations.Candidate, result *expectations.ProxyResult) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	assertion := s.assertions.GetByCandidate(candidate)
+	if assertion == nil {
+		return
+	}
+
+	assertion.Proxy = result
+	s.wakeWaiters()
+	s.changes.Notify()
+	s.log(
+		fmt.Sprintf("REQUEST PROXIED. Exchange: %s, RoutingKey: %s", result.Exchange, result.RoutingKey),
+		fmt.Sprintf("REQUEST:\n   %s", candidate.FormattedBody(3)),
+		fmt.Sprintf("RESPONSE:\n   %s", string(result.Response)),
+	)
+}
+
+// RecordReply attaches the reply published to the caller of the candidate to its assertion.
+func (s *ExpectationsService) RecordReply(candidate *expectations.Candidate, reply *expectations.Reply) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	assertion := s.assertions.GetByCandidate(candidate)
+	if assertion == nil {
+		return
+	}
+
+	assertion.Reply = reply
+	s.wakeWaiters()
+	s.changes.Notify()
+	if reply.Failed() {
+		s.log(
+			fmt.Sprintf("REPLY FAILED. Exchange: %s, RoutingKey: %s, ReplyTo: %s", candidate.Exchange, candidate.RoutingKey, reply.Queue),
+			fmt.Sprintf("ERROR: %s", reply.Error),
+		)
+	}
 }
 
-func (s *ExpectationsService) informExpectationExpired(id uuid.UUID, ttl time.Duration) {
+func (s *ExpectationsService) informExpectationExpired(exp *expectations.Expectation) {
+	ttl := exp.TimeToLive.TTL
 	time.Sleep(ttl)
-	s.log(fmt.Sprintf("Expectation expired. ExpectationID=%s, TTL=%v", id, ttl.Seconds()))
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// The expectation may have been deleted, updated or reset in the meantime, in which case it did not expire.
+	if !slices.Contains(s.expectations, exp) {
+		return
+	}
+
+	s.publish(newExpectationEvent(EventExpectationExpired, exp))
+	s.log(fmt.Sprintf("Expectation expired. ExpectationID=%s, TTL=%v", exp.ID, ttl.Seconds()))
 }
 
-// Reset removes all expectations from the service.
+// Reset removes all expectations from the service and moves all scenarios back to their initial state.
 func (s *ExpectationsService) Reset() {
 	s.m.Lock()
 	defer s.m.Unlock()
 
 	s.expectations = nil
+	s.scenarios.Clear()
+	s.changes.Notify()
+}
+
+// Restore adds expectations, assertions and scenario states taken from a snapshot of a previous run.
+func (s *ExpectationsService) Restore(
+	exps []*expectations.Expectation,
+	assertions []*expectations.Assertion,
+	scenarioStates map[string]string,
+) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.expectations = append(s.expectations, exps...)
+	for _, assertion := range assertions {
+		s.addAssertion(assertion)
+	}
+	for name, state := range scenarioStates {
+		s.scenarios.Set(name, state)
+	}
+	s.log(fmt.Sprintf("Expectations restored. Expectations=%d, Assertions=%d", len(exps), len(assertions)))
+}
+
+// ScenarioState is the current state of a scenario.
+type ScenarioState struct {
+	Name  string
+	State string
+}
+
+// GetScenarios returns the scenarios used by the expectations or moved to a state, sorted by name.
+func (s *ExpectationsService) GetScenarios() []ScenarioState {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	names := make(map[string]struct{})
+	for _, exp := range s.expectations {
+		if exp.Scenario != nil {
+			names[exp.Scenario.Name] = struct{}{}
+		}
+	}
+	for _, name := range s.scenarios.Names() {
+		names[name] = struct{}{}
+	}
+
+	result := make([]ScenarioState, 0, len(names))
+	for name := range names {
+		result = append(result, ScenarioState{Name: name, State: s.scenarios.State(name)})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// GetScenario returns the current state of a scenario. Unknown scenarios are in their initial state.
+func (s *ExpectationsService) GetScenario(name string) ScenarioState {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return ScenarioState{Name: name, State: s.scenarios.State(name)}
+}
+
+// ScenarioStates returns the states of the scenarios that are not in their initial state, keyed by name.
+func (s *ExpectationsService) ScenarioStates() map[string]string {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.scenarios.States()
+}
+
+// SetScenarioState forces a scenario into the given state.
+func (s *ExpectationsService) SetScenarioState(name, state string) error {
+	if name == "" {
+		return expectations.ErrEmptyScenarioName
+	}
+
+	if state == "" {
+		return expectations.ErrEmptyScenarioState
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.scenarios.Set(name, state)
+	s.changes.Notify()
+	s.log(fmt.Sprintf("Scenario state set. Scenario=%s, State=%s", name, state))
+
+	return nil
+}
+
+// ResetScenario moves a scenario back to its initial state.
+func (s *ExpectationsService) ResetScenario(name string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.scenarios.Reset(name)
+	s.changes.Notify()
+	s.log(fmt.Sprintf("Scenario reset. Scenario=%s", name))
+}
+
+// ResetScenarios moves all scenarios back to their initial state.
+func (s *ExpectationsService) ResetScenarios() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.scenarios.Clear()
+	s.changes.Notify()
+	s.log("Scenarios reset")
+}
+
+// formatNearMisses describes the closest expectations of an unmatched request for the log.
+func formatNearMisses(nearMisses []*expectations.NearMiss) []string {
+	if len(nearMisses) == 0 {
+		return []string{"CLOSEST EXPECTATIONS: none"}
+	}
+
+	lines := []string{"CLOSEST EXPECTATIONS:"}
+	for i, nm := range nearMisses {
+		lines = append(lines, fmt.Sprintf("   %d. ExpectationID=%s, Exchange: %s, RoutingKey: %s, differs in: %s",
+			i+1, nm.Expectation.ID, nm.Expectation.Request.Exchange, nm.Expectation.Request.RoutingKey, strings.Join(nm.Reasons, ", ")))
+		if nm.BodyDiff != "" {
+			lines = append(lines, fmt.Sprintf("      body diff: %s", nm.BodyDiff))
+		}
+	}
+
+	return lines
+}
+
+func (s *ExpectationsService) publish(e Event) {
+	e.Namespace = s.namespace
+	s.events.Publish(e)
 }
 
 func (s *ExpectationsService) log(lines ...string) {
@@ -101,6 +603,9 @@ func (s *ExpectationsService) log(lines ...string) {
 		prefix := "   "
 		if i == 0 {
 			prefix = "-> "
+			if s.namespace != "" {
+				prefix += "[" + s.namespace + "] "
+			}
 		}
 		payload.WriteString(prefix + line)
 		if i < len(lines)-1 {
@@ -114,6 +619,8 @@ func (s *ExpectationsService) log(lines ...string) {
 // GetExpectationsRequest represents the parameters for filtering expectations.
 type GetExpectationsRequest struct {
 	Status *string // "active" or "expired"
+	// Selector selects the expectations by their labels, an empty one selects all of them.
+	Selector expectations.LabelSelector
 }
 
 // GetExpectations returns expectations filtered by the given request parameters.
@@ -121,7 +628,7 @@ func (s *ExpectationsService) GetExpectations(req GetExpectationsRequest) []*exp
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	if req.Status == nil {
+	if req.Status == nil && len(req.Selector) == 0 {
 		// Return all expectations if status is not specified
 		result := make([]*expectations.Expectation, len(s.expectations))
 		for i, exp := range s.expectations {
@@ -131,9 +638,18 @@ func (s *ExpectationsService) GetExpectations(req GetExpectationsRequest) []*exp
 		return result
 	}
 
-	// Filter expectations based on status
+	// Filter expectations based on status and labels
 	var result []*expectations.Expectation
 	for _, exp := range s.expectations {
+		if !req.Selector.Matches(exp.Labels) {
+			continue
+		}
+
+		if req.Status == nil {
+			result = append(result, exp.Copy())
+			continue
+		}
+
 		switch *req.Status {
 		case "active":
 			if exp.IsActive() {
@@ -162,30 +678,247 @@ func (s *ExpectationsService) GetExpectation(id uuid.UUID) *expectations.Expecta
 	return nil
 }
 
-type GetAssertionsRequest struct {
+// VerifyRequest represents a single verification, of either an expectation or a request spec.
+type VerifyRequest struct {
 	ExpectationID *uuid.UUID
-	Status        *string
-	Include       []string
+	Request       *expectations.Request
+	Times         expectations.VerificationTimes
 }
 
-func (s *ExpectationsService) GetAssertions(req GetAssertionsRequest) []*expectations.Assertion {
-	s.m.RLock()
-	defer s.m.RUnlock()
+// Verify checks the assertions recorded so far against each of the verifications.
+// Expectations can be verified as long as they are known, or were matched before being deleted.
+func (s *ExpectationsService) Verify(reqs []VerifyRequest) ([]*expectations.VerificationResult, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.evictAssertions()
 
-	// If ExpectationID is provided, filter by specific expectation
-	if req.ExpectationID != nil {
-		return s.assertions.GetForExpectationByID(req.ExpectationID.String())
+	results := make([]*expectations.VerificationResult, 0, len(reqs))
+	for _, req := range reqs {
+		var v *expectations.Verification
+		switch {
+		case req.ExpectationID != nil:
+			exp := s.findExpectation(*req.ExpectationID)
+			if exp == nil {
+				return nil, fmt.Errorf("%w: %s", ErrExpectationNotFound, req.ExpectationID)
+			}
+			v = expectations.NewExpectationVerification(exp, req.Times)
+		case req.Request != nil:
+			v = expectations.NewRequestVerification(req.Request, req.Times)
+		default:
+			return nil, fmt.Errorf("verification needs an expectation or a request")
+		}
+
+		results = append(results, v.Verify(s.assertions.GetAll()))
 	}
 
-	// If Status is provided, filter by match status
-	if req.Status != nil {
-		switch *req.Status {
-		case "matched":
-			return s.assertions.GetMatched()
-		case "unmatched":
-			return s.assertions.GetUnmatched()
+	return results, nil
+}
+
+// VerifySequence checks that requests matching the steps arrived in their order.
+func (s *ExpectationsService) VerifySequence(steps []*expectations.Request, strict bool) (*expectations.SequenceResult, error) {
+	v, err := expectations.NewSequenceVerification(steps, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.evictAssertions()
+
+	return v.Verify(s.assertions.GetAll()), nil
+}
+
+// findExpectation looks the expectation up among the current expectations and then among the matched assertions.
+func (s *ExpectationsService) findExpectation(id uuid.UUID) *expectations.Expectation {
+	if i := s.indexByID(id); i >= 0 {
+		return s.expectations[i]
+	}
+
+	if matched := s.assertions.Find(expectations.AssertionsQuery{ExpectationID: &id}); len(matched) > 0 {
+		return matched[0].Expectation
+	}
+
+	return nil
+}
+
+// WaitForAssertions blocks until at least count assertions match the query and returns them.
+// If the context is done first, it returns the assertions matching so far along with ErrWaitTimeout.
+func (s *ExpectationsService) WaitForAssertions(ctx context.Context, query expectations.AssertionsQuery, count int) ([]*expectations.Assertion, error) {
+	for {
+		s.m.Lock()
+		s.evictAssertions()
+
+		found := copyAssertions(s.assertions.Find(query))
+
+		if len(found) >= count {
+			s.m.Unlock()
+			return found, nil
+		}
+
+		if s.asserted == nil {
+			s.asserted = make(chan struct{})
+		}
+		asserted := s.asserted
+		s.m.Unlock()
+
+		select {
+		case <-asserted:
+		case <-ctx.Done():
+			return found, fmt.Errorf("%w: %d of %d arrived: %w", ErrWaitTimeout, len(found), count, ctx.Err())
 		}
 	}
+}
+
+type GetAssertionsRequest struct {
+	Query   expectations.AssertionsQuery
+	Include []string
+	// NewestFirst returns the latest assertions first instead of in arrival order.
+	NewestFirst bool
+	// PageSize is the maximum number of assertions returned, all of them if zero.
+	PageSize int
+	// PageToken continues after the page that returned it.
+	PageToken string
+}
+
+// AssertionsPage is a page of assertions.
+type AssertionsPage struct {
+	Assertions []*expectations.Assertion
+	// NextPageToken fetches the next page, empty on the last page.
+	NextPageToken string
+}
+
+// GetAssertions returns a page of the assertions matching the query.
+// Pages are stable while new assertions arrive, as the page token points to the last assertion returned.
+func (s *ExpectationsService) GetAssertions(req GetAssertionsRequest) (*AssertionsPage, error) {
+	var after uint64
+	if req.PageToken != "" {
+		var err error
+		if after, err = parsePageToken(req.PageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.evictAssertions()
+
+	found := s.assertions.Find(req.Query)
+	if req.NewestFirst {
+		slices.Reverse(found)
+	}
+
+	if after > 0 {
+		i := slices.IndexFunc(found, func(a *expectations.Assertion) bool {
+			if req.NewestFirst {
+				return a.Sequence < after
+			}
+			return a.Sequence > after
+		})
+		if i < 0 {
+			i = len(found)
+		}
+		found = found[i:]
+	}
+
+	page := &AssertionsPage{}
+	if req.PageSize > 0 && len(found) > req.PageSize {
+		found = found[:req.PageSize]
+		page.NextPageToken = newPageToken(found[len(found)-1].Sequence)
+	}
+	page.Assertions = copyAssertions(found)
+
+	return page, nil
+}
+
+// GetAssertion returns the assertion with the ID.
+func (s *ExpectationsService) GetAssertion(id uuid.UUID) (*expectations.Assertion, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.evictAssertions()
+
+	assertion := s.assertions.GetByID(id)
+	if assertion == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAssertionNotFound, id)
+	}
+
+	return copyAssertions([]*expectations.Assertion{assertion})[0], nil
+}
+
+func newPageToken(sequence uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(sequence, 10)))
+}
+
+func parsePageToken(token string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidPageToken, token)
+	}
+
+	sequence, err := strconv.ParseUint(string(raw), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidPageToken, token)
+	}
+
+	return sequence, nil
+}
+
+// ResetAssertions clears the history of assertions.
+func (s *ExpectationsService) ResetAssertions() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	n := s.assertions.Len()
+	s.assertions.Clear()
+	s.changes.Notify()
+	s.log(fmt.Sprintf("Assertions cleared. Assertions=%d", n))
+}
+
+// AssertionsStats describes the history of assertions.
+type AssertionsStats struct {
+	// Count is the number of assertions in the history.
+	Count int
+	// Evicted is the total number of assertions evicted because of the retention limits.
+	Evicted   uint64
+	Retention expectations.Retention
+}
+
+// AssertionsStats returns the size and the limits of the history of assertions.
+func (s *ExpectationsService) AssertionsStats() AssertionsStats {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.evictAssertions()
+
+	return AssertionsStats{
+		Count:     s.assertions.Len(),
+		Evicted:   s.assertions.Evicted(),
+		Retention: s.assertions.Retention(),
+	}
+}
+
+// evictAssertions removes the assertions that outlived the maximum age since the last assertion was added.
+func (s *ExpectationsService) evictAssertions() {
+	if s.assertions.Evict(time.Now()) > 0 {
+		s.changes.Notify()
+	}
+}
+
+// copyAssertions returns shallow copies of the assertions,
+// so that they can be read outside the lock while the listeners keep updating the originals.
+func copyAssertions(list []*expectations.Assertion) []*expectations.Assertion {
+	if list == nil {
+		return nil
+	}
 
-	return s.assertions.GetAll()
+	result := make([]*expectations.Assertion, len(list))
+	for i, a := range list {
+		cp := *a
+		result[i] = &cp
+	}
+
+	return result
 }
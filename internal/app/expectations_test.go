@@ -1,13 +1,16 @@
 package app_test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,36 +36,54 @@ func TestExpectationsService_Match(t *testing.T) {
 
 	// this candidate does not have a match
 	candidate := newTestCandidate(t, "exchange", "rk", []byte("bar"))
-	resp := svc.Match(candidate)
-	assert.Nil(t, resp)
+	exp := svc.Match(candidate)
+	assert.Nil(t, exp)
 
 	// this candidate has a match
 	candidate = newTestCandidate(t, "exchange", "rk", []byte("foo"))
 
 	// higher priority should be used
-	resp = svc.Match(candidate)
-	require.NotNil(t, resp)
-	assert.Equal(t, json.RawMessage("body3"), resp.Body)
+	exp = svc.Match(candidate)
+	require.NotNil(t, exp)
+	assert.Equal(t, json.RawMessage("body3"), exp.Response.Body)
 
 	// wait for TTL so the last expectation will expire
 	time.Sleep(50 * time.Millisecond)
 
 	// since higher priority was used, the first expectation should be used
-	resp = svc.Match(candidate)
-	require.NotNil(t, resp)
-	assert.Equal(t, json.RawMessage("body1"), resp.Body)
+	exp = svc.Match(candidate)
+	require.NotNil(t, exp)
+	assert.Equal(t, json.RawMessage("body1"), exp.Response.Body)
 
 	// this candidate has a match on rk2
 	candidate = newTestCandidate(t, "exchange", "rk2", json.RawMessage("foo"))
 
 	// expectation with TTL should not be used since it's expired
-	resp = svc.Match(candidate)
-	require.NotNil(t, resp)
-	assert.Equal(t, json.RawMessage("body2"), resp.Body)
+	exp = svc.Match(candidate)
+	require.NotNil(t, exp)
+	assert.Equal(t, json.RawMessage("body2"), exp.Response.Body)
 
 	// since there are no more expectations, nil should be returned
-	resp = svc.Match(candidate)
-	assert.Nil(t, resp)
+	exp = svc.Match(candidate)
+	assert.Nil(t, exp)
+}
+
+func TestExpectationsService_Match_EqualPriorities(t *testing.T) {
+	t.Parallel()
+
+	exps := make([]*expectations.Expectation, 0, 20)
+	for i := range 20 {
+		exps = append(exps, newTestExpectation(t, "exchange", "rk", []byte(fmt.Sprintf("body%d", i)),
+			expectations.WithUnlimitedTimes(), expectations.WithPriority(i%2)))
+	}
+	svc := newExpectationsService(t, exps)
+
+	// the first created among the highest priority ones wins, every time
+	for range 10 {
+		exp := svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+		require.NotNil(t, exp)
+		assert.Equal(t, json.RawMessage("body1"), exp.Response.Body)
+	}
 }
 
 func TestExpectationsService_Reset(t *testing.T) {
@@ -101,6 +122,28 @@ func TestExpectationsService_GetExpectations(t *testing.T) {
 	assert.Equal(t, json.RawMessage("body2"), expiredExps[0].Response.Body)
 }
 
+func TestExpectationsService_GetExpectationsBySelector(t *testing.T) {
+	t.Parallel()
+
+	checkout := newTestExpectation(t, "exchange", "rk", []byte("checkout"), expectations.WithLabels(map[string]string{"suite": "checkout", "owner": "payments"}))
+	search := newTestExpectation(t, "exchange", "rk", []byte("search"), expectations.WithLabels(map[string]string{"suite": "search"}))
+	unlabeled := newTestExpectation(t, "exchange", "rk", []byte("unlabeled"))
+	svc := newExpectationsService(t, []*expectations.Expectation{checkout, search, unlabeled})
+
+	selector, err := expectations.ParseLabelSelector("suite=checkout")
+	require.NoError(t, err)
+	exps := svc.GetExpectations(GetExpectationsRequest{Selector: selector})
+	require.Len(t, exps, 1)
+	assert.Equal(t, checkout.ID, exps[0].ID)
+
+	selector, err = expectations.ParseLabelSelector("suite!=checkout")
+	require.NoError(t, err)
+	exps = svc.GetExpectations(GetExpectationsRequest{Selector: selector, Status: ptrOf("active")})
+	require.Len(t, exps, 2)
+	assert.Equal(t, search.ID, exps[0].ID)
+	assert.Equal(t, unlabeled.ID, exps[1].ID)
+}
+
 func TestExpectationsService_GetAssertions(t *testing.T) {
 	t.Parallel()
 	svc := newExpectationsService(t, []*expectations.Expectation{
@@ -113,10 +156,512 @@ func TestExpectationsService_GetAssertions(t *testing.T) {
 	candidate = newTestCandidate(t, "exchange", "rk333", []byte("foo2")) // should not match
 	svc.Match(candidate)
 
-	assert.Len(t, svc.GetAssertions(GetAssertionsRequest{}), 2)
-	assert.Len(t, svc.GetAssertions(GetAssertionsRequest{Status: ptrOf("matched")}), 1)
-	assert.Len(t, svc.GetAssertions(GetAssertionsRequest{Status: ptrOf("unmatched")}), 1)
-	assert.Len(t, svc.GetAssertions(GetAssertionsRequest{ExpectationID: ptrOf(svc.GetExpectations(GetExpectationsRequest{})[0].ID)}), 1)
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{}), 2)
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusMatched}}), 1)
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusUnmatched}}), 1)
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{ExpectationID: ptrOf(svc.GetExpectations(GetExpectationsRequest{})[0].ID)}}), 1)
+}
+
+func TestExpectationsService_GetAssertionsPages(t *testing.T) {
+	t.Parallel()
+
+	svc := NewExpectationsService()
+	for _, rk := range []string{"rk1", "rk2", "rk3", "other"} {
+		svc.Match(newTestCandidate(t, "exchange", rk, []byte("foo")))
+	}
+
+	routingKeys := func(list []*expectations.Assertion) []string {
+		var keys []string
+		for _, a := range list {
+			keys = append(keys, a.Candidate.RoutingKey)
+		}
+		return keys
+	}
+
+	req := GetAssertionsRequest{NewestFirst: true, PageSize: 2}
+	page, err := svc.GetAssertions(req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other", "rk3"}, routingKeys(page.Assertions))
+	require.NotEmpty(t, page.NextPageToken)
+
+	// new assertions do not shift the following pages
+	svc.Match(newTestCandidate(t, "exchange", "rk4", []byte("foo")))
+
+	req.PageToken = page.NextPageToken
+	page, err = svc.GetAssertions(req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rk2", "rk1"}, routingKeys(page.Assertions))
+	assert.Empty(t, page.NextPageToken)
+
+	// oldest first, with a query
+	page, err = svc.GetAssertions(GetAssertionsRequest{
+		Query:    expectations.AssertionsQuery{BodyContains: "foo", Until: time.Now().Add(time.Minute)},
+		PageSize: 3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rk1", "rk2", "rk3"}, routingKeys(page.Assertions))
+
+	page, err = svc.GetAssertions(GetAssertionsRequest{PageSize: 3, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other", "rk4"}, routingKeys(page.Assertions))
+
+	_, err = svc.GetAssertions(GetAssertionsRequest{PageToken: "not a token"})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestExpectationsService_GetAssertion(t *testing.T) {
+	t.Parallel()
+
+	exp := newTestExpectation(t, "exchange", "rk", []byte("body1"))
+	svc := newExpectationsService(t, []*expectations.Expectation{exp})
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+
+	listed := getAssertions(t, svc, GetAssertionsRequest{})
+	require.Len(t, listed, 1)
+
+	assertion, err := svc.GetAssertion(listed[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, listed[0].ID, assertion.ID)
+	assert.Equal(t, exp.ID, assertion.Expectation.ID)
+
+	// the ID is the same on every read
+	assert.Equal(t, listed[0].ID, getAssertions(t, svc, GetAssertionsRequest{})[0].ID)
+
+	_, err = svc.GetAssertion(uuid.New())
+	require.ErrorIs(t, err, ErrAssertionNotFound)
+}
+
+func TestExpectationsService_AssertionsRetention(t *testing.T) {
+	t.Parallel()
+
+	svc := NewExpectationsService(WithAssertionsRetention(expectations.Retention{MaxCount: 2}))
+	for _, rk := range []string{"rk1", "rk2", "rk3"} {
+		svc.Match(newTestCandidate(t, "exchange", rk, []byte("foo")))
+	}
+
+	assertions := getAssertions(t, svc, GetAssertionsRequest{})
+	require.Len(t, assertions, 2)
+	assert.Equal(t, "rk2", assertions[0].Candidate.RoutingKey)
+	assert.Equal(t, "rk3", assertions[1].Candidate.RoutingKey)
+
+	stats := svc.AssertionsStats()
+	assert.Equal(t, 2, stats.Count)
+	assert.Equal(t, uint64(1), stats.Evicted)
+	assert.Equal(t, 2, stats.Retention.MaxCount)
+
+	// Reset keeps the history, ResetAssertions clears it without counting evictions
+	svc.Reset()
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{}), 2)
+
+	svc.ResetAssertions()
+	assert.Empty(t, getAssertions(t, svc, GetAssertionsRequest{}))
+	assert.Equal(t, uint64(1), svc.AssertionsStats().Evicted)
+}
+
+func TestExpectationsService_AssertionsMaxAge(t *testing.T) {
+	t.Parallel()
+
+	svc := NewExpectationsService(WithAssertionsRetention(expectations.Retention{MaxAge: 20 * time.Millisecond}))
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{}), 1)
+
+	assert.Eventually(t, func() bool {
+		return len(getAssertions(t, svc, GetAssertionsRequest{})) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint64(1), svc.AssertionsStats().Evicted)
+}
+
+func TestExpectationsService_ReplaceBySourcePrefix(t *testing.T) {
+	t.Parallel()
+	svc := newExpectationsService(t, []*expectations.Expectation{
+		newTestExpectation(t, "exchange", "rk", []byte("api")),
+		newTestExpectation(t, "exchange", "rk", []byte("file1"), expectations.WithSource("file:a.json")),
+		newTestExpectation(t, "exchange", "rk", []byte("other"), expectations.WithSource("other")),
+	})
+
+	svc.ReplaceBySourcePrefix("file:", []*expectations.Expectation{
+		newTestExpectation(t, "exchange", "rk", []byte("file2"), expectations.WithSource("file:b.json")),
+		newTestExpectation(t, "exchange", "rk", []byte("file3"), expectations.WithSource("file:b.json")),
+	})
+
+	exps := svc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 4)
+
+	bodies := make([]string, 0, len(exps))
+	for _, exp := range exps {
+		bodies = append(bodies, string(exp.Response.Body))
+	}
+	assert.ElementsMatch(t, []string{"api", "other", "file2", "file3"}, bodies)
+}
+
+func TestExpectationsService_Update(t *testing.T) {
+	t.Parallel()
+
+	first := newTestExpectation(t, "exchange", "rk", []byte("first"), expectations.WithName("first"))
+	second := newTestExpectation(t, "exchange", "rk", []byte("second"), expectations.WithName("second"))
+	svc := newExpectationsService(t, []*expectations.Expectation{first, second})
+
+	err := svc.Update(uuid.New(), newTestExpectation(t, "exchange", "rk", []byte("foo")))
+	require.ErrorIs(t, err, ErrExpectationNotFound)
+
+	err = svc.Update(first.ID, newTestExpectation(t, "exchange", "rk", []byte("foo"), expectations.WithName("second")))
+	require.ErrorIs(t, err, ErrDuplicateExpectationName)
+
+	require.NoError(t, svc.Update(first.ID, newTestExpectation(t, "exchange", "rk", []byte("updated"), expectations.WithPriority(5))))
+
+	exps := svc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 2)
+	assert.Equal(t, first.ID, exps[0].ID)
+	assert.Equal(t, "updated", string(exps[0].Response.Body))
+	assert.Equal(t, 5, exps[0].Priority)
+	assert.Equal(t, second.ID, exps[1].ID)
+}
+
+func TestExpectationsService_Upsert(t *testing.T) {
+	t.Parallel()
+
+	svc := newExpectationsService(t, nil)
+
+	// by ID
+	id := uuid.New()
+	created, err := svc.Upsert(newTestExpectation(t, "exchange", "rk", []byte("v1"), expectations.WithID(id)))
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = svc.Upsert(newTestExpectation(t, "exchange", "rk", []byte("v2"), expectations.WithID(id)))
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	// by name
+	created, err = svc.Upsert(newTestExpectation(t, "exchange", "rk", []byte("named v1"), expectations.WithName("named")))
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	named := newTestExpectation(t, "exchange", "rk", []byte("named v2"), expectations.WithName("named"))
+	created, err = svc.Upsert(named)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	exps := svc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 2)
+	assert.Equal(t, id, exps[0].ID)
+	assert.Equal(t, "v2", string(exps[0].Response.Body))
+	assert.Equal(t, exps[1].ID, named.ID)
+	assert.Equal(t, "named v2", string(exps[1].Response.Body))
+
+	// the name of another expectation
+	_, err = svc.Upsert(newTestExpectation(t, "exchange", "rk", []byte("v3"), expectations.WithID(id), expectations.WithName("named")))
+	require.ErrorIs(t, err, ErrDuplicateExpectationName)
+
+	err = svc.Create(newTestExpectation(t, "exchange", "rk", []byte("v3"), expectations.WithName("named")))
+	require.ErrorIs(t, err, ErrDuplicateExpectationName)
+}
+
+func TestExpectationsService_Delete(t *testing.T) {
+	t.Parallel()
+
+	first := newTestExpectation(t, "exchange", "rk", []byte("first"))
+	second := newTestExpectation(t, "exchange", "rk", []byte("second"))
+	svc := newExpectationsService(t, []*expectations.Expectation{first, second})
+
+	require.NoError(t, svc.Delete(first.ID))
+	require.ErrorIs(t, svc.Delete(first.ID), ErrExpectationNotFound)
+
+	exps := svc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, second.ID, exps[0].ID)
+}
+
+func TestExpectationsService_DeleteBySelector(t *testing.T) {
+	t.Parallel()
+
+	checkout := newTestExpectation(t, "exchange", "rk", []byte("checkout"), expectations.WithLabels(map[string]string{"suite": "checkout"}))
+	search := newTestExpectation(t, "exchange", "rk", []byte("search"), expectations.WithLabels(map[string]string{"suite": "search"}))
+	svc := newExpectationsService(t, []*expectations.Expectation{checkout, search})
+
+	// the assertions of the removed expectations can still be selected by their labels
+	require.NotNil(t, svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo"))))
+
+	selector, err := expectations.ParseLabelSelector("suite in (checkout)")
+	require.NoError(t, err)
+	assert.Equal(t, 1, svc.DeleteBySelector(selector))
+	assert.Equal(t, 0, svc.DeleteBySelector(selector))
+
+	exps := svc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, search.ID, exps[0].ID)
+
+	assertions := getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{LabelSelector: selector}})
+	require.Len(t, assertions, 1)
+	assert.Equal(t, checkout.ID, assertions[0].Expectation.ID)
+}
+
+func TestExpectationsService_Verify(t *testing.T) {
+	t.Parallel()
+
+	exp := newTestExpectation(t, "exchange", "rk", []byte("body"), expectations.WithUnlimitedTimes())
+	svc := newExpectationsService(t, []*expectations.Expectation{exp})
+
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+	svc.Match(newTestCandidate(t, "exchange", "other", []byte("foo")))
+
+	twice, err := expectations.NewVerificationTimes(ptrOf(uint32(2)), nil, nil)
+	require.NoError(t, err)
+	once, err := expectations.NewVerificationTimes(ptrOf(uint32(1)), nil, nil)
+	require.NoError(t, err)
+
+	otherReq, err := expectations.NewRequest("exchange", "other", testComparator)
+	require.NoError(t, err)
+
+	results, err := svc.Verify([]VerifyRequest{
+		{ExpectationID: &exp.ID, Times: twice},
+		{Request: otherReq, Times: once},
+		{ExpectationID: &exp.ID, Times: once},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Passed)
+	assert.True(t, results[1].Passed)
+	assert.False(t, results[2].Passed)
+	assert.Equal(t, 2, results[2].Count)
+
+	// deleted expectations can still be verified by their matches
+	require.NoError(t, svc.Delete(exp.ID))
+	results, err = svc.Verify([]VerifyRequest{{ExpectationID: &exp.ID, Times: twice}})
+	require.NoError(t, err)
+	assert.True(t, results[0].Passed)
+
+	_, err = svc.Verify([]VerifyRequest{{ExpectationID: ptrOf(uuid.New()), Times: once}})
+	require.ErrorIs(t, err, ErrExpectationNotFound)
+}
+
+func TestExpectationsService_VerifySequence(t *testing.T) {
+	t.Parallel()
+
+	svc := newExpectationsService(t, nil)
+	svc.Match(newTestCandidate(t, "exchange", "first", []byte("foo")))
+	svc.Match(newTestCandidate(t, "exchange", "other", []byte("foo")))
+	svc.Match(newTestCandidate(t, "exchange", "second", []byte("foo")))
+
+	first, err := expectations.NewRequest("exchange", "first", testComparator)
+	require.NoError(t, err)
+	second, err := expectations.NewRequest("exchange", "second", testComparator)
+	require.NoError(t, err)
+
+	result, err := svc.VerifySequence([]*expectations.Request{first, second}, false)
+	require.NoError(t, err)
+	assert.True(t, result.Passed)
+
+	result, err = svc.VerifySequence([]*expectations.Request{first, second}, true)
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	assert.Len(t, result.Actual, 3)
+
+	_, err = svc.VerifySequence(nil, false)
+	require.ErrorIs(t, err, expectations.ErrEmptySequence)
+}
+
+func TestExpectationsService_WaitForAssertions(t *testing.T) {
+	t.Parallel()
+
+	svc := newExpectationsService(t, []*expectations.Expectation{
+		newTestExpectation(t, "exchange", "rk", []byte("body"), expectations.WithUnlimitedTimes()),
+	})
+	svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+
+	filter := expectations.AssertionsQuery{Status: expectations.AssertionStatusMatched, RoutingKey: "rk"}
+
+	// already satisfied
+	found, err := svc.WaitForAssertions(context.Background(), filter, 1)
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	// woken up by new assertions
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		svc.Match(newTestCandidate(t, "exchange", "other", []byte("foo")))
+		svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	found, err = svc.WaitForAssertions(ctx, filter, 2)
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+
+	// times out with the assertions found so far
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	found, err = svc.WaitForAssertions(ctx, filter, 3)
+	require.ErrorIs(t, err, ErrWaitTimeout)
+	assert.Len(t, found, 2)
+}
+
+func TestExpectationsService_Scenarios(t *testing.T) {
+	t.Parallel()
+
+	scenario := func(required, next string) expectations.ExpectationOption {
+		sc, err := expectations.NewScenario("order", required, next)
+		require.NoError(t, err)
+		return expectations.WithScenario(sc)
+	}
+
+	svc := newExpectationsService(t, []*expectations.Expectation{
+		newTestExpectation(t, "exchange", "create", []byte("created"), expectations.WithUnlimitedTimes(),
+			scenario(expectations.ScenarioStateStarted, "pending")),
+		newTestExpectation(t, "exchange", "get", []byte("pending"), expectations.WithUnlimitedTimes(),
+			scenario("pending", "done")),
+		newTestExpectation(t, "exchange", "get", []byte("done"), expectations.WithUnlimitedTimes(),
+			scenario("done", "")),
+	})
+
+	match := func(rk string) string {
+		exp := svc.Match(newTestCandidate(t, "exchange", rk, []byte("foo")))
+		if exp == nil {
+			return ""
+		}
+		return string(exp.Response.Body)
+	}
+
+	// nothing matches before the flow started
+	assert.Empty(t, match("get"))
+
+	assert.Equal(t, "created", match("create"))
+	assert.Equal(t, "pending", svc.GetScenario("order").State)
+	assert.Equal(t, "pending", match("get"))
+	assert.Equal(t, "done", match("get"))
+	assert.Equal(t, "done", match("get"))
+	assert.Empty(t, match("create"))
+
+	assert.Equal(t, []ScenarioState{{Name: "order", State: "done"}}, svc.GetScenarios())
+	assert.Equal(t, map[string]string{"order": "done"}, svc.ScenarioStates())
+
+	// force the state
+	require.NoError(t, svc.SetScenarioState("order", "pending"))
+	assert.Equal(t, "pending", match("get"))
+
+	svc.ResetScenario("order")
+	assert.Equal(t, expectations.ScenarioStateStarted, svc.GetScenario("order").State)
+	assert.Equal(t, "created", match("create"))
+
+	svc.ResetScenarios()
+	assert.Empty(t, svc.ScenarioStates())
+
+	// resetting the expectations resets the scenarios too
+	require.NoError(t, svc.SetScenarioState("order", "done"))
+	svc.Reset()
+	assert.Empty(t, svc.GetScenarios())
+}
+
+func TestExpectationsService_RecordProxy(t *testing.T) {
+	t.Parallel()
+	svc := newExpectationsService(t, nil)
+
+	candidate := newTestCandidate(t, "exchange", "rk", []byte("foo"))
+	assert.Nil(t, svc.Match(candidate))
+
+	svc.RecordProxy(candidate, &expectations.ProxyResult{Exchange: "real", RoutingKey: "rk", Response: []byte(`{"ok":true}`)})
+
+	proxied := getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusProxied}})
+	require.Len(t, proxied, 1)
+	assert.True(t, proxied[0].IsProxied())
+	assert.Equal(t, "real", proxied[0].Proxy.Exchange)
+	assert.JSONEq(t, `{"ok":true}`, string(proxied[0].Proxy.Response))
+
+	// a proxied request is still unmatched
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusUnmatched}}), 1)
+}
+
+func TestExpectationsService_RecordReply(t *testing.T) {
+	t.Parallel()
+	svc := newExpectationsService(t, nil)
+
+	candidate := newTestCandidate(t, "exchange", "rk", []byte("foo"))
+	assert.Nil(t, svc.Match(candidate))
+	assert.Nil(t, getAssertions(t, svc, GetAssertionsRequest{})[0].Reply)
+
+	svc.RecordReply(candidate, &expectations.Reply{Queue: "reply-to", Body: []byte(`{"ok":true}`), Latency: time.Millisecond})
+
+	assertions := getAssertions(t, svc, GetAssertionsRequest{})
+	require.Len(t, assertions, 1)
+	require.NotNil(t, assertions[0].Reply)
+	assert.Equal(t, "reply-to", assertions[0].Reply.Queue)
+	assert.JSONEq(t, `{"ok":true}`, string(assertions[0].Reply.Body))
+	assert.Equal(t, time.Millisecond, assertions[0].Reply.Latency)
+	assert.False(t, assertions[0].Reply.Failed())
+
+	// replies to unknown candidates are ignored
+	svc.RecordReply(newTestCandidate(t, "exchange", "rk", []byte("bar")), &expectations.Reply{Error: "channel closed"})
+	assert.Len(t, getAssertions(t, svc, GetAssertionsRequest{}), 1)
+}
+
+func TestExpectationsService_MatchNearMisses(t *testing.T) {
+	t.Parallel()
+
+	exhausted := newTestExpectation(t, "exchange", "rk", []byte("body1"))
+	otherRoute := newTestExpectation(t, "exchange", "other", []byte("body2"))
+	sameBody := newTestExpectation(t, "other", "other", []byte("body3"))
+	svc := newExpectationsService(t, []*expectations.Expectation{exhausted, otherRoute, sameBody})
+	require.NotNil(t, svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo"))))
+
+	assert.Nil(t, svc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo"))))
+
+	unmatched := getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusUnmatched}})
+	require.Len(t, unmatched, 1)
+	require.Len(t, unmatched[0].NearMisses, 3)
+	assert.Equal(t, exhausted.ID, unmatched[0].NearMisses[0].Expectation.ID)
+	assert.Equal(t, []string{expectations.NearMissTimesExhausted}, unmatched[0].NearMisses[0].Reasons)
+	assert.Equal(t, otherRoute.ID, unmatched[0].NearMisses[1].Expectation.ID)
+	assert.Equal(t, []string{"routing key"}, unmatched[0].NearMisses[1].Reasons)
+	assert.Equal(t, sameBody.ID, unmatched[0].NearMisses[2].Expectation.ID)
+	assert.Equal(t, []string{"exchange", "routing key"}, unmatched[0].NearMisses[2].Reasons)
+
+	// matched assertions have no near misses
+	matched := getAssertions(t, svc, GetAssertionsRequest{Query: expectations.AssertionsQuery{Status: expectations.AssertionStatusMatched}})
+	require.Len(t, matched, 1)
+	assert.Empty(t, matched[0].NearMisses)
+}
+
+func TestExpectationsService_SimulateMatch(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := expectations.NewScenario("order", "", "done")
+	require.NoError(t, err)
+
+	low := newTestExpectation(t, "exchange", "rk", []byte("body1"), expectations.WithScenario(scenario))
+	high := newTestExpectation(t, "exchange", "rk", []byte("body2"), expectations.WithPriority(10))
+	exhausted := newTestExpectation(t, "exchange", "rk", []byte("body3"))
+	exhausted.Use()
+	other := newTestExpectation(t, "exchange", "other", []byte("body4"))
+	svc := newExpectationsService(t, []*expectations.Expectation{exhausted, low, high, other})
+
+	sim := svc.SimulateMatch(newTestCandidate(t, "exchange", "rk", []byte("foo")))
+	require.Len(t, sim.Matches, 2)
+	assert.Equal(t, high.ID, sim.Matches[0].ID)
+	assert.Equal(t, low.ID, sim.Matches[1].ID)
+	require.Len(t, sim.Skipped, 1)
+	assert.Equal(t, exhausted.ID, sim.Skipped[0].Expectation.ID)
+	assert.Equal(t, []string{expectations.NearMissTimesExhausted}, sim.Skipped[0].Reasons)
+
+	// nothing changed
+	assert.Equal(t, uint32(1), high.Times.RemainingTimes)
+	assert.Equal(t, uint32(1), low.Times.RemainingTimes)
+	assert.Empty(t, getAssertions(t, svc, GetAssertionsRequest{}))
+	assert.Equal(t, expectations.ScenarioStateStarted, svc.GetScenario("order").State)
+
+	assert.Empty(t, svc.SimulateMatch(newTestCandidate(t, "exchange", "unknown", []byte("foo"))).Matches)
+}
+
+func getAssertions(t *testing.T, svc *ExpectationsService, req GetAssertionsRequest) []*expectations.Assertion {
+	t.Helper()
+
+	page, err := svc.GetAssertions(req)
+	require.NoError(t, err)
+
+	return page.Assertions
 }
 
 func newTestCandidate(t *testing.T, exc, rk string, body []byte) *expectations.Candidate {
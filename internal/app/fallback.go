@@ -0,0 +1,109 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+)
+
+const builtInFallbackBody = `{"errors":"no match found"}`
+
+// FallbackDecision describes how an unmatched request is handled.
+type FallbackDecision struct {
+	Policy subscriptions.FallbackPolicy
+	// Response is the response to publish with the reply policy.
+	Response *expectations.Response
+	// ProxyTarget is where to forward the request with the proxy policy, nil if none is configured.
+	ProxyTarget *subscriptions.ProxyTarget
+}
+
+// FallbackService is the application level service deciding what happens to requests that match no expectation.
+type FallbackService struct {
+	m               sync.RWMutex
+	defaultPolicy   subscriptions.FallbackPolicy
+	proxyTarget     *subscriptions.ProxyTarget
+	defaultResponse *expectations.Response
+}
+
+// FallbackOption is a function that configures a FallbackService.
+type FallbackOption func(s *FallbackService)
+
+// WithDefaultProxyTarget sets where unmatched requests are forwarded to with the proxy policy,
+// unless the subscription overrides it.
+func WithDefaultProxyTarget(t *subscriptions.ProxyTarget) FallbackOption {
+	return func(s *FallbackService) {
+		s.proxyTarget = t
+	}
+}
+
+// NewFallbackService creates a new FallbackService instance with the global fallback policy.
+// An empty policy falls back to replying with the default response.
+func NewFallbackService(defaultPolicy subscriptions.FallbackPolicy, opts ...FallbackOption) *FallbackService {
+	if defaultPolicy == "" {
+		defaultPolicy = subscriptions.FallbackPolicyReply
+	}
+
+	s := &FallbackService{
+		defaultPolicy: defaultPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Resolve returns how an unmatched request received by the subscription is handled.
+func (s *FallbackService) Resolve(sub *subscriptions.Subscription) *FallbackDecision {
+	decision := &FallbackDecision{
+		Policy:      s.defaultPolicy,
+		Response:    s.DefaultResponse(),
+		ProxyTarget: s.proxyTarget,
+	}
+
+	if sub != nil && sub.FallbackPolicy() != "" {
+		decision.Policy = sub.FallbackPolicy()
+	}
+
+	if sub != nil && sub.ProxyTarget() != nil {
+		decision.ProxyTarget = sub.ProxyTarget()
+	}
+
+	return decision
+}
+
+// DefaultPolicy returns the global fallback policy.
+func (s *FallbackService) DefaultPolicy() subscriptions.FallbackPolicy {
+	return s.defaultPolicy
+}
+
+// DefaultResponse returns the response published for unmatched requests.
+func (s *FallbackService) DefaultResponse() *expectations.Response {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.defaultResponse != nil {
+		return s.defaultResponse
+	}
+
+	res, _ := expectations.NewResponse([]byte(builtInFallbackBody))
+	return res
+}
+
+// SetDefaultResponse replaces the response published for unmatched requests.
+func (s *FallbackService) SetDefaultResponse(res *expectations.Response) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.defaultResponse = res
+}
+
+// ResetDefaultResponse restores the built-in response for unmatched requests.
+func (s *FallbackService) ResetDefaultResponse() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.defaultResponse = nil
+}
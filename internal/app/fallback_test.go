@@ -0,0 +1,76 @@
+package app_test
+
+import (
+	"testing"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackService_Resolve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("global policy", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewFallbackService(subscriptions.FallbackPolicyDrop)
+
+		decision := svc.Resolve(subscriptions.NewSubscription("queue"))
+		assert.Equal(t, subscriptions.FallbackPolicyDrop, decision.Policy)
+	})
+
+	t.Run("empty global policy replies", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewFallbackService("")
+
+		decision := svc.Resolve(subscriptions.NewSubscription("queue"))
+		assert.Equal(t, subscriptions.FallbackPolicyReply, decision.Policy)
+		assert.JSONEq(t, `{"errors":"no match found"}`, string(decision.Response.Body))
+		assert.Nil(t, decision.ProxyTarget)
+	})
+
+	t.Run("subscription override", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewFallbackService(subscriptions.FallbackPolicyReply)
+		sub := subscriptions.NewSubscription("queue", subscriptions.WithFallbackPolicy(subscriptions.FallbackPolicyRequeue))
+
+		decision := svc.Resolve(sub)
+		assert.Equal(t, subscriptions.FallbackPolicyRequeue, decision.Policy)
+	})
+
+	t.Run("proxy target", func(t *testing.T) {
+		t.Parallel()
+
+		global := &subscriptions.ProxyTarget{Exchange: "real"}
+		svc := NewFallbackService(subscriptions.FallbackPolicyProxy, WithDefaultProxyTarget(global))
+
+		decision := svc.Resolve(subscriptions.NewSubscription("queue"))
+		assert.Equal(t, subscriptions.FallbackPolicyProxy, decision.Policy)
+		assert.Equal(t, global, decision.ProxyTarget)
+
+		override := &subscriptions.ProxyTarget{Exchange: "other", RoutingKey: "rk"}
+		decision = svc.Resolve(subscriptions.NewSubscription("queue", subscriptions.WithProxyTarget(override)))
+		assert.Equal(t, override, decision.ProxyTarget)
+	})
+}
+
+func TestFallbackService_DefaultResponse(t *testing.T) {
+	t.Parallel()
+
+	svc := NewFallbackService(subscriptions.FallbackPolicyReply)
+
+	res, err := expectations.NewResponse([]byte(`{"error":{"code":"NOT_FOUND"}}`))
+	require.NoError(t, err)
+	res.Headers = map[string]string{"x-error": "true"}
+
+	svc.SetDefaultResponse(res)
+	assert.Equal(t, res, svc.Resolve(subscriptions.NewSubscription("queue")).Response)
+
+	svc.ResetDefaultResponse()
+	assert.JSONEq(t, `{"errors":"no match found"}`, string(svc.DefaultResponse().Body))
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+)
+
+// NamespaceHeader is the header selecting the namespace of an API call, or of an incoming message.
+const NamespaceHeader = "x-mockserver-namespace"
+
+// DefaultNamespace is the namespace of the API calls and messages that do not select one.
+const DefaultNamespace = ""
+
+var (
+	ErrInvalidNamespace = errors.New("invalid namespace")
+	ErrDefaultNamespace = errors.New("the default namespace cannot be deleted")
+)
+
+var namespacePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// ValidateNamespace checks that the namespace name is made of at most 64 letters, digits, dots,
+// underscores and dashes, starting with a letter or a digit. The default namespace is valid.
+func ValidateNamespace(namespace string) error {
+	if namespace != DefaultNamespace && !namespacePattern.MatchString(namespace) {
+		return fmt.Errorf("%w: %q", ErrInvalidNamespace, namespace)
+	}
+
+	return nil
+}
+
+// Namespaces isolates the expectations, assertions and scenarios of parallel test suites sharing the server.
+// The default namespace is held by the service it is created with, the others are created on first use by the API.
+type Namespaces struct {
+	m      sync.RWMutex
+	def    *ExpectationsService
+	opts   []ExpectationsOption
+	byName map[string]*ExpectationsService
+}
+
+// NewNamespaces creates a new Namespaces instance.
+// The options configure the services of the namespaces other than the default one.
+func NewNamespaces(def *ExpectationsService, opts ...ExpectationsOption) *Namespaces {
+	return &Namespaces{
+		def:    def,
+		opts:   opts,
+		byName: make(map[string]*ExpectationsService),
+	}
+}
+
+// Get returns the expectations service of the namespace, creating the namespace if it does not exist yet.
+func (n *Namespaces) Get(namespace string) *ExpectationsService {
+	if svc, ok := n.lookup(namespace); ok {
+		return svc
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	// created in the meantime
+	if svc, ok := n.byName[namespace]; ok {
+		return svc
+	}
+
+	svc := NewExpectationsService(append(slices.Clone(n.opts), WithExpectationsNamespace(namespace))...)
+	n.byName[namespace] = svc
+
+	return svc
+}
+
+// lookup returns the expectations service of the namespace, without creating the namespace if it does not exist.
+func (n *Namespaces) lookup(namespace string) (*ExpectationsService, bool) {
+	if namespace == DefaultNamespace {
+		return n.def, true
+	}
+
+	n.m.RLock()
+	defer n.m.RUnlock()
+
+	svc, ok := n.byName[namespace]
+
+	return svc, ok
+}
+
+// Names returns the names of the namespaces other than the default one, sorted.
+func (n *Namespaces) Names() []string {
+	n.m.RLock()
+	defer n.m.RUnlock()
+
+	names := make([]string, 0, len(n.byName))
+	for name := range n.byName {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Delete removes the namespace with its expectations, assertions and scenarios.
+func (n *Namespaces) Delete(namespace string) error {
+	if namespace == DefaultNamespace {
+		return ErrDefaultNamespace
+	}
+
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	delete(n.byName, namespace)
+
+	return nil
+}
+
+// Match matches the candidate against the expectations of its namespace.
+// Requests cannot create namespaces, only the API calls can: a candidate of an unknown namespace is moved
+// to the default namespace and recorded there as unmatched, without being matched against its expectations.
+func (n *Namespaces) Match(candidate *expectations.Candidate) *expectations.Expectation {
+	svc, ok := n.lookup(candidate.Namespace)
+	if !ok {
+		namespace := candidate.Namespace
+		candidate.Namespace = DefaultNamespace
+		n.def.recordUnknownNamespace(candidate, namespace)
+		return nil
+	}
+
+	return svc.Match(candidate)
+}
+
+// RecordProxy attaches the outcome of forwarding the candidate to its assertion, in the namespace of the candidate.
+func (n *Namespaces) RecordProxy(candidate *expectations.Candidate, result *expectations.ProxyResult) {
+	if svc, ok := n.lookup(candidate.Namespace); ok {
+		svc.RecordProxy(candidate, result)
+	}
+}
+
+// RecordReply attaches the reply to the candidate to its assertion, in the namespace of the candidate.
+func (n *Namespaces) RecordReply(candidate *expectations.Candidate, reply *expectations.Reply) {
+	if svc, ok := n.lookup(candidate.Namespace); ok {
+		svc.RecordReply(candidate, reply)
+	}
+}
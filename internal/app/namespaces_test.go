@@ -0,0 +1,199 @@
+package app_test
+
+import (
+	"testing"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNamespace(t *testing.T) {
+	t.Parallel()
+
+	for _, namespace := range []string{DefaultNamespace, "suite-a", "ci_42", "team.orders", "A"} {
+		assert.NoError(t, ValidateNamespace(namespace), namespace)
+	}
+
+	for _, namespace := range []string{"-suite", ".hidden", "with space", "slash/ed", string(make([]byte, 65))} {
+		assert.ErrorIs(t, ValidateNamespace(namespace), ErrInvalidNamespace, namespace)
+	}
+}
+
+func TestNamespaces_Get(t *testing.T) {
+	t.Parallel()
+
+	def := NewExpectationsService()
+	ns := NewNamespaces(def)
+
+	assert.Same(t, def, ns.Get(DefaultNamespace))
+	assert.Empty(t, ns.Names())
+
+	a := ns.Get("suite-a")
+	assert.NotSame(t, def, a)
+	assert.Same(t, a, ns.Get("suite-a"))
+
+	ns.Get("suite-b")
+	assert.Equal(t, []string{"suite-a", "suite-b"}, ns.Names())
+}
+
+func TestNamespaces_Isolation(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNamespaces(NewExpectationsService())
+	exp := newTestExpectation(t, "exchange", "rk", []byte(`{}`))
+	require.NoError(t, ns.Get("suite-a").Create(exp))
+
+	ns.Get("suite-b")
+
+	// the request is unmatched in the default namespace and in the other ones
+	assert.Nil(t, ns.Match(newTestCandidate(t, "exchange", "rk", []byte(`"foo"`))))
+
+	other := newTestCandidate(t, "exchange", "rk", []byte(`"foo"`))
+	other.Namespace = "suite-b"
+	assert.Nil(t, ns.Match(other))
+
+	candidate := newTestCandidate(t, "exchange", "rk", []byte(`"foo"`))
+	candidate.Namespace = "suite-a"
+	matched := ns.Match(candidate)
+	require.NotNil(t, matched)
+	assert.Equal(t, exp.ID, matched.ID)
+
+	ns.RecordReply(candidate, &expectations.Reply{Queue: "reply-to"})
+
+	// each namespace has its own history of assertions
+	assert.Len(t, getAssertions(t, ns.Get(DefaultNamespace), GetAssertionsRequest{}), 1)
+	assert.Len(t, getAssertions(t, ns.Get("suite-b"), GetAssertionsRequest{}), 1)
+	assertions := getAssertions(t, ns.Get("suite-a"), GetAssertionsRequest{})
+	require.Len(t, assertions, 1)
+	require.NotNil(t, assertions[0].Reply)
+	assert.Equal(t, "reply-to", assertions[0].Reply.Queue)
+
+	// resetting a namespace leaves the others untouched
+	ns.Get("suite-b").Reset()
+	assert.Len(t, ns.Get("suite-a").GetExpectations(GetExpectationsRequest{}), 1)
+}
+
+func TestNamespaces_UnknownNamespace(t *testing.T) {
+	t.Parallel()
+
+	def := NewExpectationsService()
+	ns := NewNamespaces(def)
+	require.NoError(t, def.Create(newTestExpectation(t, "exchange", "rk", []byte(`{}`))))
+
+	// a request cannot create a namespace, it is recorded as unmatched in the default one
+	candidate := newTestCandidate(t, "exchange", "rk", []byte(`"foo"`))
+	candidate.Namespace = "suite-a"
+	assert.Nil(t, ns.Match(candidate))
+	assert.Equal(t, DefaultNamespace, candidate.Namespace)
+	assert.Empty(t, ns.Names())
+
+	ns.RecordReply(candidate, &expectations.Reply{Queue: "reply-to"})
+
+	assertions := getAssertions(t, def, GetAssertionsRequest{})
+	require.Len(t, assertions, 1)
+	assert.Nil(t, assertions[0].Expectation)
+	require.NotNil(t, assertions[0].Reply)
+	assert.Equal(t, "reply-to", assertions[0].Reply.Queue)
+	assert.Empty(t, ns.Names())
+}
+
+func TestNamespaces_Delete(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNamespaces(NewExpectationsService())
+	require.NoError(t, ns.Get("suite-a").Create(newTestExpectation(t, "exchange", "rk", []byte(`{}`))))
+
+	require.NoError(t, ns.Delete("suite-a"))
+	assert.Empty(t, ns.Names())
+	assert.Empty(t, ns.Get("suite-a").GetExpectations(GetExpectationsRequest{}))
+
+	// deleting an unknown namespace is a no-op
+	require.NoError(t, ns.Delete("unknown"))
+
+	assert.ErrorIs(t, ns.Delete(DefaultNamespace), ErrDefaultNamespace)
+}
+
+func TestNamespaces_Events(t *testing.T) {
+	t.Parallel()
+
+	events := NewEvents()
+	ns := NewNamespaces(NewExpectationsService(WithExpectationsEvents(events)), WithExpectationsEvents(events))
+
+	watcher := events.Watch(EventFilter{Namespace: "suite-a"})
+	defer watcher.Close()
+
+	require.NoError(t, ns.Get(DefaultNamespace).Create(newTestExpectation(t, "exchange", "rk1", []byte(`{}`))))
+	require.NoError(t, ns.Get("suite-a").Create(newTestExpectation(t, "exchange", "rk2", []byte(`{}`))))
+
+	event := receiveEvent(t, watcher)
+	assert.Equal(t, EventExpectationCreated, event.Type)
+	assert.Equal(t, "rk2", event.RoutingKey)
+	assert.Equal(t, "suite-a", event.Namespace)
+	assert.Empty(t, watcher.C())
+}
+
+func TestSubscriptionsService_UnsubscribeByNamespace(t *testing.T) {
+	t.Parallel()
+
+	svc := NewSubscriptionsService(&testConsumer{})
+
+	_, err := svc.Subscribe("queue", false)
+	require.NoError(t, err)
+	_, err = svc.Subscribe("queue", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+	kept, err := svc.Subscribe("queue", false, subscriptions.WithNamespace("suite-b"))
+	require.NoError(t, err)
+
+	require.NoError(t, svc.UnsubscribeByNamespace("suite-a"))
+	require.NoError(t, svc.UnsubscribeByNamespace(DefaultNamespace))
+
+	subs := svc.GetAllSubscriptions()
+	require.Len(t, subs, 1)
+	assert.Equal(t, kept.ID(), subs[0].ID())
+}
+
+func TestSubscriptionsService_UnsubscribeByQueue(t *testing.T) {
+	t.Parallel()
+
+	svc := NewSubscriptionsService(&testConsumer{})
+
+	_, err := svc.Subscribe("queue", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+	kept, err := svc.Subscribe("queue", false, subscriptions.WithNamespace("suite-b"))
+	require.NoError(t, err)
+	other, err := svc.Subscribe("other", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+
+	require.NoError(t, svc.UnsubscribeByQueue("queue", "suite-a"))
+
+	subs := svc.GetAllSubscriptions()
+	require.Len(t, subs, 2)
+	assert.Equal(t, kept.ID(), subs[0].ID())
+	assert.Equal(t, other.ID(), subs[1].ID())
+}
+
+func TestSubscriptionsService_SubscribeIdempotent(t *testing.T) {
+	t.Parallel()
+
+	svc := NewSubscriptionsService(&testConsumer{})
+	sessionID := uuid.New()
+
+	sub, err := svc.Subscribe("queue", true, subscriptions.WithNamespace("suite-a"), subscriptions.WithSessionID(sessionID))
+	require.NoError(t, err)
+
+	same, err := svc.Subscribe("queue", true, subscriptions.WithNamespace("suite-a"), subscriptions.WithSessionID(sessionID))
+	require.NoError(t, err)
+	assert.Equal(t, sub.ID(), same.ID())
+
+	// the existing subscription is not returned to another namespace or session
+	_, err = svc.Subscribe("queue", true, subscriptions.WithNamespace("suite-b"), subscriptions.WithSessionID(sessionID))
+	require.ErrorIs(t, err, ErrSubscriptionConflict)
+	_, err = svc.Subscribe("queue", true, subscriptions.WithNamespace("suite-a"))
+	require.ErrorIs(t, err, ErrSubscriptionConflict)
+
+	assert.Len(t, svc.GetAllSubscriptions(), 1)
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+)
+
+var ErrNoRecordingTarget = errors.New("no recording target configured")
+
+// RecordingService is the application level service capturing real traffic as expectations.
+// While recording, every request is forwarded to the real service and each request/reply pair
+// is converted to an expectation that can be exported and replayed later.
+type RecordingService struct {
+	m             sync.RWMutex
+	defaultTarget *subscriptions.ProxyTarget
+	target        *subscriptions.ProxyTarget
+	recordings    []*expectations.Expectation
+}
+
+// NewRecordingService creates a new RecordingService instance.
+// The default target is used when recording is started without a target, it may be nil.
+func NewRecordingService(defaultTarget *subscriptions.ProxyTarget) *RecordingService {
+	return &RecordingService{
+		defaultTarget: defaultTarget,
+	}
+}
+
+// Start turns the recording on. If the target is nil, the default target is used.
+func (s *RecordingService) Start(target *subscriptions.ProxyTarget) error {
+	if target == nil {
+		target = s.defaultTarget
+	}
+
+	if target == nil {
+		return ErrNoRecordingTarget
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.target = target
+	return nil
+}
+
+// Stop turns the recording off, the recordings are kept until they are reset.
+func (s *RecordingService) Stop() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.target = nil
+}
+
+// Target returns where requests are forwarded to while recording, or nil if the recording is off.
+func (s *RecordingService) Target() *subscriptions.ProxyTarget {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.target
+}
+
+// Record converts an observed request and the reply of the real service to an expectation.
+func (s *RecordingService) Record(candidate *expectations.Candidate, response []byte) error {
+	exp, err := expectations.NewRecordedExpectation(candidate, response)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.recordings = append(s.recordings, exp)
+	return nil
+}
+
+// Recordings returns the recorded expectations in the order the requests were received.
+func (s *RecordingService) Recordings() []*expectations.Expectation {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	result := make([]*expectations.Expectation, len(s.recordings))
+	for i, exp := range s.recordings {
+		result[i] = exp.Copy()
+	}
+
+	return result
+}
+
+// Reset removes all recordings.
+func (s *RecordingService) Reset() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.recordings = nil
+}
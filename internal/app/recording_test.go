@@ -0,0 +1,61 @@
+package app_test
+
+import (
+	"testing"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingService_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without any target", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewRecordingService(nil)
+		require.ErrorIs(t, svc.Start(nil), ErrNoRecordingTarget)
+		assert.Nil(t, svc.Target())
+	})
+
+	t.Run("default target", func(t *testing.T) {
+		t.Parallel()
+
+		target := &subscriptions.ProxyTarget{Exchange: "real"}
+		svc := NewRecordingService(target)
+		require.NoError(t, svc.Start(nil))
+		assert.Equal(t, target, svc.Target())
+
+		svc.Stop()
+		assert.Nil(t, svc.Target())
+	})
+
+	t.Run("explicit target", func(t *testing.T) {
+		t.Parallel()
+
+		target := &subscriptions.ProxyTarget{Exchange: "other", RoutingKey: "rk"}
+		svc := NewRecordingService(&subscriptions.ProxyTarget{Exchange: "real"})
+		require.NoError(t, svc.Start(target))
+		assert.Equal(t, target, svc.Target())
+	})
+}
+
+func TestRecordingService_Record(t *testing.T) {
+	t.Parallel()
+
+	svc := NewRecordingService(nil)
+
+	require.NoError(t, svc.Record(newTestCandidate(t, "exchange", "rk", []byte(`{"id":1}`)), []byte(`{"name":"first"}`)))
+	require.NoError(t, svc.Record(newTestCandidate(t, "exchange", "rk", []byte(`{"id":2}`)), []byte(`{"name":"second"}`)))
+
+	recordings := svc.Recordings()
+	require.Len(t, recordings, 2)
+	assert.JSONEq(t, `{"name":"first"}`, string(recordings[0].Response.Body))
+	assert.JSONEq(t, `{"name":"second"}`, string(recordings[1].Response.Body))
+	assert.True(t, recordings[1].Matches(newTestCandidate(t, "exchange", "rk", []byte(`{"id":2}`))))
+
+	svc.Reset()
+	assert.Empty(t, svc.Recordings())
+}
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionHeader is the header selecting the session owning the expectations and subscriptions created by an API call.
+const SessionHeader = "x-mockserver-session"
+
+var (
+	ErrSessionNotFound  = errors.New("session not found")
+	ErrInvalidLease     = errors.New("session lease must be positive")
+	ErrSessionNamespace = errors.New("session belongs to another namespace")
+)
+
+// Session owns the expectations and subscriptions created under it, and removes them when it ends.
+// It ends when it is closed, or when its lease expires because no heartbeat renewed it in time.
+type Session struct {
+	ID uuid.UUID
+	// Namespace is the namespace the session was created in, the expectations it owns belong to it.
+	Namespace string
+	Lease     time.Duration
+	CreatedAt time.Time
+	// ExpiresAt is when the lease expires, unless a heartbeat renews it before.
+	ExpiresAt time.Time
+
+	timer *time.Timer
+}
+
+func (s *Session) copy() *Session {
+	return &Session{
+		ID:        s.ID,
+		Namespace: s.Namespace,
+		Lease:     s.Lease,
+		CreatedAt: s.CreatedAt,
+		ExpiresAt: s.ExpiresAt,
+	}
+}
+
+// SessionsService is the application level service leasing sessions to the clients,
+// so that what a crashed client created does not stay behind.
+type SessionsService struct {
+	m             sync.Mutex
+	sessions      map[uuid.UUID]*Session
+	namespaces    *Namespaces
+	subscriptions *SubscriptionsService
+}
+
+// NewSessionsService creates a new SessionsService instance.
+func NewSessionsService(namespaces *Namespaces, subSvc *SubscriptionsService) *SessionsService {
+	return &SessionsService{
+		sessions:      make(map[uuid.UUID]*Session),
+		namespaces:    namespaces,
+		subscriptions: subSvc,
+	}
+}
+
+// Create starts a session in the namespace, it expires after the lease unless a heartbeat renews it.
+func (s *SessionsService) Create(namespace string, lease time.Duration) (*Session, error) {
+	if lease <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLease, lease)
+	}
+
+	if err := ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &Session{
+		ID:        uuid.New(),
+		Namespace: namespace,
+		Lease:     lease,
+		CreatedAt: now,
+		ExpiresAt: now.Add(lease),
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id := session.ID
+	session.timer = time.AfterFunc(lease, func() { s.expire(id) })
+	s.sessions[id] = session
+
+	slog.Info("session created", "session_id", id, "namespace", namespace, "lease", lease)
+
+	return session.copy(), nil
+}
+
+// Get returns the session with the ID.
+func (s *SessionsService) Get(id uuid.UUID) (*Session, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+
+	return session.copy(), nil
+}
+
+// GetAll returns the open sessions, oldest first.
+func (s *SessionsService) GetAll() []*Session {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions := make([]*Session, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sessions = append(sessions, session.copy())
+	}
+
+	slices.SortFunc(sessions, func(a, b *Session) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return sessions
+}
+
+// Heartbeat renews the lease of the session, so that it expires one lease from now.
+func (s *SessionsService) Heartbeat(id uuid.UUID) (*Session, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+
+	session.ExpiresAt = time.Now().Add(session.Lease)
+	session.timer.Reset(session.Lease)
+
+	return session.copy(), nil
+}
+
+// Close ends the session and removes the expectations and subscriptions it owns.
+func (s *SessionsService) Close(id uuid.UUID) error {
+	s.m.Lock()
+	session, ok := s.sessions[id]
+	if ok {
+		session.timer.Stop()
+		delete(s.sessions, id)
+	}
+	s.m.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+
+	slog.Info("session closed", "session_id", id)
+
+	return s.cleanUp(session)
+}
+
+// expire ends the session if its lease has expired. A heartbeat may have renewed it while the timer fired.
+func (s *SessionsService) expire(id uuid.UUID) {
+	s.m.Lock()
+	session, ok := s.sessions[id]
+	if ok && time.Now().Before(session.ExpiresAt) {
+		ok = false
+	}
+	if ok {
+		delete(s.sessions, id)
+	}
+	s.m.Unlock()
+
+	if !ok {
+		return
+	}
+
+	slog.Warn("session lease expired", "session_id", id, "lease", session.Lease)
+
+	if err := s.cleanUp(session); err != nil {
+		slog.Error("failed to clean up expired session", "session_id", id, "error", err)
+	}
+}
+
+// cleanUp unsubscribes the subscriptions of the ended session and removes its expectations.
+func (s *SessionsService) cleanUp(session *Session) error {
+	if err := s.subscriptions.UnsubscribeBySession(session.ID); err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	// the namespace may have been deleted with its expectations in the meantime
+	if expSvc, ok := s.namespaces.lookup(session.Namespace); ok {
+		n := expSvc.DeleteBySession(session.ID)
+		slog.Info("session cleaned up", "session_id", session.ID, "expectations", n)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionsService_Create(t *testing.T) {
+	t.Parallel()
+
+	svc := NewSessionsService(NewNamespaces(NewExpectationsService()), NewSubscriptionsService(&testConsumer{}))
+
+	_, err := svc.Create(DefaultNamespace, 0)
+	require.ErrorIs(t, err, ErrInvalidLease)
+
+	_, err = svc.Create("not valid", time.Minute)
+	require.ErrorIs(t, err, ErrInvalidNamespace)
+
+	first, err := svc.Create(DefaultNamespace, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, first.Lease)
+	assert.Equal(t, first.CreatedAt.Add(time.Minute), first.ExpiresAt)
+
+	second, err := svc.Create("suite-a", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "suite-a", second.Namespace)
+
+	got, err := svc.Get(second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, second, got)
+
+	sessions := svc.GetAll()
+	require.Len(t, sessions, 2)
+	assert.Equal(t, first.ID, sessions[0].ID)
+	assert.Equal(t, second.ID, sessions[1].ID)
+
+	_, err = svc.Get(uuid.New())
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestSessionsService_Close(t *testing.T) {
+	t.Parallel()
+
+	consumer := &testConsumer{}
+	namespaces := NewNamespaces(NewExpectationsService())
+	subSvc := NewSubscriptionsService(consumer)
+	svc := NewSessionsService(namespaces, subSvc)
+
+	session, err := svc.Create("suite-a", time.Minute)
+	require.NoError(t, err)
+
+	expSvc := namespaces.Get("suite-a")
+	owned := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithSessionID(session.ID))
+	kept := newTestExpectation(t, "exchange", "rk", []byte(`{}`))
+	require.NoError(t, expSvc.Create(owned))
+	require.NoError(t, expSvc.Create(kept))
+
+	_, err = subSvc.Subscribe("queue", false, subscriptions.WithNamespace("suite-a"), subscriptions.WithSessionID(session.ID))
+	require.NoError(t, err)
+	keptSub, err := subSvc.Subscribe("queue", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Close(session.ID))
+
+	exps := expSvc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, kept.ID, exps[0].ID)
+
+	subs := subSvc.GetAllSubscriptions()
+	require.Len(t, subs, 1)
+	assert.Equal(t, keptSub.ID(), subs[0].ID())
+
+	_, err = svc.Get(session.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.ErrorIs(t, svc.Close(session.ID), ErrSessionNotFound)
+}
+
+func TestSessionsService_CloseUpdated(t *testing.T) {
+	t.Parallel()
+
+	namespaces := NewNamespaces(NewExpectationsService())
+	svc := NewSessionsService(namespaces, NewSubscriptionsService(&testConsumer{}))
+
+	session, err := svc.Create(DefaultNamespace, time.Minute)
+	require.NoError(t, err)
+
+	expSvc := namespaces.Get(DefaultNamespace)
+	updated := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithSessionID(session.ID))
+	upserted := newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithSessionID(session.ID),
+		expectations.WithName("upserted"))
+	require.NoError(t, expSvc.Create(updated))
+	require.NoError(t, expSvc.Create(upserted))
+
+	// the new definitions are not created under the session, the expectations stay owned by it
+	require.NoError(t, expSvc.Update(updated.ID, newTestExpectation(t, "exchange", "rk", []byte(`"v2"`))))
+	_, err = expSvc.Upsert(newTestExpectation(t, "exchange", "rk", []byte(`"v2"`), expectations.WithName("upserted")))
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Close(session.ID))
+	assert.Empty(t, expSvc.GetExpectations(GetExpectationsRequest{}))
+}
+
+func TestSessionsService_Expire(t *testing.T) {
+	t.Parallel()
+
+	namespaces := NewNamespaces(NewExpectationsService())
+	subSvc := NewSubscriptionsService(&testConsumer{})
+	svc := NewSessionsService(namespaces, subSvc)
+
+	session, err := svc.Create(DefaultNamespace, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	expSvc := namespaces.Get(DefaultNamespace)
+	require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte(`{}`), expectations.WithSessionID(session.ID))))
+	_, err = subSvc.Subscribe("queue", false, subscriptions.WithSessionID(session.ID))
+	require.NoError(t, err)
+
+	// the heartbeats keep the session alive past its first lease
+	for range 3 {
+		time.Sleep(50 * time.Millisecond)
+		renewed, err := svc.Heartbeat(session.ID)
+		require.NoError(t, err)
+		assert.True(t, renewed.ExpiresAt.After(session.ExpiresAt))
+	}
+	assert.Len(t, expSvc.GetExpectations(GetExpectationsRequest{}), 1)
+
+	// without heartbeats, the lease expires and what the session owned is removed
+	// the subscriptions are removed before the expectations
+	require.Eventually(t, func() bool {
+		return len(expSvc.GetExpectations(GetExpectationsRequest{})) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Empty(t, subSvc.GetAllSubscriptions())
+
+	_, err = svc.Get(session.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	_, err = svc.Heartbeat(session.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestSessionsService_DeletedNamespace(t *testing.T) {
+	t.Parallel()
+
+	namespaces := NewNamespaces(NewExpectationsService())
+	svc := NewSessionsService(namespaces, NewSubscriptionsService(&testConsumer{}))
+
+	session, err := svc.Create("suite-a", time.Minute)
+	require.NoError(t, err)
+	namespaces.Get("suite-a")
+	require.NoError(t, namespaces.Delete("suite-a"))
+
+	// closing the session does not create the namespace again
+	require.NoError(t, svc.Close(session.ID))
+	assert.Empty(t, namespaces.Names())
+}
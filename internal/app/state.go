@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+)
+
+// State is a snapshot of the server state that survives restarts.
+type State struct {
+	Expectations  []*expectations.Expectation
+	Subscriptions []*subscriptions.Subscription
+	// Assertions are only part of the snapshot if persisting them is enabled.
+	Assertions []*expectations.Assertion
+	// ScenarioStates are the states of the scenarios that are not in their initial state, keyed by name.
+	ScenarioStates map[string]string
+}
+
+// StateStore is an interface for saving and loading snapshots of the server state.
+// Load returns nil if no snapshot has been saved yet.
+type StateStore interface {
+	Save(state *State) error
+	Load() (*State, error)
+}
+
+// Changes signals that the server state has changed and a new snapshot is due.
+// Notifications are coalesced, a nil *Changes ignores them.
+type Changes struct {
+	ch chan struct{}
+}
+
+// NewChanges creates a new Changes instance.
+func NewChanges() *Changes {
+	return &Changes{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+// Notify signals a change without blocking.
+func (c *Changes) Notify() {
+	if c == nil {
+		return
+	}
+
+	select {
+	case c.ch <- struct{}{}:
+	default:
+	}
+}
+
+// StateService is the application level service persisting the server state across restarts.
+type StateService struct {
+	store             StateStore
+	expectations      *ExpectationsService
+	subscriptions     *SubscriptionsService
+	changes           *Changes
+	interval          time.Duration
+	persistAssertions bool
+}
+
+// StateOption is a function that configures a StateService.
+type StateOption func(s *StateService)
+
+// WithSaveInterval makes the service save the state on the interval and on shutdown instead of after every change.
+func WithSaveInterval(d time.Duration) StateOption {
+	return func(s *StateService) {
+		s.interval = d
+	}
+}
+
+// WithPersistedAssertions makes the assertions part of the snapshot.
+func WithPersistedAssertions() StateOption {
+	return func(s *StateService) {
+		s.persistAssertions = true
+	}
+}
+
+// NewStateService creates a new StateService instance.
+// The changes must be the ones the expectations and subscriptions services notify.
+func NewStateService(
+	store StateStore,
+	expSvc *ExpectationsService,
+	subSvc *SubscriptionsService,
+	changes *Changes,
+	opts ...StateOption,
+) *StateService {
+	s := &StateService{
+		store:         store,
+		expectations:  expSvc,
+		subscriptions: subSvc,
+		changes:       changes,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Restore loads the last snapshot and restores the expectations, the assertions if enabled,
+// and finally the subscriptions, so that no request is received before the expectations are in place.
+func (s *StateService) Restore() error {
+	state, err := s.store.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+
+	if state == nil {
+		return nil
+	}
+
+	var assertions []*expectations.Assertion
+	if s.persistAssertions {
+		assertions = state.Assertions
+	}
+
+	s.expectations.Restore(state.Expectations, assertions, state.ScenarioStates)
+
+	if err := s.subscriptions.Restore(state.Subscriptions); err != nil {
+		return err
+	}
+
+	slog.Info("state restored", "expectations", len(state.Expectations), "subscriptions", len(state.Subscriptions), "assertions", len(assertions))
+
+	return nil
+}
+
+// Save takes a snapshot of the current state and saves it.
+// Expectations with a source, like the ones from expectation files, are not saved since they are loaded from it again.
+// Neither are the expectations and subscriptions owned by a session, since sessions end with the process.
+// Only the default namespace is saved, so the subscriptions of the other namespaces are left out as well,
+// since they would come back without the expectations they are matched against.
+func (s *StateService) Save() error {
+	state := &State{
+		ScenarioStates: s.expectations.ScenarioStates(),
+	}
+
+	for _, sub := range s.subscriptions.GetAllSubscriptions() {
+		if sub.SessionID() == uuid.Nil && sub.Namespace() == DefaultNamespace {
+			state.Subscriptions = append(state.Subscriptions, sub)
+		}
+	}
+
+	for _, exp := range s.expectations.GetExpectations(GetExpectationsRequest{}) {
+		if exp.Source == "" && exp.SessionID == uuid.Nil {
+			state.Expectations = append(state.Expectations, exp)
+		}
+	}
+
+	if s.persistAssertions {
+		page, err := s.expectations.GetAssertions(GetAssertionsRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get assertions: %w", err)
+		}
+		state.Assertions = page.Assertions
+	}
+
+	if err := s.store.Save(state); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+
+	return nil
+}
+
+// Run saves the state after every change, or on the save interval if one is set, and once more on shutdown.
+func (s *StateService) Run(ctx context.Context) error {
+	var tick <-chan time.Time
+	if s.interval > 0 {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	changed := false
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.Save(); err != nil {
+				return err
+			}
+			slog.Info("state saved on shutdown")
+			return nil
+		case <-s.changes.ch:
+			if tick != nil {
+				changed = true
+				continue
+			}
+			s.save()
+		case <-tick:
+			if changed {
+				changed = false
+				s.save()
+			}
+		}
+	}
+}
+
+func (s *StateService) save() {
+	if err := s.Save(); err != nil {
+		slog.Error("failed to save state", "error", err)
+	}
+}
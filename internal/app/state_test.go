@@ -0,0 +1,202 @@
+package app_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStateStore struct {
+	m     sync.Mutex
+	state *State
+	saves int
+}
+
+func (s *testStateStore) Save(state *State) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.state = state
+	s.saves++
+	return nil
+}
+
+func (s *testStateStore) Load() (*State, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.state, nil
+}
+
+func (s *testStateStore) snapshot() (*State, int) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.state, s.saves
+}
+
+type testConsumer struct {
+	m    sync.Mutex
+	subs []*subscriptions.Subscription
+}
+
+func (c *testConsumer) Subscribe(sub *subscriptions.Subscription) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.subs = append(c.subs, sub)
+	return nil
+}
+
+func (c *testConsumer) Unsubscribe(id uuid.UUID) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	for i, sub := range c.subs {
+		if sub.ID() == id {
+			c.subs = append(c.subs[:i], c.subs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (c *testConsumer) UnsubscribeFromQueue(string) error { return nil }
+
+func (c *testConsumer) GetAllSubscriptions() []*subscriptions.Subscription {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return append([]*subscriptions.Subscription(nil), c.subs...)
+}
+
+func (c *testConsumer) GetQueueSubscriptions(queue string) []*subscriptions.Subscription {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	var subs []*subscriptions.Subscription
+	for _, sub := range c.subs {
+		if sub.Queue() == queue {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+func (c *testConsumer) UnsubscribeAll() error { return nil }
+
+func TestStateService_SaveAndRestore(t *testing.T) {
+	t.Parallel()
+
+	store := &testStateStore{}
+
+	// first run
+	changes := NewChanges()
+	expSvc := NewExpectationsService(WithExpectationsChanges(changes))
+	subSvc := NewSubscriptionsService(&testConsumer{}, WithSubscriptionsChanges(changes))
+	stateSvc := NewStateService(store, expSvc, subSvc, changes, WithPersistedAssertions())
+
+	require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte("api"))))
+	require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte("file"), expectations.WithSource("file:a.json"))))
+	require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte("session"), expectations.WithSessionID(uuid.New()))))
+	sub, err := subSvc.Subscribe("queue", false, subscriptions.WithFallbackPolicy(subscriptions.FallbackPolicyDrop))
+	require.NoError(t, err)
+	_, err = subSvc.Subscribe("session-queue", false, subscriptions.WithSessionID(uuid.New()))
+	require.NoError(t, err)
+	_, err = subSvc.Subscribe("namespace-queue", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+	require.NotNil(t, expSvc.Match(newTestCandidate(t, "exchange", "rk", []byte("foo"))))
+
+	require.NoError(t, stateSvc.Save())
+
+	state, _ := store.snapshot()
+	require.Len(t, state.Expectations, 1, "expectations with a source or a session are not saved")
+	assert.Len(t, state.Subscriptions, 1, "subscriptions with a session or of another namespace are not saved")
+	assert.Len(t, state.Assertions, 1)
+
+	// second run
+	consumer := &testConsumer{}
+	expSvc = NewExpectationsService()
+	subSvc = NewSubscriptionsService(consumer)
+	require.NoError(t, NewStateService(store, expSvc, subSvc, NewChanges(), WithPersistedAssertions()).Restore())
+
+	exps := expSvc.GetExpectations(GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, state.Expectations[0].ID, exps[0].ID)
+	assert.Len(t, getAssertions(t, expSvc, GetAssertionsRequest{}), 1)
+	require.Len(t, consumer.subs, 1)
+	assert.Equal(t, sub.ID(), consumer.subs[0].ID())
+
+	// assertions are ignored if they are not persisted
+	expSvc = NewExpectationsService()
+	require.NoError(t, NewStateService(store, expSvc, NewSubscriptionsService(&testConsumer{}), NewChanges()).Restore())
+	assert.Empty(t, getAssertions(t, expSvc, GetAssertionsRequest{}))
+}
+
+func TestStateService_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("saves on every change", func(t *testing.T) {
+		t.Parallel()
+
+		store := &testStateStore{}
+		changes := NewChanges()
+		expSvc := NewExpectationsService(WithExpectationsChanges(changes))
+		subSvc := NewSubscriptionsService(&testConsumer{}, WithSubscriptionsChanges(changes))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() { done <- NewStateService(store, expSvc, subSvc, changes).Run(ctx) }()
+
+		require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte("api"))))
+		require.Eventually(t, func() bool {
+			state, _ := store.snapshot()
+			return state != nil && len(state.Expectations) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		_, err := subSvc.Subscribe("queue", false)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			state, _ := store.snapshot()
+			return len(state.Subscriptions) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("saves on the interval and on shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		store := &testStateStore{}
+		changes := NewChanges()
+		expSvc := NewExpectationsService(WithExpectationsChanges(changes))
+		subSvc := NewSubscriptionsService(&testConsumer{}, WithSubscriptionsChanges(changes))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- NewStateService(store, expSvc, subSvc, changes, WithSaveInterval(time.Hour)).Run(ctx)
+		}()
+
+		require.NoError(t, expSvc.Create(newTestExpectation(t, "exchange", "rk", []byte("api"))))
+		time.Sleep(50 * time.Millisecond)
+		_, saves := store.snapshot()
+		assert.Zero(t, saves)
+
+		cancel()
+		require.NoError(t, <-done)
+
+		state, saves := store.snapshot()
+		assert.Equal(t, 1, saves)
+		assert.Len(t, state.Expectations, 1)
+	})
+}
@@ -1,10 +1,21 @@
 package app
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/google/uuid"
 )
 
+// ErrSubscriptionNotFound is returned for a subscription which does not exist in the namespace of the call.
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// ErrSubscriptionConflict is returned by an idempotent subscription to a queue which already has a subscription
+// of another namespace or session.
+var ErrSubscriptionConflict = errors.New("subscription exists in another namespace or session")
+
 // Consumer is the interface that wraps the basic AMQP subscriber/consumer methods.
 type Consumer interface {
 	Subscribe(sub *subscriptions.Subscription) error
@@ -18,41 +29,97 @@ type Consumer interface {
 // SubscriptionsService is the application level service to manage AMQP subscriptions.
 type SubscriptionsService struct {
 	consumer Consumer
+	changes  *Changes
+	events   *Events
+}
+
+// SubscriptionsOption is a function that configures a SubscriptionsService.
+type SubscriptionsOption func(s *SubscriptionsService)
+
+// WithSubscriptionsChanges makes the service notify every change of the subscriptions.
+func WithSubscriptionsChanges(c *Changes) SubscriptionsOption {
+	return func(s *SubscriptionsService) {
+		s.changes = c
+	}
+}
+
+// WithSubscriptionsEvents makes the service publish the subscription events.
+func WithSubscriptionsEvents(e *Events) SubscriptionsOption {
+	return func(s *SubscriptionsService) {
+		s.events = e
+	}
 }
 
 // NewSubscriptionsService creates a new SubscriptionsService instance.
-func NewSubscriptionsService(consumer Consumer) *SubscriptionsService {
-	return &SubscriptionsService{
+func NewSubscriptionsService(consumer Consumer, opts ...SubscriptionsOption) *SubscriptionsService {
+	s := &SubscriptionsService{
 		consumer: consumer,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // Subscribe subscribes to a queue.
-func (s *SubscriptionsService) Subscribe(queue string, idempotent bool) (*subscriptions.Subscription, error) {
+// An idempotent subscription returns the existing subscription to the queue instead, which must belong to the same
+// namespace and session.
+func (s *SubscriptionsService) Subscribe(queue string, idempotent bool, opts ...subscriptions.Option) (*subscriptions.Subscription, error) {
+	sub := subscriptions.NewSubscription(queue, opts...)
+
 	if idempotent {
 		subs := s.consumer.GetQueueSubscriptions(queue)
 		if len(subs) > 0 {
 			// return the first subscription found
+			if subs[0].Namespace() != sub.Namespace() || subs[0].SessionID() != sub.SessionID() {
+				return nil, fmt.Errorf("%w: queue %s", ErrSubscriptionConflict, queue)
+			}
 			return subs[0], nil
 		}
 	}
 
-	sub := subscriptions.NewSubscription(queue)
 	if err := s.consumer.Subscribe(sub); err != nil {
 		return nil, err
 	}
+	s.changes.Notify()
+	s.publish(EventSubscriptionAdded, sub)
 
 	return sub, nil
 }
 
+// Restore subscribes again to the subscriptions taken from a snapshot of a previous run.
+func (s *SubscriptionsService) Restore(subs []*subscriptions.Subscription) error {
+	for _, sub := range subs {
+		if err := s.consumer.Subscribe(sub); err != nil {
+			return fmt.Errorf("failed to restore subscription to queue %s: %w", sub.Queue(), err)
+		}
+	}
+
+	return nil
+}
+
 // UnsubscribeByID unsubscribes from a queue by ID.
 func (s *SubscriptionsService) UnsubscribeByID(id uuid.UUID) error {
-	return s.consumer.Unsubscribe(id)
+	defer s.changes.Notify()
+
+	var removed []*subscriptions.Subscription
+	for _, sub := range s.consumer.GetAllSubscriptions() {
+		if sub.ID() == id {
+			removed = append(removed, sub)
+		}
+	}
+
+	return s.unsubscribe(removed, func() error { return s.consumer.Unsubscribe(id) })
 }
 
-// UnsubscribeByQueue unsubscribes from a queue.
-func (s *SubscriptionsService) UnsubscribeByQueue(queue string) error {
-	return s.consumer.UnsubscribeFromQueue(queue)
+// UnsubscribeByQueue removes the subscriptions to the queue bound to the namespace,
+// leaving the subscriptions of the other namespaces untouched.
+func (s *SubscriptionsService) UnsubscribeByQueue(queue, namespace string) error {
+	return s.unsubscribeWhere(func(sub *subscriptions.Subscription) bool {
+		return sub.Queue() == queue && sub.Namespace() == namespace
+	})
 }
 
 // GetAllSubscriptions returns all subscriptions.
@@ -62,5 +129,62 @@ func (s *SubscriptionsService) GetAllSubscriptions() []*subscriptions.Subscripti
 
 // UnsubscribeAll resets all subscriptions.
 func (s *SubscriptionsService) UnsubscribeAll() error {
-	return s.consumer.UnsubscribeAll()
+	defer s.changes.Notify()
+	return s.unsubscribe(s.consumer.GetAllSubscriptions(), s.consumer.UnsubscribeAll)
+}
+
+// UnsubscribeByNamespace removes the subscriptions bound to the namespace, leaving the others untouched.
+func (s *SubscriptionsService) UnsubscribeByNamespace(namespace string) error {
+	return s.unsubscribeWhere(func(sub *subscriptions.Subscription) bool { return sub.Namespace() == namespace })
+}
+
+// UnsubscribeBySession removes the subscriptions owned by the session.
+func (s *SubscriptionsService) UnsubscribeBySession(sessionID uuid.UUID) error {
+	if sessionID == uuid.Nil {
+		return nil
+	}
+
+	return s.unsubscribeWhere(func(sub *subscriptions.Subscription) bool { return sub.SessionID() == sessionID })
+}
+
+// unsubscribeWhere removes the subscriptions one by one, so that the other subscriptions to their queues are kept.
+func (s *SubscriptionsService) unsubscribeWhere(match func(sub *subscriptions.Subscription) bool) error {
+	defer s.changes.Notify()
+
+	for _, sub := range s.consumer.GetAllSubscriptions() {
+		if !match(sub) {
+			continue
+		}
+
+		id := sub.ID()
+		if err := s.unsubscribe([]*subscriptions.Subscription{sub}, func() error { return s.consumer.Unsubscribe(id) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unsubscribe runs the unsubscribe call and publishes the removal of the subscriptions if it succeeds.
+func (s *SubscriptionsService) unsubscribe(removed []*subscriptions.Subscription, call func() error) error {
+	if err := call(); err != nil {
+		return err
+	}
+
+	for _, sub := range removed {
+		s.publish(EventSubscriptionRemoved, sub)
+	}
+
+	return nil
+}
+
+func (s *SubscriptionsService) publish(t EventType, sub *subscriptions.Subscription) {
+	id := sub.ID()
+	s.events.Publish(Event{
+		Type:           t,
+		CreatedAt:      time.Now(),
+		SubscriptionID: &id,
+		Queue:          sub.Queue(),
+		Namespace:      sub.Namespace(),
+	})
 }
@@ -18,6 +18,19 @@ type Config struct {
 	RabbitMQURL                      string `envconfig:"RABBITMQ_URL" required:"true"`
 	RabbitMQConnectionTimeoutSeconds int    `envconfig:"RABBITMQ_CONNECTION_TIMEOUT_SECONDS" required:"false" default:"300"`
 	AMQPQueuesStr                    string `envconfig:"AMQP_QUEUES" required:"false"`
+	AMQPPrefetchCount                int    `envconfig:"AMQP_PREFETCH_COUNT" required:"false" default:"100"`
+	UnmatchedPolicyStr               string `envconfig:"UNMATCHED_POLICY" required:"false" default:"REPLY"`
+	ProxyExchange                    string `envconfig:"PROXY_EXCHANGE" required:"false"`
+	ProxyRoutingKey                  string `envconfig:"PROXY_ROUTING_KEY" required:"false"`
+	ProxyTimeoutSeconds              int    `envconfig:"PROXY_TIMEOUT_SECONDS" required:"false" default:"5"`
+	ExpectationsDir                  string `envconfig:"EXPECTATIONS_DIR" required:"false"`
+	ExpectationsPollIntervalSeconds  int    `envconfig:"EXPECTATIONS_POLL_INTERVAL_SECONDS" required:"false" default:"2"`
+	StateFile                        string `envconfig:"STATE_FILE" required:"false"`
+	StateDir                         string `envconfig:"STATE_DIR" required:"false"`
+	StateSaveIntervalSeconds         int    `envconfig:"STATE_SAVE_INTERVAL_SECONDS" required:"false" default:"0"`
+	StatePersistAssertions           bool   `envconfig:"STATE_PERSIST_ASSERTIONS" required:"false" default:"false"`
+	AssertionsMaxCount               int    `envconfig:"ASSERTIONS_MAX_COUNT" required:"false" default:"0"`
+	AssertionsMaxAgeSeconds          int    `envconfig:"ASSERTIONS_MAX_AGE_SECONDS" required:"false" default:"0"`
 }
 
 type ServiceInfo struct {
@@ -86,3 +99,21 @@ func (c *Config) GRPCPort() int {
 func (c *Config) RabbitMQConnectionTimeout() time.Duration {
 	return time.Duration(c.RabbitMQConnectionTimeoutSeconds) * time.Second
 }
+
+func (c *Config) ProxyTimeout() time.Duration {
+	return time.Duration(c.ProxyTimeoutSeconds) * time.Second
+}
+
+func (c *Config) ExpectationsPollInterval() time.Duration {
+	return time.Duration(c.ExpectationsPollIntervalSeconds) * time.Second
+}
+
+// StateSaveInterval returns how often the state is saved, zero to save it after every change.
+func (c *Config) StateSaveInterval() time.Duration {
+	return time.Duration(c.StateSaveIntervalSeconds) * time.Second
+}
+
+// AssertionsMaxAge returns how long assertions are kept, zero to keep them forever.
+func (c *Config) AssertionsMaxAge() time.Duration {
+	return time.Duration(c.AssertionsMaxAgeSeconds) * time.Second
+}
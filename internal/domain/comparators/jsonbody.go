@@ -75,3 +75,38 @@ func (b *JSONBody) matchSubset(payload []byte) bool {
 
 	return true
 }
+
+// Diff returns the JSON patch turning the expected body into the payload, as a JSON array.
+// For a partial match, the fields added by the payload are left out since they do not prevent a match.
+// It is empty if the payload matches.
+func (b *JSONBody) Diff(payload []byte) (string, error) {
+	if b.Match(payload) {
+		return "", nil
+	}
+
+	if !json.Valid(payload) {
+		return "", errors.New("invalid json payload")
+	}
+
+	patch, err := jsondiff.CompareJSON(b.Body, payload)
+	if err != nil {
+		return "", err
+	}
+
+	if b.MatchType == MatchTypePartial {
+		changes := patch[:0]
+		for _, change := range patch {
+			if change.Type != jsondiff.OperationAdd {
+				changes = append(changes, change)
+			}
+		}
+		patch = changes
+	}
+
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
@@ -102,3 +102,56 @@ func TestJSONBody_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONBody_Diff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		matchType MatchType
+		payload   []byte
+		expDiff   string
+		expError  bool
+	}{
+		"match": {
+			matchType: MatchTypePartial,
+			payload:   []byte(`{"a": 1, "b": 2, "c": 3}`),
+		},
+		"partial ignores added fields": {
+			matchType: MatchTypePartial,
+			payload:   []byte(`{"a": 2, "c": 3}`),
+			expDiff:   `[{"op":"replace","path":"/a","value":2},{"op":"remove","path":"/b"}]`,
+		},
+		"exact reports added fields": {
+			matchType: MatchTypeExact,
+			payload:   []byte(`{"a": 1, "b": 2, "c": 3}`),
+			expDiff:   `[{"op":"add","path":"/c","value":3}]`,
+		},
+		"bad json payload": {
+			matchType: MatchTypeExact,
+			payload:   []byte(`foo`),
+			expError:  true,
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cmp, err := NewJSONBody([]byte(`{"a": 1, "b": 2}`), tt.matchType)
+			require.NoError(t, err)
+
+			diff, err := cmp.Diff(tt.payload)
+			if tt.expError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.expDiff == "" {
+				assert.Empty(t, diff)
+			} else {
+				assert.JSONEq(t, tt.expDiff, diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package comparators
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 )
 
 // Regex represents a regular expression matcher.
@@ -26,3 +27,24 @@ func NewRegex(regex string) (*Regex, error) {
 func (r *Regex) Match(payload []byte) bool {
 	return r.Regex.Match(payload)
 }
+
+// Captures returns the capture groups of the first match of the regular expression in the payload.
+// Groups are keyed by their index ("0" is the whole match, "1" the first group, etc.) and,
+// for named groups, additionally by their name. It returns nil if the payload does not match.
+func (r *Regex) Captures(payload []byte) map[string]string {
+	match := r.Regex.FindSubmatch(payload)
+	if match == nil {
+		return nil
+	}
+
+	captures := make(map[string]string, len(match))
+	names := r.Regex.SubexpNames()
+	for i, group := range match {
+		captures[strconv.Itoa(i)] = string(group)
+		if names[i] != "" {
+			captures[names[i]] = string(group)
+		}
+	}
+
+	return captures
+}
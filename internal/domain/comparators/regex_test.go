@@ -78,3 +78,17 @@ func TestRegex_Match(t *testing.T) {
 		})
 	}
 }
+
+func TestRegex_Captures(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRegex(`"id":\s*"(?P<id>[^"]+)".*"tenant":\s*"([^"]+)"`)
+	require.NoError(t, err)
+
+	captures := r.Captures([]byte(`{"id": "abc", "tenant": "acme"}`))
+	assert.Equal(t, "abc", captures["1"])
+	assert.Equal(t, "abc", captures["id"])
+	assert.Equal(t, "acme", captures["2"])
+
+	assert.Nil(t, r.Captures([]byte(`{}`)))
+}
@@ -0,0 +1,63 @@
+package comparators
+
+import (
+	"errors"
+	"strings"
+)
+
+// Topic represents a RabbitMQ topic exchange binding pattern matcher.
+// Words are separated by dots, "*" matches exactly one word and "#" matches zero or more words.
+type Topic struct {
+	Pattern string
+	words   []string
+}
+
+// NewTopic creates a new Topic matcher instance.
+func NewTopic(pattern string) (*Topic, error) {
+	if pattern == "" {
+		return nil, errors.New("topic pattern cannot be empty")
+	}
+
+	return &Topic{
+		Pattern: pattern,
+		words:   strings.Split(pattern, "."),
+	}, nil
+}
+
+// Match matches the topic pattern against a routing key.
+func (t *Topic) Match(key string) bool {
+	return matchTopicWords(t.words, strings.Split(key, "."))
+}
+
+func matchTopicWords(pattern, words []string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case "#":
+			// collapse consecutive hashes, they are equivalent to a single one
+			for len(pattern) > 0 && pattern[0] == "#" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(words); i++ {
+				if matchTopicWords(pattern, words[i:]) {
+					return true
+				}
+			}
+			return false
+		case "*":
+			if len(words) == 0 {
+				return false
+			}
+		default:
+			if len(words) == 0 || pattern[0] != words[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		words = words[1:]
+	}
+
+	return len(words) == 0
+}
@@ -0,0 +1,95 @@
+package comparators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTopic(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTopic("billing.*.invoice.#")
+	require.NoError(t, err)
+
+	_, err = NewTopic("")
+	assert.EqualError(t, err, "topic pattern cannot be empty")
+}
+
+func TestTopic_Match(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		key      string
+		expMatch bool
+	}{
+		"literal": {
+			pattern:  "billing.invoice",
+			key:      "billing.invoice",
+			expMatch: true,
+		},
+		"literal mismatch": {
+			pattern: "billing.invoice",
+			key:     "billing.order",
+		},
+		"star matches one word": {
+			pattern:  "billing.*.invoice",
+			key:      "billing.eu.invoice",
+			expMatch: true,
+		},
+		"star does not match zero words": {
+			pattern: "billing.*.invoice",
+			key:     "billing.invoice",
+		},
+		"star does not match two words": {
+			pattern: "billing.*.invoice",
+			key:     "billing.eu.west.invoice",
+		},
+		"hash matches zero words": {
+			pattern:  "billing.*.invoice.#",
+			key:      "billing.eu.invoice",
+			expMatch: true,
+		},
+		"hash matches many words": {
+			pattern:  "billing.*.invoice.#",
+			key:      "billing.eu.invoice.created.v2",
+			expMatch: true,
+		},
+		"hash in the middle": {
+			pattern:  "billing.#.created",
+			key:      "billing.eu.invoice.created",
+			expMatch: true,
+		},
+		"hash in the middle mismatch": {
+			pattern: "billing.#.created",
+			key:     "billing.eu.invoice.deleted",
+		},
+		"single hash matches everything": {
+			pattern:  "#",
+			key:      "any.routing.key",
+			expMatch: true,
+		},
+		"consecutive hashes": {
+			pattern:  "#.#.invoice",
+			key:      "invoice",
+			expMatch: true,
+		},
+		"trailing words mismatch": {
+			pattern: "billing.*",
+			key:     "billing.eu.invoice",
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			topic, err := NewTopic(tt.pattern)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expMatch, topic.Match(tt.key))
+		})
+	}
+}
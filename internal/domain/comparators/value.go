@@ -0,0 +1,62 @@
+package comparators
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ValueMatchType represents how a single header or property value is matched.
+type ValueMatchType string
+
+const (
+	// ValueMatchTypeExact represents an exact match of the value.
+	ValueMatchTypeExact ValueMatchType = "EXACT"
+	// ValueMatchTypeRegex represents a match of the value against a regular expression.
+	ValueMatchTypeRegex ValueMatchType = "REGEX"
+	// ValueMatchTypePresent represents a match if the value is present, regardless of its content.
+	ValueMatchTypePresent ValueMatchType = "PRESENT"
+	// ValueMatchTypeAbsent represents a match if the value is not present.
+	ValueMatchTypeAbsent ValueMatchType = "ABSENT"
+)
+
+// Value represents a matcher for a single header or property value.
+type Value struct {
+	MatchType ValueMatchType
+	Value     string
+	regex     *regexp.Regexp
+}
+
+// NewValue creates a new Value matcher instance.
+func NewValue(value string, matchType ValueMatchType) (*Value, error) {
+	v := &Value{Value: value, MatchType: matchType}
+
+	switch matchType {
+	case ValueMatchTypeExact, ValueMatchTypePresent, ValueMatchTypeAbsent:
+	case ValueMatchTypeRegex:
+		compiled, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regex: %w", err)
+		}
+		v.regex = compiled
+	default:
+		return nil, fmt.Errorf("unsupported value match type: %s", matchType)
+	}
+
+	return v, nil
+}
+
+// Match matches the value. present reports whether the value exists on the message at all.
+func (v *Value) Match(value string, present bool) bool {
+	switch v.MatchType {
+	case ValueMatchTypeExact:
+		return present && v.Value == value
+	case ValueMatchTypeRegex:
+		return present && v.regex.MatchString(value)
+	case ValueMatchTypePresent:
+		return present
+	case ValueMatchTypeAbsent:
+		return !present
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+package comparators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     string
+		matchType ValueMatchType
+		expError  string
+	}{
+		"exact": {
+			value:     "foo",
+			matchType: ValueMatchTypeExact,
+		},
+		"regex": {
+			value:     "^foo.*",
+			matchType: ValueMatchTypeRegex,
+		},
+		"bad regex": {
+			value:     "^foo.*[",
+			matchType: ValueMatchTypeRegex,
+			expError:  "error parsing regexp",
+		},
+		"unsupported match type": {
+			value:     "foo",
+			matchType: "FOO",
+			expError:  "unsupported value match type",
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewValue(tt.value, tt.matchType)
+			if tt.expError != "" {
+				assert.ErrorContains(t, err, tt.expError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValue_Match(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     string
+		matchType ValueMatchType
+		actual    string
+		present   bool
+		expMatch  bool
+	}{
+		"exact match": {
+			value:     "foo",
+			matchType: ValueMatchTypeExact,
+			actual:    "foo",
+			present:   true,
+			expMatch:  true,
+		},
+		"exact mismatch": {
+			value:     "foo",
+			matchType: ValueMatchTypeExact,
+			actual:    "bar",
+			present:   true,
+		},
+		"exact empty value is not present": {
+			value:     "",
+			matchType: ValueMatchTypeExact,
+		},
+		"regex match": {
+			value:     "^tenant-\\d+$",
+			matchType: ValueMatchTypeRegex,
+			actual:    "tenant-42",
+			present:   true,
+			expMatch:  true,
+		},
+		"regex mismatch": {
+			value:     "^tenant-\\d+$",
+			matchType: ValueMatchTypeRegex,
+			actual:    "tenant-x",
+			present:   true,
+		},
+		"present": {
+			matchType: ValueMatchTypePresent,
+			actual:    "anything",
+			present:   true,
+			expMatch:  true,
+		},
+		"present but missing": {
+			matchType: ValueMatchTypePresent,
+		},
+		"absent": {
+			matchType: ValueMatchTypeAbsent,
+			expMatch:  true,
+		},
+		"absent but present": {
+			matchType: ValueMatchTypeAbsent,
+			actual:    "anything",
+			present:   true,
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := NewValue(tt.value, tt.matchType)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expMatch, v.Match(tt.actual, tt.present))
+		})
+	}
+}
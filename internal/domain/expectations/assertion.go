@@ -1,15 +1,64 @@
 package expectations
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Assertion struct {
+	// ID identifies the assertion, it stays the same for its whole life.
+	ID uuid.UUID
+	// Sequence is the position of the assertion in the history, assigned when it is added.
+	Sequence    uint64
 	Candidate   *Candidate
 	Expectation *Expectation // can be null if no match
+	Proxy       *ProxyResult // set if the unmatched request was forwarded to the real service
+	Reply       *Reply       // set once a reply was published to the caller
+	NearMisses  []*NearMiss  // the closest expectations and why they did not match, if the request matched none
 	CreatedAt   time.Time
 }
 
+// ProxyResult is the outcome of forwarding an unmatched request to the real service.
+type ProxyResult struct {
+	Exchange   string
+	RoutingKey string
+	// Response is the reply of the real service, empty if the call failed.
+	Response []byte
+	// Error describes why the call failed, empty on success.
+	Error string
+}
+
+// Reply is the message published back to the caller of a request.
+type Reply struct {
+	// Queue is the reply-to queue the reply was published to.
+	Queue      string
+	Headers    map[string]string
+	Properties Properties
+	Body       []byte
+	// PublishedAt is when the reply was published, or when publishing failed.
+	PublishedAt time.Time
+	// Latency is the time between receiving the request and publishing the reply, zero if the receipt time is unknown.
+	Latency time.Duration
+	// Error describes why publishing failed, empty on success.
+	Error string
+}
+
+// Failed reports whether publishing the reply failed.
+func (r *Reply) Failed() bool {
+	return r.Error != ""
+}
+
+// IsProxied reports whether the request was forwarded to the real service.
+func (a *Assertion) IsProxied() bool {
+	return a.Proxy != nil
+}
+
 func NewMatchedAssertion(cnd *Candidate, exp *Expectation) *Assertion {
 	return &Assertion{
+		ID:          uuid.New(),
 		Candidate:   cnd,
 		Expectation: exp.Copy(), // freeze the state of the expectation
 		CreatedAt:   time.Now(),
@@ -18,60 +67,233 @@ func NewMatchedAssertion(cnd *Candidate, exp *Expectation) *Assertion {
 
 func NewUnmatchedAssertion(cnd *Candidate) *Assertion {
 	return &Assertion{
+		ID:        uuid.New(),
 		Candidate: cnd,
 		CreatedAt: time.Now(),
 	}
 }
 
+// Retention limits the history of assertions. Zero values mean no limit.
+type Retention struct {
+	// MaxCount is the number of assertions kept, the oldest ones are evicted first like in a ring buffer.
+	MaxCount int
+	// MaxAge is how long an assertion is kept after it was created.
+	MaxAge time.Duration
+}
+
 type Assertions struct {
-	list []*Assertion
+	list      []*Assertion
+	retention Retention
+	evicted   uint64
+	sequence  uint64
 }
 
 func NewAssertions() *Assertions {
 	return &Assertions{}
 }
 
+// SetRetention limits the history from now on and evicts the assertions beyond the new limits.
+func (a *Assertions) SetRetention(r Retention) {
+	a.retention = r
+	a.Evict(time.Now())
+}
+
+// Retention returns the limits of the history.
+func (a *Assertions) Retention() Retention {
+	return a.retention
+}
+
+// Add appends the assertion to the history, giving it an ID if it has none.
 func (a *Assertions) Add(assertion *Assertion) {
+	if assertion.ID == uuid.Nil {
+		assertion.ID = uuid.New()
+	}
+
+	a.sequence++
+	assertion.Sequence = a.sequence
 	a.list = append(a.list, assertion)
+	a.Evict(time.Now())
 }
 
+// Evict removes the assertions beyond the retention limits at the given time and returns how many were removed.
+func (a *Assertions) Evict(now time.Time) int {
+	n := 0
+	if a.retention.MaxCount > 0 && len(a.list) > a.retention.MaxCount {
+		n = len(a.list) - a.retention.MaxCount
+	}
+
+	if a.retention.MaxAge > 0 {
+		for n < len(a.list) && now.Sub(a.list[n].CreatedAt) > a.retention.MaxAge {
+			n++
+		}
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	// copy, so that the evicted assertions are not kept alive by the underlying array
+	a.list = append([]*Assertion(nil), a.list[n:]...)
+	a.evicted += uint64(n)
+
+	return n
+}
+
+// Evicted returns the total number of assertions evicted because of the retention limits.
+func (a *Assertions) Evicted() uint64 {
+	return a.evicted
+}
+
+// Len returns the number of assertions in the history.
+func (a *Assertions) Len() int {
+	return len(a.list)
+}
+
+// Clear removes all assertions. Cleared assertions do not count as evicted.
 func (a *Assertions) Clear() {
 	a.list = nil
 }
 
-func (a *Assertions) GetUnmatched() []*Assertion {
-	var unmatched []*Assertion
-	for _, assertion := range a.list {
-		if assertion.Expectation == nil {
-			unmatched = append(unmatched, assertion)
+// GetByCandidate returns the latest assertion recorded for the candidate, or nil if there is none.
+func (a *Assertions) GetByCandidate(cnd *Candidate) *Assertion {
+	for i := len(a.list) - 1; i >= 0; i-- {
+		if a.list[i].Candidate == cnd {
+			return a.list[i]
 		}
 	}
 
-	return unmatched
+	return nil
 }
 
-func (a *Assertions) GetMatched() []*Assertion {
-	var matched []*Assertion
+// GetByID returns the assertion with the ID, or nil if there is none.
+func (a *Assertions) GetByID(id uuid.UUID) *Assertion {
 	for _, assertion := range a.list {
-		if assertion.Expectation != nil {
-			matched = append(matched, assertion)
+		if assertion.ID == id {
+			return assertion
 		}
 	}
 
-	return matched
+	return nil
 }
 
 func (a *Assertions) GetAll() []*Assertion {
 	return a.list
 }
 
-func (a *Assertions) GetForExpectationByID(id string) []*Assertion {
-	var assertions []*Assertion
+// Find returns the assertions matching the query, in arrival order.
+func (a *Assertions) Find(q AssertionsQuery) []*Assertion {
+	var found []*Assertion
 	for _, assertion := range a.list {
-		if assertion.Expectation != nil && assertion.Expectation.ID.String() == id {
-			assertions = append(assertions, assertion)
+		if q.Matches(assertion) {
+			found = append(found, assertion)
 		}
 	}
 
-	return assertions
+	return found
+}
+
+// AssertionStatus is the outcome of a request, as recorded in its assertion.
+type AssertionStatus string
+
+const (
+	AssertionStatusMatched   AssertionStatus = "matched"
+	AssertionStatusUnmatched AssertionStatus = "unmatched"
+	AssertionStatusProxied   AssertionStatus = "proxied"
+)
+
+// ParseAssertionStatus parses the status of an assertion.
+func ParseAssertionStatus(s string) (AssertionStatus, error) {
+	switch status := AssertionStatus(s); status {
+	case AssertionStatusMatched, AssertionStatusUnmatched, AssertionStatusProxied:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownAssertionStatus, s)
+	}
+}
+
+// AssertionsQuery selects assertions. Unset fields match any assertion, set fields must all match.
+type AssertionsQuery struct {
+	ExpectationID *uuid.UUID
+	Status        AssertionStatus
+	Exchange      string
+	RoutingKey    string
+	Queue         string
+	// Headers must all be present in the request with the same values.
+	Headers map[string]string
+	// BodyContains is a substring of the raw request body.
+	BodyContains   string
+	BodyComparator BodyComparator
+	// LabelSelector selects the assertions by the labels of their expectation, unmatched requests have no labels.
+	LabelSelector LabelSelector
+	// Since and Until bound the creation time of the assertions, Since inclusively and Until exclusively.
+	Since time.Time
+	Until time.Time
+}
+
+// Matches reports whether the assertion matches the query.
+func (q AssertionsQuery) Matches(a *Assertion) bool {
+	if q.ExpectationID != nil && (a.Expectation == nil || a.Expectation.ID != *q.ExpectationID) {
+		return false
+	}
+
+	switch q.Status {
+	case AssertionStatusMatched:
+		if a.Expectation == nil {
+			return false
+		}
+	case AssertionStatusUnmatched:
+		if a.Expectation != nil {
+			return false
+		}
+	case AssertionStatusProxied:
+		if !a.IsProxied() {
+			return false
+		}
+	}
+
+	if len(q.LabelSelector) > 0 {
+		var labels map[string]string
+		if a.Expectation != nil {
+			labels = a.Expectation.Labels
+		}
+		if !q.LabelSelector.Matches(labels) {
+			return false
+		}
+	}
+
+	if !q.Since.IsZero() && a.CreatedAt.Before(q.Since) {
+		return false
+	}
+
+	if !q.Until.IsZero() && !a.CreatedAt.Before(q.Until) {
+		return false
+	}
+
+	return q.matchesCandidate(a.Candidate)
+}
+
+func (q AssertionsQuery) matchesCandidate(cnd *Candidate) bool {
+	if q.Exchange != "" && cnd.Exchange != q.Exchange {
+		return false
+	}
+
+	if q.RoutingKey != "" && cnd.RoutingKey != q.RoutingKey {
+		return false
+	}
+
+	if q.Queue != "" && cnd.Queue != q.Queue {
+		return false
+	}
+
+	for name, value := range q.Headers {
+		if v, ok := cnd.Header(name); !ok || v != value {
+			return false
+		}
+	}
+
+	if q.BodyContains != "" && !strings.Contains(string(cnd.Body), q.BodyContains) {
+		return false
+	}
+
+	return q.BodyComparator == nil || q.BodyComparator.Match(cnd.Body)
 }
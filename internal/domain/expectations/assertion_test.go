@@ -0,0 +1,146 @@
+package expectations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertions_Retention(t *testing.T) {
+	t.Parallel()
+
+	newAssertion := func(age time.Duration) *Assertion {
+		cnd, err := NewCandidate("exchange", "rk", []byte(`{}`))
+		require.NoError(t, err)
+
+		assertion := NewUnmatchedAssertion(cnd)
+		assertion.CreatedAt = time.Now().Add(-age)
+
+		return assertion
+	}
+
+	t.Run("max count", func(t *testing.T) {
+		t.Parallel()
+
+		a := NewAssertions()
+		a.SetRetention(Retention{MaxCount: 2})
+
+		first, second, third := newAssertion(0), newAssertion(0), newAssertion(0)
+		a.Add(first)
+		a.Add(second)
+		a.Add(third)
+
+		assert.Equal(t, []*Assertion{second, third}, a.GetAll())
+		assert.Equal(t, uint64(1), a.Evicted())
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		t.Parallel()
+
+		a := NewAssertions()
+		a.Add(newAssertion(time.Hour))
+		recent := newAssertion(time.Second)
+		a.Add(recent)
+		assert.Equal(t, 2, a.Len())
+
+		a.SetRetention(Retention{MaxAge: time.Minute})
+		assert.Equal(t, []*Assertion{recent}, a.GetAll())
+
+		assert.Equal(t, 1, a.Evict(time.Now().Add(2*time.Minute)))
+		assert.Empty(t, a.GetAll())
+		assert.Equal(t, uint64(2), a.Evicted())
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		t.Parallel()
+
+		a := NewAssertions()
+		a.Add(newAssertion(0))
+		a.Clear()
+
+		assert.Equal(t, 0, a.Len())
+		assert.Equal(t, uint64(0), a.Evicted())
+	})
+}
+
+func TestAssertionsQuery(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cnd, err := NewCandidate("orders", "order.created", []byte(`{"id":42,"status":"paid"}`),
+		WithCandidateQueue("orders-queue"),
+		WithCandidateHeaders(map[string]string{"x-tenant": "acme"}),
+	)
+	require.NoError(t, err)
+
+	assertion := NewUnmatchedAssertion(cnd)
+	assertion.CreatedAt = now
+
+	tests := []struct {
+		name  string
+		query AssertionsQuery
+		want  bool
+	}{
+		{name: "empty", query: AssertionsQuery{}, want: true},
+		{name: "combined", query: AssertionsQuery{
+			Status:       AssertionStatusUnmatched,
+			RoutingKey:   "order.created",
+			Queue:        "orders-queue",
+			Headers:      map[string]string{"x-tenant": "acme"},
+			BodyContains: `"status":"paid"`,
+			Since:        now.Add(-time.Minute),
+			Until:        now.Add(time.Minute),
+		}, want: true},
+		{name: "status", query: AssertionsQuery{Status: AssertionStatusMatched}},
+		{name: "expectation", query: AssertionsQuery{ExpectationID: &uuid.Nil}},
+		{name: "exchange", query: AssertionsQuery{Exchange: "other"}},
+		{name: "queue", query: AssertionsQuery{Queue: "other"}},
+		{name: "header value", query: AssertionsQuery{Headers: map[string]string{"x-tenant": "other"}}},
+		{name: "missing header", query: AssertionsQuery{Headers: map[string]string{"x-missing": ""}}},
+		{name: "body", query: AssertionsQuery{BodyContains: "shipped"}},
+		{name: "since", query: AssertionsQuery{Since: now.Add(time.Nanosecond)}},
+		{name: "until is exclusive", query: AssertionsQuery{Until: now}},
+		{name: "unmatched requests have no labels", query: AssertionsQuery{LabelSelector: LabelSelector{{Key: "suite", Operator: LabelOperatorExists}}}},
+		{name: "missing label", query: AssertionsQuery{LabelSelector: LabelSelector{{Key: "suite", Operator: LabelOperatorDoesNotExist}}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.query.Matches(assertion))
+		})
+	}
+}
+
+func TestParseAssertionStatus(t *testing.T) {
+	t.Parallel()
+
+	status, err := ParseAssertionStatus("proxied")
+	require.NoError(t, err)
+	assert.Equal(t, AssertionStatusProxied, status)
+
+	_, err = ParseAssertionStatus("unknown")
+	require.ErrorIs(t, err, ErrUnknownAssertionStatus)
+}
+
+func TestAssertions_GetByID(t *testing.T) {
+	t.Parallel()
+
+	cnd, err := NewCandidate("exchange", "rk", []byte(`{}`))
+	require.NoError(t, err)
+
+	a := NewAssertions()
+	assertion := NewUnmatchedAssertion(cnd)
+	a.Add(assertion)
+	assert.NotEqual(t, uuid.Nil, assertion.ID)
+	assert.Same(t, assertion, a.GetByID(assertion.ID))
+	assert.Nil(t, a.GetByID(uuid.New()))
+
+	// assertions without an ID get one when added
+	withoutID := &Assertion{Candidate: cnd}
+	a.Add(withoutID)
+	assert.NotEqual(t, uuid.Nil, withoutID.ID)
+}
@@ -2,18 +2,85 @@ package expectations
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // Candidate represents a candidate message to match against expectations.
 type Candidate struct {
 	Exchange   string
 	RoutingKey string
+	// Queue is the queue the message was consumed from, empty if unknown.
+	Queue string
+	// SubscriptionID is the subscription that consumed the message, nil if unknown.
+	SubscriptionID uuid.UUID
+	// Namespace is the namespace whose expectations the message is matched against, empty for the default one.
+	Namespace  string
+	Headers    map[string]string
+	Properties Properties
+	Delivery   Delivery
 	Body       json.RawMessage
 }
 
+// Delivery is the AMQP delivery metadata of a consumed message.
+type Delivery struct {
+	ConsumerTag string
+	DeliveryTag uint64
+	Redelivered bool
+	// ReceivedAt is when the message was consumed, zero if unknown.
+	ReceivedAt time.Time
+}
+
+// CandidateOption is a function that configures a Candidate.
+type CandidateOption func(c *Candidate)
+
+// WithCandidateHeaders sets the message headers of the candidate.
+func WithCandidateHeaders(headers map[string]string) CandidateOption {
+	return func(c *Candidate) {
+		c.Headers = headers
+	}
+}
+
+// WithCandidateQueue sets the queue the candidate was consumed from.
+func WithCandidateQueue(queue string) CandidateOption {
+	return func(c *Candidate) {
+		c.Queue = queue
+	}
+}
+
+// WithCandidateSubscriptionID sets the subscription that consumed the candidate.
+func WithCandidateSubscriptionID(id uuid.UUID) CandidateOption {
+	return func(c *Candidate) {
+		c.SubscriptionID = id
+	}
+}
+
+// WithCandidateNamespace sets the namespace whose expectations the candidate is matched against.
+func WithCandidateNamespace(namespace string) CandidateOption {
+	return func(c *Candidate) {
+		c.Namespace = namespace
+	}
+}
+
+// WithCandidateDelivery sets the delivery metadata of the candidate.
+func WithCandidateDelivery(d Delivery) CandidateOption {
+	return func(c *Candidate) {
+		c.Delivery = d
+	}
+}
+
+// WithCandidateProperties sets the message properties of the candidate.
+func WithCandidateProperties(props Properties) CandidateOption {
+	return func(c *Candidate) {
+		c.Properties = props
+	}
+}
+
 // NewCandidate creates a new Candidate instance.
-func NewCandidate(exchange, rk string, body json.RawMessage) (*Candidate, error) {
+func NewCandidate(exchange, rk string, body json.RawMessage, opts ...CandidateOption) (*Candidate, error) {
 	if exchange == "" {
 		return nil, ErrEmptyExchange
 	}
@@ -22,14 +89,104 @@ func NewCandidate(exchange, rk string, body json.RawMessage) (*Candidate, error)
 		return nil, ErrEmptyRoutingKey
 	}
 
-	return &Candidate{
+	c := &Candidate{
 		Exchange:   exchange,
 		RoutingKey: rk,
 		Body:       body,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// Header returns the value of a header and whether the header is present.
+func (c *Candidate) Header(name string) (string, bool) {
+	v, ok := c.Headers[name]
+	return v, ok
 }
 
 func (c *Candidate) FormattedBody(offset int) string {
 	raw, _ := json.MarshalIndent(c.Body, strings.Repeat(" ", offset), "  ")
 	return string(raw)
 }
+
+// Property names that can be matched by a Request.
+const (
+	PropertyContentType     = "content_type"
+	PropertyContentEncoding = "content_encoding"
+	PropertyDeliveryMode    = "delivery_mode"
+	PropertyPriority        = "priority"
+	PropertyCorrelationID   = "correlation_id"
+	PropertyReplyTo         = "reply_to"
+	PropertyExpiration      = "expiration"
+	PropertyMessageID       = "message_id"
+	PropertyType            = "type"
+	PropertyUserID          = "user_id"
+	PropertyAppID           = "app_id"
+)
+
+// Properties holds the AMQP basic properties of a candidate message.
+type Properties struct {
+	ContentType     string
+	ContentEncoding string
+	DeliveryMode    uint8
+	Priority        uint8
+	CorrelationID   string
+	ReplyTo         string
+	Expiration      string
+	MessageID       string
+	Type            string
+	UserID          string
+	AppID           string
+}
+
+// Get returns the value of a property by its name and whether it is set.
+// Numeric properties are reported as not set when they hold a zero value.
+func (p Properties) Get(name string) (string, bool) {
+	var v string
+	switch name {
+	case PropertyContentType:
+		v = p.ContentType
+	case PropertyContentEncoding:
+		v = p.ContentEncoding
+	case PropertyDeliveryMode:
+		if p.DeliveryMode != 0 {
+			v = strconv.Itoa(int(p.DeliveryMode))
+		}
+	case PropertyPriority:
+		if p.Priority != 0 {
+			v = strconv.Itoa(int(p.Priority))
+		}
+	case PropertyCorrelationID:
+		v = p.CorrelationID
+	case PropertyReplyTo:
+		v = p.ReplyTo
+	case PropertyExpiration:
+		v = p.Expiration
+	case PropertyMessageID:
+		v = p.MessageID
+	case PropertyType:
+		v = p.Type
+	case PropertyUserID:
+		v = p.UserID
+	case PropertyAppID:
+		v = p.AppID
+	}
+
+	return v, v != ""
+}
+
+// IsKnownProperty reports whether name is a property that can be matched.
+func IsKnownProperty(name string) bool {
+	switch name {
+	case PropertyContentType, PropertyContentEncoding, PropertyDeliveryMode, PropertyPriority,
+		PropertyCorrelationID, PropertyReplyTo, PropertyExpiration, PropertyMessageID,
+		PropertyType, PropertyUserID, PropertyAppID:
+		return true
+	default:
+		return false
+	}
+}
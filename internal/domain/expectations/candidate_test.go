@@ -57,3 +57,23 @@ func TestNewCandidate(t *testing.T) {
 		})
 	}
 }
+
+func TestProperties_Get(t *testing.T) {
+	t.Parallel()
+
+	props := Properties{ContentType: "application/json", Priority: 5, Type: "invoice.created"}
+
+	v, ok := props.Get(PropertyContentType)
+	assert.True(t, ok)
+	assert.Equal(t, "application/json", v)
+
+	v, ok = props.Get(PropertyPriority)
+	assert.True(t, ok)
+	assert.Equal(t, "5", v)
+
+	_, ok = props.Get(PropertyAppID)
+	assert.False(t, ok)
+
+	_, ok = props.Get(PropertyDeliveryMode)
+	assert.False(t, ok)
+}
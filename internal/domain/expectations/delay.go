@@ -0,0 +1,122 @@
+package expectations
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// MaxDelay is the longest delay of a reply, the random delays are clamped to it.
+	MaxDelay = 10 * time.Minute
+	// MaxDelaySigma is the largest sigma of a log-normal delay, larger ones would almost always hit MaxDelay.
+	MaxDelaySigma = 5.0
+)
+
+// DelayType represents how the reply delay of an expectation is computed.
+type DelayType string
+
+const (
+	// DelayTypeFixed delays every reply by the same duration.
+	DelayTypeFixed DelayType = "FIXED"
+	// DelayTypeUniform delays replies by a random duration uniformly distributed in the [Min, Max] range.
+	DelayTypeUniform DelayType = "UNIFORM"
+	// DelayTypeLogNormal delays replies by a random duration following a log-normal distribution,
+	// which is a good approximation of real service latencies with a long tail.
+	DelayTypeLogNormal DelayType = "LOG_NORMAL"
+)
+
+// Delay is a specification of how long to wait before a reply is published.
+type Delay struct {
+	Type   DelayType
+	Fixed  time.Duration
+	Min    time.Duration
+	Max    time.Duration
+	Median time.Duration
+	Sigma  float64
+}
+
+// NewFixedDelay creates a delay of a fixed duration.
+func NewFixedDelay(d time.Duration) (*Delay, error) {
+	if d < 0 {
+		return nil, ErrNegativeDelay
+	}
+
+	if d > MaxDelay {
+		return nil, fmt.Errorf("%w: %s", ErrDelayTooLong, MaxDelay)
+	}
+
+	return &Delay{Type: DelayTypeFixed, Fixed: d}, nil
+}
+
+// NewUniformDelay creates a delay uniformly distributed in the [minDelay, maxDelay] range.
+func NewUniformDelay(minDelay, maxDelay time.Duration) (*Delay, error) {
+	if minDelay < 0 || maxDelay < 0 {
+		return nil, ErrNegativeDelay
+	}
+
+	if maxDelay < minDelay {
+		return nil, ErrBadDelayRange
+	}
+
+	if maxDelay > MaxDelay {
+		return nil, fmt.Errorf("%w: %s", ErrDelayTooLong, MaxDelay)
+	}
+
+	return &Delay{Type: DelayTypeUniform, Min: minDelay, Max: maxDelay}, nil
+}
+
+// NewLogNormalDelay creates a delay following a log-normal distribution with the given median and sigma.
+func NewLogNormalDelay(median time.Duration, sigma float64) (*Delay, error) {
+	if median < 0 || sigma < 0 {
+		return nil, ErrNegativeDelay
+	}
+
+	if median > MaxDelay {
+		return nil, fmt.Errorf("%w: %s", ErrDelayTooLong, MaxDelay)
+	}
+
+	// NaN is not comparable, so it is rejected along with the values above the maximum
+	if !(sigma <= MaxDelaySigma) {
+		return nil, fmt.Errorf("%w: %v, the maximum is %v", ErrBadDelaySigma, sigma, MaxDelaySigma)
+	}
+
+	return &Delay{Type: DelayTypeLogNormal, Median: median, Sigma: sigma}, nil
+}
+
+// Duration returns the duration to wait for a single reply.
+func (d *Delay) Duration() time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	switch d.Type {
+	case DelayTypeFixed:
+		return d.Fixed
+	case DelayTypeUniform:
+		if d.Max == d.Min {
+			return d.Min
+		}
+		return d.Min + time.Duration(rand.Int64N(int64(d.Max-d.Min)+1)) // nolint: gosec
+	case DelayTypeLogNormal:
+		// the long tail is clamped before the conversion, which would overflow for the largest samples
+		sample := float64(d.Median) * math.Exp(rand.NormFloat64()*d.Sigma) // nolint: gosec
+		if sample >= float64(MaxDelay) {
+			return MaxDelay
+		}
+		return time.Duration(sample)
+	default:
+		return 0
+	}
+}
+
+// Action represents what the mockserver does with a matched request.
+type Action string
+
+const (
+	// ActionReply publishes the response to the reply-to queue of the request.
+	ActionReply Action = "REPLY"
+	// ActionDrop acknowledges the request and records the assertion, but does not publish any reply.
+	ActionDrop Action = "DROP"
+)
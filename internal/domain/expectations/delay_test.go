@@ -0,0 +1,153 @@
+package expectations
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		create  func() (*Delay, error)
+		wantErr error
+	}{
+		{
+			name:   "fixed",
+			create: func() (*Delay, error) { return NewFixedDelay(time.Second) },
+		},
+		{
+			name:    "negative fixed",
+			create:  func() (*Delay, error) { return NewFixedDelay(-time.Second) },
+			wantErr: ErrNegativeDelay,
+		},
+		{
+			name:   "uniform",
+			create: func() (*Delay, error) { return NewUniformDelay(time.Second, 2*time.Second) },
+		},
+		{
+			name:    "uniform with inverted range",
+			create:  func() (*Delay, error) { return NewUniformDelay(2*time.Second, time.Second) },
+			wantErr: ErrBadDelayRange,
+		},
+		{
+			name:    "negative uniform",
+			create:  func() (*Delay, error) { return NewUniformDelay(-time.Second, time.Second) },
+			wantErr: ErrNegativeDelay,
+		},
+		{
+			name:   "log normal",
+			create: func() (*Delay, error) { return NewLogNormalDelay(time.Second, 0.5) },
+		},
+		{
+			name:    "log normal with negative sigma",
+			create:  func() (*Delay, error) { return NewLogNormalDelay(time.Second, -1) },
+			wantErr: ErrNegativeDelay,
+		},
+		{
+			name:    "fixed above the maximum",
+			create:  func() (*Delay, error) { return NewFixedDelay(MaxDelay + time.Second) },
+			wantErr: ErrDelayTooLong,
+		},
+		{
+			name:    "uniform above the maximum",
+			create:  func() (*Delay, error) { return NewUniformDelay(time.Second, MaxDelay+time.Second) },
+			wantErr: ErrDelayTooLong,
+		},
+		{
+			name:    "log normal with too large sigma",
+			create:  func() (*Delay, error) { return NewLogNormalDelay(time.Second, 50) },
+			wantErr: ErrBadDelaySigma,
+		},
+		{
+			name:    "log normal with NaN sigma",
+			create:  func() (*Delay, error) { return NewLogNormalDelay(time.Second, math.NaN()) },
+			wantErr: ErrBadDelaySigma,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := tt.create()
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, d)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, d)
+		})
+	}
+}
+
+func TestDelay_Duration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil delay", func(t *testing.T) {
+		t.Parallel()
+
+		var d *Delay
+		assert.Zero(t, d.Duration())
+	})
+
+	t.Run("fixed", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewFixedDelay(150 * time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, 150*time.Millisecond, d.Duration())
+	})
+
+	t.Run("uniform", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewUniformDelay(100*time.Millisecond, 200*time.Millisecond)
+		require.NoError(t, err)
+
+		for range 100 {
+			got := d.Duration()
+			assert.GreaterOrEqual(t, got, 100*time.Millisecond)
+			assert.LessOrEqual(t, got, 200*time.Millisecond)
+		}
+	})
+
+	t.Run("log normal without variation", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewLogNormalDelay(100*time.Millisecond, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 100*time.Millisecond, d.Duration())
+	})
+
+	t.Run("log normal", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewLogNormalDelay(100*time.Millisecond, 1)
+		require.NoError(t, err)
+
+		for range 100 {
+			assert.Positive(t, d.Duration())
+		}
+	})
+
+	t.Run("log normal clamped to the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewLogNormalDelay(MaxDelay, MaxDelaySigma)
+		require.NoError(t, err)
+
+		for range 1000 {
+			got := d.Duration()
+			assert.GreaterOrEqual(t, got, time.Duration(0))
+			assert.LessOrEqual(t, got, MaxDelay)
+		}
+	})
+}
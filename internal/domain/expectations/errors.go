@@ -5,4 +5,23 @@ import "errors"
 var (
 	ErrEmptyExchange   = errors.New("exchange cannot be empty")
 	ErrEmptyRoutingKey = errors.New("routing key cannot be empty")
+	ErrEmptyHeaderName = errors.New("header name cannot be empty")
+	ErrUnknownProperty = errors.New("unknown message property")
+	ErrEmptyTemplate   = errors.New("response template cannot be empty")
+	ErrMissingValue    = errors.New("no value in the request at path")
+	ErrNegativeDelay   = errors.New("delay cannot be negative")
+	ErrBadDelayRange   = errors.New("delay max must be greater than or equal to min")
+	ErrDelayTooLong    = errors.New("delay is longer than the maximum delay")
+	ErrBadDelaySigma   = errors.New("delay sigma is too large")
+
+	ErrEmptyScenarioName  = errors.New("scenario name cannot be empty")
+	ErrEmptyScenarioState = errors.New("scenario state cannot be empty")
+
+	ErrBadVerificationTimes = errors.New("verification times must be exactly n, or at least n and at most m with n <= m")
+	ErrEmptySequence        = errors.New("sequence must have at least one step")
+
+	ErrUnknownAssertionStatus = errors.New("unknown assertion status")
+
+	ErrInvalidLabel         = errors.New("invalid label")
+	ErrInvalidLabelSelector = errors.New("invalid label selector")
 )
@@ -8,11 +8,18 @@ import (
 
 type Expectation struct {
 	ID         uuid.UUID
+	Name       string // optional client-supplied name, unique among the expectations
+	Labels     map[string]string
 	Request    *Request
 	Response   *Response
 	Times      *Times
 	TimeToLive *TimeToLive
 	Priority   int
+	Delay      *Delay
+	Action     Action
+	Source     string // where the expectation comes from, e.g. an expectation file, empty if created through the API
+	Scenario   *Scenario
+	SessionID  uuid.UUID // the session owning the expectation, nil if it outlives any client
 	CreatedAt  time.Time
 }
 
@@ -50,8 +57,9 @@ func NewExpectation(req *Request, res *Response, opts ...ExpectationOption) (*Ex
 	e := &Expectation{
 		ID:       uuid.New(),
 		Request:  req,
-		Response: res,
+		Response: res.withCapturer(req.BodyComparator),
 		Priority: 0,
+		Action:   ActionReply,
 		Times: &Times{
 			RemainingTimes: 1,
 		},
@@ -78,25 +86,34 @@ func (e *Expectation) Use() {
 }
 
 func (e *Expectation) IsActive() bool {
-	if e.Times != nil && !e.Times.Unlimited && e.Times.RemainingTimes <= 0 {
-		return false
-	}
+	return !e.IsExhausted() && !e.IsExpired()
+}
 
-	if e.TimeToLive != nil && time.Now().After(e.CreatedAt.Add(e.TimeToLive.TTL)) {
-		return false
-	}
+// IsExhausted reports whether the expectation was matched as many times as it is allowed to.
+func (e *Expectation) IsExhausted() bool {
+	return e.Times != nil && !e.Times.Unlimited && e.Times.RemainingTimes <= 0
+}
 
-	return true
+// IsExpired reports whether the time to live of the expectation has passed.
+func (e *Expectation) IsExpired() bool {
+	return e.TimeToLive != nil && time.Now().After(e.CreatedAt.Add(e.TimeToLive.TTL))
 }
 
 func (e *Expectation) Copy() *Expectation {
 	return &Expectation{
 		ID:         e.ID,
+		Name:       e.Name,
+		Labels:     copyLabels(e.Labels),
 		Request:    e.Request,  // immutable
 		Response:   e.Response, // immutable
 		Times:      e.Times.Copy(),
 		TimeToLive: e.TimeToLive.Copy(),
 		Priority:   e.Priority,
+		Delay:      e.Delay, // immutable
+		Action:     e.Action,
+		Source:     e.Source,
+		Scenario:   e.Scenario, // immutable
+		SessionID:  e.SessionID,
 		CreatedAt:  e.CreatedAt,
 	}
 }
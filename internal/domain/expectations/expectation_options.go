@@ -3,6 +3,8 @@ package expectations
 import (
 	"errors"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type ExpectationOption func(e *Expectation) error
@@ -57,3 +59,84 @@ func WithTimeToLive(ttl time.Duration) ExpectationOption {
 		return nil
 	}
 }
+
+func WithDelay(d *Delay) ExpectationOption {
+	return func(e *Expectation) error {
+		e.Delay = d
+		return nil
+	}
+}
+
+func WithDropReply() ExpectationOption {
+	return func(e *Expectation) error {
+		e.Action = ActionDrop
+		return nil
+	}
+}
+
+func WithSource(source string) ExpectationOption {
+	return func(e *Expectation) error {
+		e.Source = source
+		return nil
+	}
+}
+
+// WithSessionID makes the expectation owned by a session, it is removed when the session ends.
+func WithSessionID(id uuid.UUID) ExpectationOption {
+	return func(e *Expectation) error {
+		e.SessionID = id
+		return nil
+	}
+}
+
+// WithName gives the expectation a client-supplied name, so that it can be upserted by it.
+func WithName(name string) ExpectationOption {
+	return func(e *Expectation) error {
+		e.Name = name
+		return nil
+	}
+}
+
+// WithLabels attaches labels to the expectation, to select it with a label selector.
+func WithLabels(labels map[string]string) ExpectationOption {
+	return func(e *Expectation) error {
+		if err := ValidateLabels(labels); err != nil {
+			return err
+		}
+
+		e.Labels = copyLabels(labels)
+		return nil
+	}
+}
+
+// WithScenario makes the expectation part of a scenario.
+func WithScenario(sc *Scenario) ExpectationOption {
+	return func(e *Expectation) error {
+		e.Scenario = sc
+		return nil
+	}
+}
+
+// WithID sets the ID of the expectation, e.g. when it is restored from a snapshot.
+func WithID(id uuid.UUID) ExpectationOption {
+	return func(e *Expectation) error {
+		e.ID = id
+		return nil
+	}
+}
+
+// WithCreatedAt sets the creation time of the expectation, the time to live is counted from it.
+func WithCreatedAt(t time.Time) ExpectationOption {
+	return func(e *Expectation) error {
+		e.CreatedAt = t
+		return nil
+	}
+}
+
+// WithTimes sets the usage state of the expectation as is, including used up limited times.
+func WithTimes(t *Times) ExpectationOption {
+	return func(e *Expectation) error {
+		e.Times = t.Copy()
+		return nil
+	}
+}
@@ -92,6 +92,45 @@ func TestNewExpectation(t *testing.T) {
 		assert.ErrorIs(t, err, ErrTTLMustBeGreaterThanZero)
 		assert.Nil(t, exp)
 	})
+
+	t.Run("with delay", func(t *testing.T) {
+		t.Parallel()
+
+		delay, err := NewFixedDelay(time.Second)
+		require.NoError(t, err)
+
+		exp, err := NewExpectation(req, resp, WithDelay(delay))
+		require.NoError(t, err)
+		require.NotNil(t, exp)
+
+		assert.Equal(t, delay, exp.Delay)
+		assert.Equal(t, ActionReply, exp.Action)
+	})
+
+	t.Run("with drop reply", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExpectation(req, resp, WithDropReply())
+		require.NoError(t, err)
+		require.NotNil(t, exp)
+
+		assert.Equal(t, ActionDrop, exp.Action)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		t.Parallel()
+
+		id := uuid.New()
+		createdAt := time.Now().Add(-time.Hour)
+		exp, err := NewExpectation(req, resp, WithID(id), WithCreatedAt(createdAt), WithTimes(&Times{RemainingTimes: 0}))
+		require.NoError(t, err)
+		require.NotNil(t, exp)
+
+		assert.Equal(t, id, exp.ID)
+		assert.Equal(t, createdAt, exp.CreatedAt)
+		assert.Equal(t, uint32(0), exp.Times.RemainingTimes)
+		assert.False(t, exp.IsActive())
+	})
 }
 
 func TestExpectation_IsActive(t *testing.T) {
@@ -210,10 +249,13 @@ func TestExpectation_Copy(t *testing.T) {
 	resp, err := NewResponse([]byte("body"))
 	require.NoError(t, err)
 
-	exp, err := NewExpectation(req, resp, WithLimitedTimes(2), WithTimeToLive(time.Second))
+	exp, err := NewExpectation(req, resp, WithLimitedTimes(2), WithTimeToLive(time.Second), WithLabels(map[string]string{"suite": "checkout"}))
 	require.NoError(t, err)
 
 	cpy := exp.Copy()
 
 	assert.Equal(t, exp, cpy)
+
+	cpy.Labels["suite"] = "other"
+	assert.Equal(t, "checkout", exp.Labels["suite"])
 }
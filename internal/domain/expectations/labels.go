@@ -0,0 +1,178 @@
+package expectations
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// labelKeyPattern is an optional DNS-like prefix and a name of at most 63 characters, as in Kubernetes.
+	labelKeyPattern   = regexp.MustCompile(`^([A-Za-z0-9.-]+/)?[A-Za-z0-9]([A-Za-z0-9._-]{0,61}[A-Za-z0-9])?$`)
+	labelValuePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]{0,61}[A-Za-z0-9])?)?$`)
+
+	setRequirementPattern      = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+	equalityRequirementPattern = regexp.MustCompile(`^([^\s!=]+)\s*(!=|==|=)\s*(\S*)$`)
+)
+
+// ValidateLabels checks the keys and values of the labels, with the syntax of Kubernetes labels.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !labelKeyPattern.MatchString(key) {
+			return fmt.Errorf("%w: key %q", ErrInvalidLabel, key)
+		}
+
+		if !labelValuePattern.MatchString(value) {
+			return fmt.Errorf("%w: value %q of %s", ErrInvalidLabel, value, key)
+		}
+	}
+
+	return nil
+}
+
+// LabelOperator is how a requirement of a label selector compares the value of a label.
+type LabelOperator string
+
+const (
+	LabelOperatorEquals       LabelOperator = "="
+	LabelOperatorNotEquals    LabelOperator = "!="
+	LabelOperatorIn           LabelOperator = "in"
+	LabelOperatorNotIn        LabelOperator = "notin"
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!"
+)
+
+// LabelRequirement is a condition on one label.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	// Values are the values compared, one for the equality operators and none for the existence ones.
+	Values []string
+}
+
+// Matches reports whether the labels meet the requirement.
+// Like in Kubernetes, labels without the key meet the != and notin requirements.
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case LabelOperatorEquals, LabelOperatorIn:
+		return ok && slices.Contains(r.Values, value)
+	case LabelOperatorNotEquals, LabelOperatorNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	case LabelOperatorExists:
+		return ok
+	case LabelOperatorDoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+// LabelSelector selects expectations by their labels, all the requirements must be met.
+// An empty selector selects everything.
+type LabelSelector []LabelRequirement
+
+// Matches reports whether the labels meet all the requirements of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseLabelSelector parses a Kubernetes-style label selector, a comma-separated list of requirements:
+// key=value, key==value, key!=value, key in (v1,v2), key notin (v1,v2), key and !key.
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var s LabelSelector
+	for _, term := range splitLabelSelector(selector) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return nil, fmt.Errorf("%w: empty requirement in %q", ErrInvalidLabelSelector, selector)
+		}
+
+		r, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, r)
+	}
+
+	return s, nil
+}
+
+// splitLabelSelector splits the selector on the commas that are not inside the values of a set requirement.
+func splitLabelSelector(selector string) []string {
+	if strings.TrimSpace(selector) == "" {
+		return nil
+	}
+
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(terms, selector[start:])
+}
+
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	var r LabelRequirement
+	switch {
+	case setRequirementPattern.MatchString(term):
+		m := setRequirementPattern.FindStringSubmatch(term)
+		if strings.TrimSpace(m[3]) == "" {
+			return LabelRequirement{}, fmt.Errorf("%w: no values in %q", ErrInvalidLabelSelector, term)
+		}
+		r = LabelRequirement{Key: m[1], Operator: LabelOperator(m[2])}
+		for _, value := range strings.Split(m[3], ",") {
+			r.Values = append(r.Values, strings.TrimSpace(value))
+		}
+	case equalityRequirementPattern.MatchString(term):
+		m := equalityRequirementPattern.FindStringSubmatch(term)
+		r = LabelRequirement{Key: m[1], Operator: LabelOperatorEquals, Values: []string{m[3]}}
+		if m[2] == "!=" {
+			r.Operator = LabelOperatorNotEquals
+		}
+	case strings.HasPrefix(term, "!"):
+		r = LabelRequirement{Key: strings.TrimSpace(term[1:]), Operator: LabelOperatorDoesNotExist}
+	default:
+		r = LabelRequirement{Key: term, Operator: LabelOperatorExists}
+	}
+
+	if !labelKeyPattern.MatchString(r.Key) {
+		return LabelRequirement{}, fmt.Errorf("%w: invalid key in %q", ErrInvalidLabelSelector, term)
+	}
+
+	for _, value := range r.Values {
+		if !labelValuePattern.MatchString(value) {
+			return LabelRequirement{}, fmt.Errorf("%w: invalid value in %q", ErrInvalidLabelSelector, term)
+		}
+	}
+
+	return r, nil
+}
+
+// copyLabels returns a copy of the labels, nil if there are none.
+func copyLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	return maps.Clone(labels)
+}
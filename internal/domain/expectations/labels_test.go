@@ -0,0 +1,104 @@
+package expectations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateLabels(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateLabels(map[string]string{
+		"suite":                    "checkout",
+		"app.example.com/owner":    "payments",
+		"empty":                    "",
+		"with-dash_underscore.dot": "v1.2-3_a",
+	}))
+
+	for _, labels := range []map[string]string{
+		{"": "value"},
+		{"-suite": "checkout"},
+		{"with space": "checkout"},
+		{"suite": "-checkout"},
+		{"suite": "check out"},
+		{"suite": "a,b"},
+	} {
+		assert.ErrorIs(t, ValidateLabels(labels), ErrInvalidLabel, labels)
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		selector string
+		want     LabelSelector
+	}{
+		{selector: "", want: nil},
+		{selector: "suite=checkout", want: LabelSelector{{Key: "suite", Operator: LabelOperatorEquals, Values: []string{"checkout"}}}},
+		{selector: "suite==checkout", want: LabelSelector{{Key: "suite", Operator: LabelOperatorEquals, Values: []string{"checkout"}}}},
+		{selector: "suite != checkout", want: LabelSelector{{Key: "suite", Operator: LabelOperatorNotEquals, Values: []string{"checkout"}}}},
+		{selector: "owner in (payments, orders)", want: LabelSelector{{Key: "owner", Operator: LabelOperatorIn, Values: []string{"payments", "orders"}}}},
+		{selector: "owner notin (payments)", want: LabelSelector{{Key: "owner", Operator: LabelOperatorNotIn, Values: []string{"payments"}}}},
+		{selector: "suite", want: LabelSelector{{Key: "suite", Operator: LabelOperatorExists}}},
+		{selector: "!suite", want: LabelSelector{{Key: "suite", Operator: LabelOperatorDoesNotExist}}},
+		{selector: "suite=checkout, owner in (payments,orders), !flaky", want: LabelSelector{
+			{Key: "suite", Operator: LabelOperatorEquals, Values: []string{"checkout"}},
+			{Key: "owner", Operator: LabelOperatorIn, Values: []string{"payments", "orders"}},
+			{Key: "flaky", Operator: LabelOperatorDoesNotExist},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseLabelSelector(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, selector := range []string{"suite=checkout,", "suite=a=b", "owner in ()", "owner in (a b)", "-suite", "!", "suite=check out"} {
+		_, err := ParseLabelSelector(selector)
+		assert.ErrorIs(t, err, ErrInvalidLabelSelector, selector)
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"suite": "checkout", "owner": "payments"}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "suite=checkout", want: true},
+		{selector: "suite=search"},
+		{selector: "suite!=search", want: true},
+		{selector: "missing!=value", want: true},
+		{selector: "owner in (payments,orders)", want: true},
+		{selector: "owner notin (payments,orders)"},
+		{selector: "missing notin (value)", want: true},
+		{selector: "missing in (value)"},
+		{selector: "suite", want: true},
+		{selector: "missing"},
+		{selector: "!missing", want: true},
+		{selector: "!suite"},
+		{selector: "suite=checkout,owner=orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			t.Parallel()
+
+			selector, err := ParseLabelSelector(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, selector.Matches(labels))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package expectations
+
+import "sort"
+
+// nearMissesLimit is the number of expectations explained for an unmatched request.
+const nearMissesLimit = 3
+
+// Reasons an expectation did not match a request, besides the parts listed by Request.Mismatches.
+const (
+	NearMissExpired        = "expired"
+	NearMissTimesExhausted = "times exhausted"
+	NearMissScenarioState  = "scenario state"
+)
+
+// BodyDiffer is implemented by the body comparators able to explain why a payload does not match.
+type BodyDiffer interface {
+	Diff(payload []byte) (string, error)
+}
+
+// NearMiss explains why an expectation close to a request did not match it.
+type NearMiss struct {
+	Expectation *Expectation
+	// Reasons lists why the expectation did not match, e.g. "routing key", "body" or "expired".
+	Reasons []string
+	// BodyDiff is the JSON patch turning the expected body into the received one,
+	// empty unless the body does not match and the body comparator can tell the difference.
+	BodyDiff string
+}
+
+// FindNearMisses explains why the closest expectations did not match the candidate, the closest first.
+// An expectation is close if it expects the same exchange and routing key, or the same body.
+func FindNearMisses(cnd *Candidate, exps []*Expectation, scenarios *Scenarios) []*NearMiss {
+	var nearMisses []*NearMiss
+	for _, exp := range exps {
+		req := exp.Request
+		sameRoute := req.matchesExchange(cnd) && req.matchesRoutingKey(cnd)
+		sameBody := req.BodyComparator.Match(cnd.Body)
+		if !sameRoute && !sameBody {
+			continue
+		}
+
+		nearMiss := &NearMiss{
+			Expectation: exp.Copy(),
+			Reasons:     append(req.Mismatches(cnd), InactiveReasons(exp, scenarios)...),
+		}
+
+		if differ, ok := req.BodyComparator.(BodyDiffer); ok && !sameBody {
+			// the reasons already tell the body does not match if the difference cannot be computed
+			nearMiss.BodyDiff, _ = differ.Diff(cnd.Body)
+		}
+
+		nearMisses = append(nearMisses, nearMiss)
+	}
+
+	sort.SliceStable(nearMisses, func(i, j int) bool {
+		return len(nearMisses[i].Reasons) < len(nearMisses[j].Reasons)
+	})
+
+	return nearMisses[:min(len(nearMisses), nearMissesLimit)]
+}
+
+// InactiveReasons lists why the expectation cannot match any request at the moment, empty if it can.
+func InactiveReasons(exp *Expectation, scenarios *Scenarios) []string {
+	var reasons []string
+	if exp.IsExpired() {
+		reasons = append(reasons, NearMissExpired)
+	}
+
+	if exp.IsExhausted() {
+		reasons = append(reasons, NearMissTimesExhausted)
+	}
+
+	if sc := exp.Scenario; sc != nil && !sc.Allows(scenarios.State(sc.Name)) {
+		reasons = append(reasons, NearMissScenarioState)
+	}
+
+	return reasons
+}
@@ -0,0 +1,70 @@
+package expectations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindNearMisses(t *testing.T) {
+	t.Parallel()
+
+	newExpectation := func(rk, body string, opts ...ExpectationOption) *Expectation {
+		bodyCmp, err := comparators.NewJSONBody([]byte(body), comparators.MatchTypePartial)
+		require.NoError(t, err)
+
+		req, err := NewRequest("exchange", rk, bodyCmp)
+		require.NoError(t, err)
+
+		res, err := NewResponse([]byte(`{}`))
+		require.NoError(t, err)
+
+		exp, err := NewExpectation(req, res, opts...)
+		require.NoError(t, err)
+
+		return exp
+	}
+
+	scenario, err := NewScenario("order", "paid", "")
+	require.NoError(t, err)
+
+	otherRoute := newExpectation("other", `{"id":1}`)
+	otherBody := newExpectation("rk", `{"id":2}`)
+	exhausted := newExpectation("rk", `{"id":1}`)
+	exhausted.Use()
+	expired := newExpectation("rk", `{"id":1}`, WithTimeToLive(time.Nanosecond))
+	inOtherState := newExpectation("rk", `{"id":1}`, WithScenario(scenario))
+	unrelated := newExpectation("other", `{"id":3}`)
+	time.Sleep(time.Millisecond)
+
+	cnd, err := NewCandidate("exchange", "rk", []byte(`{"id":1}`))
+	require.NoError(t, err)
+
+	nearMisses := FindNearMisses(cnd, []*Expectation{unrelated, otherRoute, otherBody, exhausted}, NewScenarios())
+	require.Len(t, nearMisses, 3)
+
+	assert.Equal(t, otherRoute.ID, nearMisses[0].Expectation.ID)
+	assert.Equal(t, []string{"routing key"}, nearMisses[0].Reasons)
+	assert.Empty(t, nearMisses[0].BodyDiff)
+
+	assert.Equal(t, otherBody.ID, nearMisses[1].Expectation.ID)
+	assert.Equal(t, []string{"body"}, nearMisses[1].Reasons)
+	assert.JSONEq(t, `[{"op":"replace","path":"/id","value":1}]`, nearMisses[1].BodyDiff)
+
+	assert.Equal(t, exhausted.ID, nearMisses[2].Expectation.ID)
+	assert.Equal(t, []string{NearMissTimesExhausted}, nearMisses[2].Reasons)
+
+	nearMisses = FindNearMisses(cnd, []*Expectation{expired, inOtherState}, NewScenarios())
+	require.Len(t, nearMisses, 2)
+	assert.Equal(t, []string{NearMissExpired}, nearMisses[0].Reasons)
+	assert.Equal(t, []string{NearMissScenarioState}, nearMisses[1].Reasons)
+
+	// the closest expectations come first and only a few are listed
+	nearMisses = FindNearMisses(cnd, []*Expectation{otherRoute, otherRoute, exhausted, expired, otherBody}, NewScenarios())
+	require.Len(t, nearMisses, nearMissesLimit)
+
+	assert.Empty(t, FindNearMisses(cnd, []*Expectation{unrelated}, NewScenarios()))
+}
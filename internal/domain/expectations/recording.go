@@ -0,0 +1,40 @@
+package expectations
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+)
+
+// NewRecordedExpectation creates an expectation replaying an observed request and the reply of the real service.
+// JSON object requests are matched exactly, other payloads by a regex matching the whole body literally,
+// since the API only describes JSON bodies as objects.
+func NewRecordedExpectation(cnd *Candidate, responseBody []byte) (*Expectation, error) {
+	bodyCmp, err := newRecordedBodyComparator(cnd.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := NewRequest(cnd.Exchange, cnd.RoutingKey, bodyCmp)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := NewResponse(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExpectation(req, res)
+}
+
+// nolint: ireturn
+func newRecordedBodyComparator(body []byte) (BodyComparator, error) {
+	var object map[string]any
+	if err := json.Unmarshal(body, &object); err == nil && object != nil {
+		return comparators.NewJSONBody(body, comparators.MatchTypeExact)
+	}
+
+	return comparators.NewRegex("^" + regexp.QuoteMeta(string(body)) + "$")
+}
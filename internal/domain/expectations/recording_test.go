@@ -0,0 +1,70 @@
+package expectations
+
+import (
+	"testing"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordedExpectation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("json request", func(t *testing.T) {
+		t.Parallel()
+
+		cnd, err := NewCandidate("exchange", "rk", []byte(`{"id":1,"items":[1,2]}`))
+		require.NoError(t, err)
+
+		exp, err := NewRecordedExpectation(cnd, []byte(`{"status":"ok"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "exchange", exp.Request.Exchange)
+		assert.Equal(t, "rk", exp.Request.RoutingKey)
+		require.IsType(t, &comparators.JSONBody{}, exp.Request.BodyComparator)
+		assert.Equal(t, comparators.MatchTypeExact, exp.Request.BodyComparator.(*comparators.JSONBody).MatchType)
+		assert.JSONEq(t, `{"status":"ok"}`, string(exp.Response.Body))
+		assert.Equal(t, uint32(1), exp.Times.RemainingTimes)
+
+		assert.True(t, exp.Matches(cnd))
+
+		other, err := NewCandidate("exchange", "rk", []byte(`{"id":1}`))
+		require.NoError(t, err)
+		assert.False(t, exp.Matches(other))
+	})
+
+	t.Run("non json request", func(t *testing.T) {
+		t.Parallel()
+
+		cnd, err := NewCandidate("exchange", "rk", []byte(`id=1+2`))
+		require.NoError(t, err)
+
+		exp, err := NewRecordedExpectation(cnd, []byte(`{"status":"ok"}`))
+		require.NoError(t, err)
+
+		require.IsType(t, &comparators.Regex{}, exp.Request.BodyComparator)
+		assert.True(t, exp.Matches(cnd))
+
+		other, err := NewCandidate("exchange", "rk", []byte(`id=11+2`))
+		require.NoError(t, err)
+		assert.False(t, exp.Matches(other))
+	})
+
+	t.Run("json array request", func(t *testing.T) {
+		t.Parallel()
+
+		cnd, err := NewCandidate("exchange", "rk", []byte(`[1,2]`))
+		require.NoError(t, err)
+
+		exp, err := NewRecordedExpectation(cnd, []byte(`["ok"]`))
+		require.NoError(t, err)
+
+		require.IsType(t, &comparators.Regex{}, exp.Request.BodyComparator)
+		assert.True(t, exp.Matches(cnd))
+
+		other, err := NewCandidate("exchange", "rk", []byte(`[1,2,3]`))
+		require.NoError(t, err)
+		assert.False(t, exp.Matches(other))
+	})
+}
@@ -2,37 +2,225 @@ package expectations
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 )
 
 type BodyComparator interface {
 	Match(payload []byte) bool
 }
 
+// ValueComparator matches a single header or property value.
+// present reports whether the value exists on the candidate at all.
+type ValueComparator interface {
+	Match(value string, present bool) bool
+}
+
+// ExchangeMatchType represents how the exchange of a candidate is matched.
+type ExchangeMatchType string
+
+const (
+	// ExchangeMatchTypeExact requires the exchange to be equal.
+	ExchangeMatchTypeExact ExchangeMatchType = "EXACT"
+	// ExchangeMatchTypeAny matches messages from any exchange.
+	ExchangeMatchTypeAny ExchangeMatchType = "ANY"
+)
+
+// RoutingKeyMatchType represents how the routing key of a candidate is matched.
+type RoutingKeyMatchType string
+
+const (
+	// RoutingKeyMatchTypeExact requires the routing key to be equal.
+	RoutingKeyMatchTypeExact RoutingKeyMatchType = "EXACT"
+	// RoutingKeyMatchTypeTopic treats the routing key as a topic exchange binding pattern with "*" and "#" wildcards.
+	RoutingKeyMatchTypeTopic RoutingKeyMatchType = "TOPIC"
+)
+
 type Request struct {
-	Exchange       string
-	RoutingKey     string
-	BodyComparator BodyComparator
+	Exchange            string
+	ExchangeMatchType   ExchangeMatchType
+	RoutingKey          string
+	RoutingKeyMatchType RoutingKeyMatchType
+	BodyComparator      BodyComparator
+	HeaderComparators   map[string]ValueComparator
+	PropertyComparators map[string]ValueComparator
+	routingKeyTopic     *comparators.Topic
 }
 
-func NewRequest(exchange, routingKey string, bodyCmp BodyComparator) (*Request, error) {
-	if exchange == "" {
+// RequestOption is a function that configures a Request.
+type RequestOption func(r *Request) error
+
+// WithHeaderComparator adds a matcher for the message header with the given name.
+func WithHeaderComparator(name string, cmp ValueComparator) RequestOption {
+	return func(r *Request) error {
+		if name == "" {
+			return ErrEmptyHeaderName
+		}
+
+		if r.HeaderComparators == nil {
+			r.HeaderComparators = make(map[string]ValueComparator)
+		}
+		r.HeaderComparators[name] = cmp
+
+		return nil
+	}
+}
+
+// WithPropertyComparator adds a matcher for the message property with the given name, e.g. "type" or "app_id".
+func WithPropertyComparator(name string, cmp ValueComparator) RequestOption {
+	return func(r *Request) error {
+		if !IsKnownProperty(name) {
+			return fmt.Errorf("%w: %s", ErrUnknownProperty, name)
+		}
+
+		if r.PropertyComparators == nil {
+			r.PropertyComparators = make(map[string]ValueComparator)
+		}
+		r.PropertyComparators[name] = cmp
+
+		return nil
+	}
+}
+
+// WithAnyExchange makes the request match messages published to any exchange.
+func WithAnyExchange() RequestOption {
+	return func(r *Request) error {
+		r.ExchangeMatchType = ExchangeMatchTypeAny
+		return nil
+	}
+}
+
+// WithTopicRoutingKey makes the request treat its routing key as a topic exchange binding pattern.
+func WithTopicRoutingKey() RequestOption {
+	return func(r *Request) error {
+		r.RoutingKeyMatchType = RoutingKeyMatchTypeTopic
+		return nil
+	}
+}
+
+// NewRequest creates a new Request instance.
+// The exchange may only be empty if the request is configured to match any exchange.
+func NewRequest(exchange, routingKey string, bodyCmp BodyComparator, opts ...RequestOption) (*Request, error) {
+	r := &Request{
+		Exchange:            exchange,
+		ExchangeMatchType:   ExchangeMatchTypeExact,
+		RoutingKey:          routingKey,
+		RoutingKeyMatchType: RoutingKeyMatchTypeExact,
+		BodyComparator:      bodyCmp,
+	}
+
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Exchange == "" && r.ExchangeMatchType != ExchangeMatchTypeAny {
 		return nil, ErrEmptyExchange
 	}
 
-	if routingKey == "" {
+	if r.RoutingKey == "" {
 		return nil, ErrEmptyRoutingKey
 	}
 
-	return &Request{
-		Exchange:       exchange,
-		RoutingKey:     routingKey,
-		BodyComparator: bodyCmp,
-	}, nil
+	if r.RoutingKeyMatchType == RoutingKeyMatchTypeTopic {
+		topic, err := comparators.NewTopic(r.RoutingKey)
+		if err != nil {
+			return nil, err
+		}
+		r.routingKeyTopic = topic
+	}
+
+	return r, nil
 }
 
 func (r *Request) Matches(cnd *Candidate) bool {
-	return r.Exchange == cnd.Exchange && r.RoutingKey == cnd.RoutingKey && r.BodyComparator.Match(cnd.Body)
+	return r.matchesExchange(cnd) &&
+		r.matchesRoutingKey(cnd) &&
+		r.matchesHeaders(cnd) &&
+		r.matchesProperties(cnd) &&
+		r.BodyComparator.Match(cnd.Body)
+}
+
+// Mismatches lists the parts of the candidate that do not match the request, e.g. "routing key" or "header x-tenant".
+// It is empty if the candidate matches.
+func (r *Request) Mismatches(cnd *Candidate) []string {
+	var parts []string
+
+	if !r.matchesExchange(cnd) {
+		parts = append(parts, "exchange")
+	}
+
+	if !r.matchesRoutingKey(cnd) {
+		parts = append(parts, "routing key")
+	}
+
+	for _, name := range sortedKeys(r.HeaderComparators) {
+		if !r.HeaderComparators[name].Match(cnd.Header(name)) {
+			parts = append(parts, "header "+name)
+		}
+	}
+
+	for _, name := range sortedKeys(r.PropertyComparators) {
+		if !r.PropertyComparators[name].Match(cnd.Properties.Get(name)) {
+			parts = append(parts, "property "+name)
+		}
+	}
+
+	if !r.BodyComparator.Match(cnd.Body) {
+		parts = append(parts, "body")
+	}
+
+	return parts
+}
+
+func sortedKeys(m map[string]ValueComparator) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (r *Request) matchesExchange(cnd *Candidate) bool {
+	if r.ExchangeMatchType == ExchangeMatchTypeAny {
+		return true
+	}
+
+	return r.Exchange == cnd.Exchange
+}
+
+func (r *Request) matchesRoutingKey(cnd *Candidate) bool {
+	if r.routingKeyTopic != nil {
+		return r.routingKeyTopic.Match(cnd.RoutingKey)
+	}
+
+	return r.RoutingKey == cnd.RoutingKey
+}
+
+func (r *Request) matchesHeaders(cnd *Candidate) bool {
+	for name, cmp := range r.HeaderComparators {
+		if !cmp.Match(cnd.Header(name)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Request) matchesProperties(cnd *Candidate) bool {
+	for name, cmp := range r.PropertyComparators {
+		if !cmp.Match(cnd.Properties.Get(name)) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (r *Request) FormattedBody(offset int) string {
@@ -101,3 +101,171 @@ func TestRequest_Matches(t *testing.T) {
 		})
 	}
 }
+
+func TestRequest_MatchesHeadersAndProperties(t *testing.T) {
+	t.Parallel()
+	bodyCmp, err := comparators.NewRegex("foo")
+	require.NoError(t, err)
+
+	tenantCmp, err := comparators.NewValue("acme", comparators.ValueMatchTypeExact)
+	require.NoError(t, err)
+
+	traceCmp, err := comparators.NewValue("", comparators.ValueMatchTypeAbsent)
+	require.NoError(t, err)
+
+	typeCmp, err := comparators.NewValue("^invoice\\.", comparators.ValueMatchTypeRegex)
+	require.NoError(t, err)
+
+	req, err := NewRequest("exchange", "rk", bodyCmp,
+		WithHeaderComparator("x-tenant", tenantCmp),
+		WithHeaderComparator("x-trace", traceCmp),
+		WithPropertyComparator(PropertyType, typeCmp),
+	)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		headers map[string]string
+		props   Properties
+		matches bool
+	}{
+		"success": {
+			headers: map[string]string{"x-tenant": "acme"},
+			props:   Properties{Type: "invoice.created"},
+			matches: true,
+		},
+		"header mismatch": {
+			headers: map[string]string{"x-tenant": "other"},
+			props:   Properties{Type: "invoice.created"},
+		},
+		"header missing": {
+			props: Properties{Type: "invoice.created"},
+		},
+		"header must be absent": {
+			headers: map[string]string{"x-tenant": "acme", "x-trace": "1"},
+			props:   Properties{Type: "invoice.created"},
+		},
+		"property mismatch": {
+			headers: map[string]string{"x-tenant": "acme"},
+			props:   Properties{Type: "order.created"},
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cnd, err := NewCandidate("exchange", "rk", []byte("foo"),
+				WithCandidateHeaders(tt.headers),
+				WithCandidateProperties(tt.props),
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.matches, req.Matches(cnd))
+		})
+	}
+}
+
+func TestRequest_Mismatches(t *testing.T) {
+	t.Parallel()
+	bodyCmp, err := comparators.NewRegex("foo")
+	require.NoError(t, err)
+
+	tenantCmp, err := comparators.NewValue("acme", comparators.ValueMatchTypeExact)
+	require.NoError(t, err)
+
+	req, err := NewRequest("exchange", "rk", bodyCmp, WithHeaderComparator("x-tenant", tenantCmp))
+	require.NoError(t, err)
+
+	cnd, err := NewCandidate("exchange", "rk", []byte("foo"), WithCandidateHeaders(map[string]string{"x-tenant": "acme"}))
+	require.NoError(t, err)
+	assert.Empty(t, req.Mismatches(cnd))
+
+	cnd, err = NewCandidate("other", "rk", []byte("bar"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"exchange", "header x-tenant", "body"}, req.Mismatches(cnd))
+}
+
+func TestNewRequest_Options(t *testing.T) {
+	t.Parallel()
+
+	cmp, err := comparators.NewValue("foo", comparators.ValueMatchTypeExact)
+	require.NoError(t, err)
+
+	_, err = NewRequest("exchange", "rk", nil, WithHeaderComparator("", cmp))
+	assert.ErrorIs(t, err, ErrEmptyHeaderName)
+
+	_, err = NewRequest("exchange", "rk", nil, WithPropertyComparator("unknown", cmp))
+	assert.ErrorIs(t, err, ErrUnknownProperty)
+}
+
+func TestRequest_MatchesRoutingModes(t *testing.T) {
+	t.Parallel()
+	bodyCmp, err := comparators.NewRegex("foo")
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		exchange string
+		rk       string
+		opts     []RequestOption
+		cndExc   string
+		cndRK    string
+		matches  bool
+	}{
+		"exact is the default": {
+			exchange: "exchange",
+			rk:       "billing.*.invoice",
+			cndExc:   "exchange",
+			cndRK:    "billing.eu.invoice",
+		},
+		"topic routing key": {
+			exchange: "exchange",
+			rk:       "billing.*.invoice.#",
+			opts:     []RequestOption{WithTopicRoutingKey()},
+			cndExc:   "exchange",
+			cndRK:    "billing.eu.invoice.created",
+			matches:  true,
+		},
+		"topic routing key mismatch": {
+			exchange: "exchange",
+			rk:       "billing.*.invoice.#",
+			opts:     []RequestOption{WithTopicRoutingKey()},
+			cndExc:   "exchange",
+			cndRK:    "billing.invoice",
+		},
+		"topic routing key exchange mismatch": {
+			exchange: "exchange",
+			rk:       "#",
+			opts:     []RequestOption{WithTopicRoutingKey()},
+			cndExc:   "exchange2",
+			cndRK:    "billing.invoice",
+		},
+		"any exchange": {
+			rk:      "rk",
+			opts:    []RequestOption{WithAnyExchange()},
+			cndExc:  "whatever",
+			cndRK:   "rk",
+			matches: true,
+		},
+		"any exchange and topic routing key": {
+			rk:      "billing.#",
+			opts:    []RequestOption{WithAnyExchange(), WithTopicRoutingKey()},
+			cndExc:  "whatever",
+			cndRK:   "billing.eu.invoice",
+			matches: true,
+		},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := NewRequest(tt.exchange, tt.rk, bodyCmp, tt.opts...)
+			require.NoError(t, err)
+
+			cnd, err := NewCandidate(tt.cndExc, tt.cndRK, []byte("foo"))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.matches, req.Matches(cnd))
+		})
+	}
+}
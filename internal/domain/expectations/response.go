@@ -1,12 +1,27 @@
 package expectations
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"strings"
+	"text/template"
 )
 
+// BodyCapturer is implemented by body comparators that can extract capture groups from a payload,
+// so they can be referenced from response templates.
+type BodyCapturer interface {
+	Captures(payload []byte) map[string]string
+}
+
 type Response struct {
 	Body json.RawMessage
+	// Headers are the AMQP headers published with the reply.
+	Headers map[string]string
+	// Template is the source of the response body template, empty for static responses.
+	Template string
+	tmpl     *template.Template
+	capturer BodyCapturer
 }
 
 func NewResponse(body []byte) (*Response, error) {
@@ -15,7 +30,71 @@ func NewResponse(body []byte) (*Response, error) {
 	}, nil
 }
 
+// NewTemplateResponse creates a response whose body is rendered per request from a Go text/template.
+// See TemplateData for the data available to the template and templateFuncs for the helper functions.
+func NewTemplateResponse(tmpl string) (*Response, error) {
+	if tmpl == "" {
+		return nil, ErrEmptyTemplate
+	}
+
+	// a missing key fails the render, rather than writing "<no value>" into the JSON body
+	parsed, err := template.New("response").Option("missingkey=error").Funcs(templateFuncs()).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response template: %w", err)
+	}
+
+	return &Response{
+		Template: tmpl,
+		tmpl:     parsed,
+	}, nil
+}
+
+// IsTemplate reports whether the response body is rendered from a template.
+func (r *Response) IsTemplate() bool {
+	return r.tmpl != nil
+}
+
+// Render returns the response body for the given candidate.
+// Static responses return their body as is, templated responses are rendered against the candidate.
+func (r *Response) Render(cnd *Candidate) ([]byte, error) {
+	if r.tmpl == nil {
+		return r.Body, nil
+	}
+
+	data, err := newTemplateData(cnd, r.capturer)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := r.tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render response template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// withCapturer returns a copy of a templated response bound to the capture groups of a body comparator.
+func (r *Response) withCapturer(cmp BodyComparator) *Response {
+	capturer, ok := cmp.(BodyCapturer)
+	if r == nil || r.tmpl == nil || !ok {
+		return r
+	}
+
+	return &Response{
+		Body:     r.Body,
+		Headers:  r.Headers,
+		Template: r.Template,
+		tmpl:     r.tmpl,
+		capturer: capturer,
+	}
+}
+
 func (r *Response) FormattedBody(offset int) string {
+	if r.tmpl != nil {
+		return r.Template
+	}
+
 	raw, _ := json.MarshalIndent(r.Body, strings.Repeat(" ", offset), "  ")
 	return string(raw)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,3 +31,120 @@ func TestNewResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTemplateResponse(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTemplateResponse(`{"id": {{ json (jsonPath .Body "$.id") }}}`)
+	require.NoError(t, err)
+
+	_, err = NewTemplateResponse(`{"id": {{ .Body.id }`)
+	assert.ErrorContains(t, err, "failed to parse response template")
+
+	_, err = NewTemplateResponse(`{{ unknownFunc }}`)
+	assert.ErrorContains(t, err, "failed to parse response template")
+
+	_, err = NewTemplateResponse("")
+	assert.ErrorIs(t, err, ErrEmptyTemplate)
+}
+
+func TestResponse_Render(t *testing.T) {
+	t.Parallel()
+
+	cnd, err := NewCandidate("exchange", "orders.create", []byte(`{"id": "abc", "items": [{"sku": "x1"}]}`),
+		WithCandidateHeaders(map[string]string{"x-tenant": "acme"}),
+		WithCandidateProperties(Properties{CorrelationID: "corr-1"}),
+	)
+	require.NoError(t, err)
+
+	t.Run("static", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := NewResponse([]byte(`{"ok": true}`))
+		require.NoError(t, err)
+
+		body, err := res.Render(cnd)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"ok": true}`, string(body))
+	})
+
+	t.Run("template", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := NewTemplateResponse(`{` +
+			`"id": {{ json (jsonPath .Body "$.id") }}, ` +
+			`"sku": {{ json (jsonPath .Body "items[0].sku") }}, ` +
+			`"tenant": "{{ index .Headers "x-tenant" }}", ` +
+			`"correlation_id": "{{ .Properties.CorrelationID }}", ` +
+			`"route": "{{ .Exchange }}/{{ .RoutingKey }}", ` +
+			`"request_id": "{{ uuid }}", ` +
+			`"code": "{{ randomString 6 }}", ` +
+			`"n": {{ randomInt 1 2 }}, ` +
+			`"at": "{{ now "2006" }}"` +
+			`}`)
+		require.NoError(t, err)
+
+		body, err := res.Render(cnd)
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "abc", got["id"])
+		assert.Equal(t, "x1", got["sku"])
+		assert.Equal(t, "acme", got["tenant"])
+		assert.Equal(t, "corr-1", got["correlation_id"])
+		assert.Equal(t, "exchange/orders.create", got["route"])
+		assert.Len(t, got["request_id"], 36)
+		assert.Len(t, got["code"], 6)
+		assert.InDelta(t, 1, got["n"], 0)
+		assert.Len(t, got["at"], 4)
+	})
+
+	t.Run("template with regex captures", func(t *testing.T) {
+		t.Parallel()
+
+		cmp, err := comparators.NewRegex(`"id":\s*"(?P<id>[^"]+)"`)
+		require.NoError(t, err)
+
+		req, err := NewRequest("exchange", "orders.create", cmp)
+		require.NoError(t, err)
+
+		res, err := NewTemplateResponse(`{"id": "{{ .Captures.id }}", "first": "{{ index .Captures "1" }}"}`)
+		require.NoError(t, err)
+
+		exp, err := NewExpectation(req, res)
+		require.NoError(t, err)
+
+		body, err := exp.Response.Render(cnd)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id": "abc", "first": "abc"}`, string(body))
+	})
+
+	t.Run("render error", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := NewTemplateResponse(`{{ index .Body.items 5 }}`)
+		require.NoError(t, err)
+
+		_, err = res.Render(cnd)
+		assert.ErrorContains(t, err, "failed to render response template")
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tmpl := range []string{
+			`{"name": "{{ .Body.name }}"}`,
+			`{"tenant": "{{ .Headers.missing }}"}`,
+			`{"name": {{ json (jsonPath .Body "$.name") }}}`,
+			`{"sku": {{ json (jsonPath .Body "items[3].sku") }}}`,
+		} {
+			res, err := NewTemplateResponse(tmpl)
+			require.NoError(t, err)
+
+			body, err := res.Render(cnd)
+			require.Error(t, err, tmpl)
+			assert.Nil(t, body, tmpl)
+		}
+	})
+}
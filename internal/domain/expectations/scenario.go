@@ -0,0 +1,98 @@
+package expectations
+
+import "sort"
+
+// ScenarioStateStarted is the state every scenario starts in.
+const ScenarioStateStarted = "Started"
+
+// Scenario makes an expectation part of a state machine shared by all expectations with the same scenario name.
+// It allows the same request to be answered differently depending on what happened before.
+type Scenario struct {
+	Name string
+	// RequiredState is the state the scenario must be in for the expectation to match, any state if empty.
+	RequiredState string
+	// NewState is the state the scenario moves to when the expectation is matched, unchanged if empty.
+	NewState string
+}
+
+// NewScenario creates a new Scenario instance.
+func NewScenario(name, requiredState, newState string) (*Scenario, error) {
+	if name == "" {
+		return nil, ErrEmptyScenarioName
+	}
+
+	return &Scenario{
+		Name:          name,
+		RequiredState: requiredState,
+		NewState:      newState,
+	}, nil
+}
+
+// Allows reports whether the expectation can match while the scenario is in the given state.
+func (s *Scenario) Allows(state string) bool {
+	return s.RequiredState == "" || s.RequiredState == state
+}
+
+// Scenarios holds the current states of the scenarios.
+// Scenarios without a recorded state are in the ScenarioStateStarted state.
+type Scenarios struct {
+	states map[string]string
+}
+
+// NewScenarios creates a new Scenarios instance.
+func NewScenarios() *Scenarios {
+	return &Scenarios{}
+}
+
+// State returns the current state of a scenario.
+func (s *Scenarios) State(name string) string {
+	if state, ok := s.states[name]; ok {
+		return state
+	}
+
+	return ScenarioStateStarted
+}
+
+// Set moves a scenario to the given state.
+func (s *Scenarios) Set(name, state string) {
+	if s.states == nil {
+		s.states = make(map[string]string)
+	}
+
+	s.states[name] = state
+}
+
+// Reset moves a scenario back to the ScenarioStateStarted state.
+func (s *Scenarios) Reset(name string) {
+	delete(s.states, name)
+}
+
+// Clear moves all scenarios back to the ScenarioStateStarted state.
+func (s *Scenarios) Clear() {
+	s.states = nil
+}
+
+// States returns a copy of the recorded states keyed by scenario name.
+func (s *Scenarios) States() map[string]string {
+	if len(s.states) == 0 {
+		return nil
+	}
+
+	states := make(map[string]string, len(s.states))
+	for name, state := range s.states {
+		states[name] = state
+	}
+
+	return states
+}
+
+// Names returns the sorted names of the scenarios with a recorded state.
+func (s *Scenarios) Names() []string {
+	names := make([]string, 0, len(s.states))
+	for name := range s.states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,44 @@
+package expectations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScenario(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewScenario("", "a", "b")
+	require.ErrorIs(t, err, ErrEmptyScenarioName)
+
+	sc, err := NewScenario("flow", "a", "b")
+	require.NoError(t, err)
+	assert.True(t, sc.Allows("a"))
+	assert.False(t, sc.Allows("b"))
+
+	sc, err = NewScenario("flow", "", "b")
+	require.NoError(t, err)
+	assert.True(t, sc.Allows("anything"))
+}
+
+func TestScenarios(t *testing.T) {
+	t.Parallel()
+
+	s := NewScenarios()
+	assert.Equal(t, ScenarioStateStarted, s.State("flow"))
+	assert.Empty(t, s.Names())
+
+	s.Set("flow", "pending")
+	s.Set("another", "done")
+	assert.Equal(t, "pending", s.State("flow"))
+	assert.Equal(t, []string{"another", "flow"}, s.Names())
+	assert.Equal(t, map[string]string{"flow": "pending", "another": "done"}, s.States())
+
+	s.Reset("flow")
+	assert.Equal(t, ScenarioStateStarted, s.State("flow"))
+
+	s.Clear()
+	assert.Nil(t, s.States())
+}
@@ -0,0 +1,139 @@
+package expectations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SequenceVerification checks that requests matching the steps arrived in the order of the steps.
+// In the strict mode the steps must match consecutive requests, otherwise other requests may arrive in between.
+type SequenceVerification struct {
+	Steps  []*Request
+	Strict bool
+}
+
+// NewSequenceVerification creates a new SequenceVerification instance.
+func NewSequenceVerification(steps []*Request, strict bool) (*SequenceVerification, error) {
+	if len(steps) == 0 {
+		return nil, ErrEmptySequence
+	}
+
+	return &SequenceVerification{
+		Steps:  steps,
+		Strict: strict,
+	}, nil
+}
+
+// SequenceEntry is a received request along with the indexes of the steps it matches.
+type SequenceEntry struct {
+	Assertion *Assertion
+	Steps     []int
+}
+
+// SequenceResult is the outcome of a sequence verification.
+type SequenceResult struct {
+	Passed bool
+	// Actual is the sequence of all received requests, in arrival order.
+	Actual []SequenceEntry
+	// Report describes the outcome, for failures along with the actual sequence.
+	Report string
+}
+
+// Verify checks the assertions, which must be in arrival order, against the steps.
+func (v *SequenceVerification) Verify(assertions []*Assertion) *SequenceResult {
+	actual := make([]SequenceEntry, 0, len(assertions))
+	for _, assertion := range assertions {
+		entry := SequenceEntry{Assertion: assertion}
+		for i, step := range v.Steps {
+			if step.Matches(assertion.Candidate) {
+				entry.Steps = append(entry.Steps, i)
+			}
+		}
+		actual = append(actual, entry)
+	}
+
+	var matched int
+	if v.Strict {
+		matched = v.longestContiguous(actual)
+	} else {
+		matched = v.longestSubsequence(actual)
+	}
+
+	result := &SequenceResult{
+		Passed: matched == len(v.Steps),
+		Actual: actual,
+	}
+
+	mode := "loose"
+	if v.Strict {
+		mode = "strict"
+	}
+
+	if result.Passed {
+		result.Report = fmt.Sprintf("The %d steps were received in order (%s mode).", len(v.Steps), mode)
+		return result
+	}
+
+	report := strings.Builder{}
+	fmt.Fprintf(&report, "Expected the %d steps in order (%s mode), but only the first %d were received in order.", len(v.Steps), mode, matched)
+	fmt.Fprintf(&report, "\nMissing step %d: exchange=%s, routing_key=%s", matched+1, v.Steps[matched].Exchange, v.Steps[matched].RoutingKey)
+
+	if len(actual) == 0 {
+		report.WriteString("\nNo requests were received.")
+	} else {
+		report.WriteString("\nActual sequence:")
+		for i, entry := range actual {
+			fmt.Fprintf(&report, "\n  %d. %s", i+1, formatCandidate(entry.Assertion))
+			if len(entry.Steps) > 0 {
+				fmt.Fprintf(&report, " (step %s)", formatSteps(entry.Steps))
+			}
+		}
+	}
+
+	result.Report = report.String()
+
+	return result
+}
+
+// longestSubsequence returns the number of leading steps found in order, with any requests in between.
+func (v *SequenceVerification) longestSubsequence(actual []SequenceEntry) int {
+	next := 0
+	for _, entry := range actual {
+		if next == len(v.Steps) {
+			break
+		}
+
+		if v.Steps[next].Matches(entry.Assertion.Candidate) {
+			next++
+		}
+	}
+
+	return next
+}
+
+// longestContiguous returns the largest number of leading steps matched by consecutive requests.
+func (v *SequenceVerification) longestContiguous(actual []SequenceEntry) int {
+	longest := 0
+	for start := range actual {
+		n := 0
+		for start+n < len(actual) && n < len(v.Steps) && v.Steps[n].Matches(actual[start+n].Assertion.Candidate) {
+			n++
+		}
+
+		longest = max(longest, n)
+		if longest == len(v.Steps) {
+			break
+		}
+	}
+
+	return longest
+}
+
+func formatSteps(steps []int) string {
+	parts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		parts = append(parts, fmt.Sprintf("%d", step+1))
+	}
+
+	return strings.Join(parts, ", ")
+}
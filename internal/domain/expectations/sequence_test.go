@@ -0,0 +1,99 @@
+package expectations
+
+import (
+	"testing"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSequenceVerification(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSequenceVerification(nil, false)
+	require.ErrorIs(t, err, ErrEmptySequence)
+}
+
+func TestSequenceVerification_Verify(t *testing.T) {
+	t.Parallel()
+
+	bodyCmp, err := comparators.NewRegex(".*")
+	require.NoError(t, err)
+
+	newStep := func(rk string) *Request {
+		req, err := NewRequest("exchange", rk, bodyCmp)
+		require.NoError(t, err)
+		return req
+	}
+
+	newAssertions := func(rks ...string) []*Assertion {
+		list := make([]*Assertion, 0, len(rks))
+		for _, rk := range rks {
+			cnd, err := NewCandidate("exchange", rk, []byte("{}"))
+			require.NoError(t, err)
+			list = append(list, NewUnmatchedAssertion(cnd))
+		}
+		return list
+	}
+
+	steps := []*Request{newStep("inventory.reserve"), newStep("payment.charge"), newStep("shipping.create")}
+
+	testCases := map[string]struct {
+		received []string
+		strict   bool
+		passed   bool
+	}{
+		"loose in order": {
+			received: []string{"inventory.reserve", "audit.log", "payment.charge", "shipping.create"},
+			passed:   true,
+		},
+		"loose out of order": {
+			received: []string{"payment.charge", "inventory.reserve", "shipping.create"},
+		},
+		"loose missing step": {
+			received: []string{"inventory.reserve", "payment.charge"},
+		},
+		"strict contiguous": {
+			received: []string{"audit.log", "inventory.reserve", "payment.charge", "shipping.create"},
+			strict:   true,
+			passed:   true,
+		},
+		"strict with a request in between": {
+			received: []string{"inventory.reserve", "audit.log", "payment.charge", "shipping.create"},
+			strict:   true,
+		},
+		"strict after a partial attempt": {
+			received: []string{"inventory.reserve", "inventory.reserve", "payment.charge", "shipping.create"},
+			strict:   true,
+			passed:   true,
+		},
+		"nothing received": {},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := NewSequenceVerification(steps, tt.strict)
+			require.NoError(t, err)
+
+			result := v.Verify(newAssertions(tt.received...))
+			assert.Equal(t, tt.passed, result.Passed, result.Report)
+			assert.Len(t, result.Actual, len(tt.received))
+		})
+	}
+
+	t.Run("report", func(t *testing.T) {
+		t.Parallel()
+
+		v, err := NewSequenceVerification(steps, true)
+		require.NoError(t, err)
+
+		result := v.Verify(newAssertions("inventory.reserve", "audit.log", "payment.charge"))
+		assert.Equal(t, []int{1}, result.Actual[2].Steps)
+		assert.Contains(t, result.Report, "only the first 1 were received in order")
+		assert.Contains(t, result.Report, "Missing step 2: exchange=exchange, routing_key=payment.charge")
+		assert.Contains(t, result.Report, "routing_key=payment.charge, body={} (step 2)")
+	})
+}
@@ -0,0 +1,117 @@
+package expectations
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TemplateData is the data available to response templates.
+type TemplateData struct {
+	Exchange   string
+	RoutingKey string
+	Headers    map[string]string
+	Properties Properties
+	// Body is the decoded JSON body of the request, nil if the body is not valid JSON.
+	Body any
+	// RawBody is the request body as a string.
+	RawBody string
+	// Captures are the capture groups of the regex body comparator, keyed by index and by name.
+	Captures map[string]string
+}
+
+func newTemplateData(cnd *Candidate, capturer BodyCapturer) (*TemplateData, error) {
+	data := &TemplateData{
+		Exchange:   cnd.Exchange,
+		RoutingKey: cnd.RoutingKey,
+		Headers:    cnd.Headers,
+		Properties: cnd.Properties,
+		RawBody:    string(cnd.Body),
+	}
+
+	if json.Valid(cnd.Body) {
+		if err := json.Unmarshal(cnd.Body, &data.Body); err != nil {
+			return nil, fmt.Errorf("failed to decode request body: %w", err)
+		}
+	}
+
+	if capturer != nil {
+		data.Captures = capturer.Captures(cnd.Body)
+	}
+
+	return data, nil
+}
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// jsonPath returns a value of the decoded body by a path like "$.order.items[0].id" or "order.items.0.id",
+		// it fails the render if there is no value at the path.
+		"jsonPath": jsonPath,
+		// json encodes a value as JSON, use it to embed strings and objects into a JSON response safely.
+		"json": func(v any) (string, error) {
+			raw, err := json.Marshal(v)
+			return string(raw), err
+		},
+		// now returns the current time formatted as RFC3339 or with the given Go layout.
+		"now": func(layout ...string) string {
+			if len(layout) > 0 {
+				return time.Now().Format(layout[0])
+			}
+			return time.Now().Format(time.RFC3339)
+		},
+		"uuid": uuid.NewString,
+		// randomInt returns a random integer in the [min, max) range.
+		"randomInt": func(lower, upper int) int {
+			if upper <= lower {
+				return lower
+			}
+			return lower + rand.IntN(upper-lower) // nolint: gosec
+		},
+		// randomString returns a random alphanumeric string of the given length.
+		"randomString": func(n int) string {
+			b := make([]byte, n)
+			for i := range b {
+				b[i] = letters[rand.IntN(len(letters))] // nolint: gosec
+			}
+			return string(b)
+		},
+	}
+}
+
+func jsonPath(data any, path string) (any, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if trimmed == "" {
+		return data, nil
+	}
+
+	trimmed = strings.NewReplacer("[", ".", "]", "").Replace(trimmed)
+	current := data
+	for _, segment := range strings.Split(trimmed, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			value, ok := v[segment]
+			if !ok {
+				return nil, fmt.Errorf("%w %s", ErrMissingValue, path)
+			}
+			current = value
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, fmt.Errorf("%w %s", ErrMissingValue, path)
+			}
+			current = v[idx]
+		default:
+			return nil, fmt.Errorf("%w %s", ErrMissingValue, path)
+		}
+	}
+
+	return current, nil
+}
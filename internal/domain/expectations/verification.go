@@ -0,0 +1,242 @@
+package expectations
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// closestCandidates is the number of requests listed in the report of a failed verification.
+const closestCandidates = 3
+
+// VerificationTimes is the number of times a verification expects the requests to arrive.
+// AtMost is nil if there is no upper bound.
+type VerificationTimes struct {
+	AtLeast uint32
+	AtMost  *uint32
+}
+
+// NewVerificationTimes creates VerificationTimes from the optional exactly, at least and at most bounds.
+// Exactly cannot be combined with the other bounds, without any bound the requests are expected at least once.
+func NewVerificationTimes(exactly, atLeast, atMost *uint32) (VerificationTimes, error) {
+	if exactly != nil {
+		if atLeast != nil || atMost != nil {
+			return VerificationTimes{}, ErrBadVerificationTimes
+		}
+
+		return VerificationTimes{AtLeast: *exactly, AtMost: exactly}, nil
+	}
+
+	if atLeast == nil && atMost == nil {
+		return VerificationTimes{AtLeast: 1}, nil
+	}
+
+	t := VerificationTimes{AtMost: atMost}
+	if atLeast != nil {
+		t.AtLeast = *atLeast
+	}
+
+	if t.AtMost != nil && *t.AtMost < t.AtLeast {
+		return VerificationTimes{}, ErrBadVerificationTimes
+	}
+
+	return t, nil
+}
+
+// Allows reports whether the count is within the bounds.
+func (t VerificationTimes) Allows(count int) bool {
+	if count < int(t.AtLeast) {
+		return false
+	}
+
+	return t.AtMost == nil || count <= int(*t.AtMost)
+}
+
+func (t VerificationTimes) String() string {
+	switch {
+	case t.AtMost != nil && *t.AtMost == 0:
+		return "never"
+	case t.AtMost != nil && *t.AtMost == t.AtLeast:
+		return "exactly " + formatTimes(int(t.AtLeast))
+	case t.AtMost == nil:
+		return "at least " + formatTimes(int(t.AtLeast))
+	case t.AtLeast == 0:
+		return "at most " + formatTimes(int(*t.AtMost))
+	default:
+		return fmt.Sprintf("between %d and %d times", t.AtLeast, *t.AtMost)
+	}
+}
+
+func formatTimes(n int) string {
+	if n == 1 {
+		return "1 time"
+	}
+
+	return fmt.Sprintf("%d times", n)
+}
+
+// Verification checks how many times an expectation was matched,
+// or how many requests matching a request spec arrived, regardless of the expectation they matched.
+type Verification struct {
+	Expectation *Expectation
+	Request     *Request
+	Times       VerificationTimes
+}
+
+// NewExpectationVerification creates a verification of the number of times the expectation was matched.
+func NewExpectationVerification(exp *Expectation, times VerificationTimes) *Verification {
+	return &Verification{
+		Expectation: exp,
+		Times:       times,
+	}
+}
+
+// NewRequestVerification creates a verification of the number of requests matching the request spec.
+// Requests are matched exactly the way expectations match them.
+func NewRequestVerification(req *Request, times VerificationTimes) *Verification {
+	return &Verification{
+		Request: req,
+		Times:   times,
+	}
+}
+
+// VerificationResult is the outcome of a verification.
+type VerificationResult struct {
+	Verification *Verification
+	Passed       bool
+	Count        int
+	// Report describes the outcome, for failures along with the closest actual requests.
+	Report string
+}
+
+// Verify counts the assertions the verification applies to and checks the count against its times.
+func (v *Verification) Verify(assertions []*Assertion) *VerificationResult {
+	var counted, others []*Assertion
+	for _, assertion := range assertions {
+		if v.counts(assertion) {
+			counted = append(counted, assertion)
+		} else {
+			others = append(others, assertion)
+		}
+	}
+
+	result := &VerificationResult{
+		Verification: v,
+		Count:        len(counted),
+		Passed:       v.Times.Allows(len(counted)),
+	}
+
+	report := strings.Builder{}
+	if result.Passed {
+		fmt.Fprintf(&report, "%s was %s %s, as expected.", v.subject(), v.verb(), formatTimes(result.Count))
+		result.Report = report.String()
+
+		return result
+	}
+
+	expected := fmt.Sprintf("to be %s %s", v.verb(), v.Times)
+	if v.Times.AtMost != nil && *v.Times.AtMost == 0 {
+		expected = "to never be " + v.verb()
+	}
+
+	fmt.Fprintf(&report, "%s was expected %s, but was %s %s.", v.subject(), expected, v.verb(), formatTimes(result.Count))
+
+	if result.Count < int(v.Times.AtLeast) {
+		v.reportClosest(&report, others)
+	} else {
+		v.reportCounted(&report, counted)
+	}
+
+	result.Report = report.String()
+
+	return result
+}
+
+func (v *Verification) counts(assertion *Assertion) bool {
+	if v.Expectation != nil {
+		return assertion.Expectation != nil && assertion.Expectation.ID == v.Expectation.ID
+	}
+
+	return v.Request.Matches(assertion.Candidate)
+}
+
+func (v *Verification) request() *Request {
+	if v.Expectation != nil {
+		return v.Expectation.Request
+	}
+
+	return v.Request
+}
+
+func (v *Verification) subject() string {
+	req := v.request()
+	if v.Expectation != nil {
+		return fmt.Sprintf("Expectation %s (exchange=%s, routing_key=%s)", v.Expectation.ID, req.Exchange, req.RoutingKey)
+	}
+
+	return fmt.Sprintf("Request (exchange=%s, routing_key=%s)", req.Exchange, req.RoutingKey)
+}
+
+func (v *Verification) verb() string {
+	if v.Expectation != nil {
+		return "matched"
+	}
+
+	return "received"
+}
+
+// reportClosest lists the requests that differ from the request spec in the fewest parts.
+func (v *Verification) reportClosest(report *strings.Builder, others []*Assertion) {
+	type closest struct {
+		assertion  *Assertion
+		mismatches []string
+	}
+
+	list := make([]closest, 0, len(others))
+	for _, assertion := range others {
+		list = append(list, closest{assertion: assertion, mismatches: v.request().Mismatches(assertion.Candidate)})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return len(list[i].mismatches) < len(list[j].mismatches)
+	})
+
+	if len(list) == 0 {
+		report.WriteString("\nNo other requests were received.")
+		return
+	}
+
+	report.WriteString("\nClosest requests:")
+	for i, c := range list[:min(len(list), closestCandidates)] {
+		fmt.Fprintf(report, "\n  %d. %s", i+1, formatCandidate(c.assertion))
+		if len(c.mismatches) == 0 {
+			report.WriteString("\n     matches, but was matched by another expectation")
+		} else {
+			fmt.Fprintf(report, "\n     differs in: %s", strings.Join(c.mismatches, ", "))
+		}
+	}
+}
+
+// reportCounted lists the requests that were counted, when there are too many of them.
+func (v *Verification) reportCounted(report *strings.Builder, counted []*Assertion) {
+	report.WriteString("\nCounted requests:")
+	for i, assertion := range counted[:min(len(counted), closestCandidates)] {
+		fmt.Fprintf(report, "\n  %d. %s", i+1, formatCandidate(assertion))
+	}
+
+	if len(counted) > closestCandidates {
+		fmt.Fprintf(report, "\n  ... and %d more", len(counted)-closestCandidates)
+	}
+}
+
+func formatCandidate(assertion *Assertion) string {
+	cnd := assertion.Candidate
+	line := fmt.Sprintf("at %s exchange=%s, routing_key=%s", assertion.CreatedAt.Format("15:04:05.000"), cnd.Exchange, cnd.RoutingKey)
+
+	body := string(cnd.Body)
+	if len(body) > 200 {
+		body = body[:200] + "..."
+	}
+
+	return line + ", body=" + body
+}
@@ -0,0 +1,140 @@
+package expectations
+
+import (
+	"testing"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVerificationTimes(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(v uint32) *uint32 { return &v }
+
+	testCases := map[string]struct {
+		exactly, atLeast, atMost *uint32
+		expString                string
+		expError                 error
+		allowed, denied          []int
+	}{
+		"default":                   {expString: "at least 1 time", allowed: []int{1, 5}, denied: []int{0}},
+		"exactly":                   {exactly: ptr(2), expString: "exactly 2 times", allowed: []int{2}, denied: []int{1, 3}},
+		"never":                     {exactly: ptr(0), expString: "never", allowed: []int{0}, denied: []int{1}},
+		"at least":                  {atLeast: ptr(2), expString: "at least 2 times", allowed: []int{2, 3}, denied: []int{1}},
+		"at most":                   {atMost: ptr(1), expString: "at most 1 time", allowed: []int{0, 1}, denied: []int{2}},
+		"between":                   {atLeast: ptr(1), atMost: ptr(3), expString: "between 1 and 3 times", allowed: []int{1, 3}, denied: []int{0, 4}},
+		"exactly with other bounds": {exactly: ptr(1), atLeast: ptr(1), expError: ErrBadVerificationTimes},
+		"at most below at least":    {atLeast: ptr(2), atMost: ptr(1), expError: ErrBadVerificationTimes},
+	}
+
+	for name, tt := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			times, err := NewVerificationTimes(tt.exactly, tt.atLeast, tt.atMost)
+			if tt.expError != nil {
+				require.ErrorIs(t, err, tt.expError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expString, times.String())
+			for _, n := range tt.allowed {
+				assert.True(t, times.Allows(n), n)
+			}
+			for _, n := range tt.denied {
+				assert.False(t, times.Allows(n), n)
+			}
+		})
+	}
+}
+
+func TestVerification_Verify(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(rk, regex string) *Request {
+		bodyCmp, err := comparators.NewRegex(regex)
+		require.NoError(t, err)
+
+		req, err := NewRequest("exchange", rk, bodyCmp)
+		require.NoError(t, err)
+
+		return req
+	}
+
+	newAssertion := func(rk, body string, exp *Expectation) *Assertion {
+		cnd, err := NewCandidate("exchange", rk, []byte(body))
+		require.NoError(t, err)
+
+		if exp == nil {
+			return NewUnmatchedAssertion(cnd)
+		}
+
+		return NewMatchedAssertion(cnd, exp)
+	}
+
+	res, err := NewResponse([]byte(`{}`))
+	require.NoError(t, err)
+
+	exp, err := NewExpectation(newRequest("order.get", "foo"), res)
+	require.NoError(t, err)
+
+	assertions := []*Assertion{
+		newAssertion("order.get", "foo", exp),
+		newAssertion("order.get", "bar", nil),
+		newAssertion("order.create", "foo", nil),
+	}
+
+	exactly := func(n uint32) VerificationTimes {
+		times, err := NewVerificationTimes(&n, nil, nil)
+		require.NoError(t, err)
+		return times
+	}
+
+	t.Run("expectation passes", func(t *testing.T) {
+		t.Parallel()
+
+		result := NewExpectationVerification(exp, exactly(1)).Verify(assertions)
+		assert.True(t, result.Passed)
+		assert.Equal(t, 1, result.Count)
+	})
+
+	t.Run("expectation fails with the closest requests", func(t *testing.T) {
+		t.Parallel()
+
+		result := NewExpectationVerification(exp, exactly(2)).Verify(assertions)
+		assert.False(t, result.Passed)
+		assert.Equal(t, 1, result.Count)
+		assert.Contains(t, result.Report, "was expected to be matched exactly 2 times, but was matched 1 time")
+		assert.Contains(t, result.Report, "1. at ")
+		assert.Contains(t, result.Report, "routing_key=order.get, body=bar\n     differs in: body")
+		assert.Contains(t, result.Report, "routing_key=order.create, body=foo\n     differs in: routing key")
+	})
+
+	t.Run("request counts all matching requests", func(t *testing.T) {
+		t.Parallel()
+
+		result := NewRequestVerification(newRequest("order.get", ".*"), exactly(2)).Verify(assertions)
+		assert.True(t, result.Passed)
+		assert.Equal(t, 2, result.Count)
+	})
+
+	t.Run("request that should never arrive lists the counted requests", func(t *testing.T) {
+		t.Parallel()
+
+		result := NewRequestVerification(newRequest("order.create", ".*"), exactly(0)).Verify(assertions)
+		assert.False(t, result.Passed)
+		assert.Contains(t, result.Report, "was expected to never be received, but was received 1 time")
+		assert.Contains(t, result.Report, "Counted requests:")
+	})
+
+	t.Run("no requests", func(t *testing.T) {
+		t.Parallel()
+
+		result := NewRequestVerification(newRequest("order.get", ".*"), exactly(1)).Verify(nil)
+		assert.False(t, result.Passed)
+		assert.Contains(t, result.Report, "No other requests were received.")
+	})
+}
@@ -0,0 +1,51 @@
+package subscriptions
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownFallbackPolicy = errors.New("unknown fallback policy")
+
+// FallbackPolicy represents what the mockserver does with a request that does not match any expectation.
+type FallbackPolicy string
+
+const (
+	// FallbackPolicyReply publishes the default response and acknowledges the request.
+	FallbackPolicyReply FallbackPolicy = "REPLY"
+	// FallbackPolicyDrop acknowledges the request without publishing any reply, so the client runs into its timeout.
+	FallbackPolicyDrop FallbackPolicy = "DROP"
+	// FallbackPolicyReject negatively acknowledges the request without requeueing it.
+	// The request is dead-lettered if the queue has a dead letter exchange configured, otherwise it is discarded.
+	FallbackPolicyReject FallbackPolicy = "REJECT"
+	// FallbackPolicyRequeue negatively acknowledges the request and puts it back to the queue.
+	FallbackPolicyRequeue FallbackPolicy = "REQUEUE"
+	// FallbackPolicyProxy forwards the request to the real service and relays its reply to the caller.
+	FallbackPolicyProxy FallbackPolicy = "PROXY"
+)
+
+// ProxyTarget is where unmatched requests are forwarded to with the proxy policy.
+type ProxyTarget struct {
+	Exchange string
+	// RoutingKey is the routing key used for forwarded requests, empty to keep the routing key of the request.
+	RoutingKey string
+}
+
+// RoutingKeyFor returns the routing key a request published with the given routing key is forwarded with.
+func (t *ProxyTarget) RoutingKeyFor(routingKey string) string {
+	if t.RoutingKey != "" {
+		return t.RoutingKey
+	}
+
+	return routingKey
+}
+
+// ParseFallbackPolicy converts a string to a FallbackPolicy.
+func ParseFallbackPolicy(s string) (FallbackPolicy, error) {
+	switch p := FallbackPolicy(s); p {
+	case FallbackPolicyReply, FallbackPolicyDrop, FallbackPolicyReject, FallbackPolicyRequeue, FallbackPolicyProxy:
+		return p, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFallbackPolicy, s)
+	}
+}
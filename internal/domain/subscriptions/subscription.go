@@ -3,15 +3,63 @@ package subscriptions
 import "github.com/google/uuid"
 
 type Subscription struct {
-	id    uuid.UUID
-	queue string
+	id             uuid.UUID
+	queue          string
+	fallbackPolicy FallbackPolicy
+	proxyTarget    *ProxyTarget
+	namespace      string
+	sessionID      uuid.UUID
 }
 
-func NewSubscription(queue string) *Subscription {
-	return &Subscription{
+// Option is a function that configures a Subscription.
+type Option func(s *Subscription)
+
+// WithFallbackPolicy overrides the global fallback policy for unmatched requests received by the subscription.
+func WithFallbackPolicy(p FallbackPolicy) Option {
+	return func(s *Subscription) {
+		s.fallbackPolicy = p
+	}
+}
+
+// WithProxyTarget overrides the global proxy target for unmatched requests received by the subscription.
+func WithProxyTarget(t *ProxyTarget) Option {
+	return func(s *Subscription) {
+		s.proxyTarget = t
+	}
+}
+
+// WithNamespace binds the requests received by the subscription to a namespace.
+func WithNamespace(namespace string) Option {
+	return func(s *Subscription) {
+		s.namespace = namespace
+	}
+}
+
+// WithSessionID makes the subscription owned by a session, it is removed when the session ends.
+func WithSessionID(id uuid.UUID) Option {
+	return func(s *Subscription) {
+		s.sessionID = id
+	}
+}
+
+// WithID sets the ID of the subscription, e.g. when it is restored from a snapshot.
+func WithID(id uuid.UUID) Option {
+	return func(s *Subscription) {
+		s.id = id
+	}
+}
+
+func NewSubscription(queue string, opts ...Option) *Subscription {
+	s := &Subscription{
 		id:    uuid.New(),
 		queue: queue,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *Subscription) ID() uuid.UUID {
@@ -21,3 +69,23 @@ func (s *Subscription) ID() uuid.UUID {
 func (s *Subscription) Queue() string {
 	return s.queue
 }
+
+// FallbackPolicy returns the fallback policy override of the subscription, empty if the global policy applies.
+func (s *Subscription) FallbackPolicy() FallbackPolicy {
+	return s.fallbackPolicy
+}
+
+// ProxyTarget returns the proxy target override of the subscription, nil if the global target applies.
+func (s *Subscription) ProxyTarget() *ProxyTarget {
+	return s.proxyTarget
+}
+
+// Namespace returns the namespace the requests received by the subscription belong to, empty for the default one.
+func (s *Subscription) Namespace() string {
+	return s.namespace
+}
+
+// SessionID returns the ID of the session owning the subscription, nil if there is none.
+func (s *Subscription) SessionID() uuid.UUID {
+	return s.sessionID
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"sync"
 
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	gocoreamqp "github.com/dialecticanet-com/rmq-rpc-mockserver/lib/amqp"
@@ -13,27 +14,100 @@ import (
 )
 
 // Matcher is an interface for matching expectations against candidates.
+// Match returns the matched expectation or nil if there is no match.
+// RecordProxy attaches the outcome of forwarding an unmatched candidate to the real service.
+// RecordReply attaches the reply published to the caller of the candidate.
 type Matcher interface {
-	Match(candidate *expectations.Candidate) *expectations.Response
+	Match(candidate *expectations.Candidate) *expectations.Expectation
+	RecordProxy(candidate *expectations.Candidate, result *expectations.ProxyResult)
+	RecordReply(candidate *expectations.Candidate, reply *expectations.Reply)
 }
 
+// Fallback is an interface for deciding what happens to requests that match no expectation.
+type Fallback interface {
+	Resolve(sub *subscriptions.Subscription) *app.FallbackDecision
+}
+
+// Forwarder is an interface for calling the real service, it is implemented by the RPC client.
+type Forwarder interface {
+	Call(ctx context.Context, exchange, routingKey string, body []byte, opts ...gocoreamqp.RPCCallOptionFunc) ([]byte, error)
+}
+
+// Recorder is an interface for capturing real traffic while the record mode is on.
+// Target returns where requests are forwarded to, or nil if the recording is off.
+type Recorder interface {
+	Target() *subscriptions.ProxyTarget
+	Record(candidate *expectations.Candidate, response []byte) error
+}
+
+// ConsumerOption is a function that configures a Consumer.
+type ConsumerOption func(c *Consumer)
+
+// WithRecorder enables the record mode, in which every request is forwarded to the real service and captured.
+func WithRecorder(r Recorder) ConsumerOption {
+	return func(c *Consumer) {
+		c.recorder = r
+	}
+}
+
+// WithForwarder sets the client used to forward requests to the real service in the proxy and record modes.
+func WithForwarder(f Forwarder) ConsumerOption {
+	return func(c *Consumer) {
+		c.forwarder = f
+	}
+}
+
+// WithPrefetchCount sets how many messages of a queue can be unacknowledged at once,
+// which bounds the replies waiting for their delay or for the real service. Non-positive counts are ignored,
+// since RabbitMQ reads zero as no limit.
+func WithPrefetchCount(n int) ConsumerOption {
+	return func(c *Consumer) {
+		if n > 0 {
+			c.prefetchCount = n
+		}
+	}
+}
+
+// defaultPrefetchCount is the prefetch count of the listeners when WithPrefetchCount is not used.
+const defaultPrefetchCount = 100
+
 // Consumer is a message consumer for AMQP messages.
 type Consumer struct {
-	m            sync.RWMutex
-	conn         *gocoreamqp.Connection
-	matcher      Matcher
-	waitingGroup *sync.WaitGroup
-	listeners    map[uuid.UUID]*amqpListener
+	m             sync.RWMutex
+	conn          *gocoreamqp.Connection
+	matcher       Matcher
+	fallback      Fallback
+	forwarder     Forwarder
+	recorder      Recorder
+	prefetchCount int
+	waitingGroup  *sync.WaitGroup
+	listeners     map[uuid.UUID]*amqpListener
 }
 
 // NewConsumer creates a new AMQP consumer for a list of queues.
-func NewConsumer(con *gocoreamqp.Connection, matcher Matcher) (*Consumer, error) {
-	return &Consumer{
-		conn:         con,
-		matcher:      matcher,
-		listeners:    make(map[uuid.UUID]*amqpListener),
-		waitingGroup: &sync.WaitGroup{},
-	}, nil
+func NewConsumer(con *gocoreamqp.Connection, matcher Matcher, fallback Fallback, opts ...ConsumerOption) (*Consumer, error) {
+	c := &Consumer{
+		conn:          con,
+		matcher:       matcher,
+		fallback:      fallback,
+		prefetchCount: defaultPrefetchCount,
+		listeners:     make(map[uuid.UUID]*amqpListener),
+		waitingGroup:  &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.forwarder == nil {
+		rpcClient, err := gocoreamqp.NewRPCClient(con)
+		if err != nil {
+			return nil, fmt.Errorf("creating rpc client: %w", err)
+		}
+		c.forwarder = rpcClient
+	}
+
+	return c, nil
 }
 
 // Run starts the consumer and processes incoming messages.
@@ -51,7 +125,7 @@ func (c *Consumer) Run(ctx context.Context) error {
 
 // Subscribe subscribes to a queue.
 func (c *Consumer) Subscribe(sub *subscriptions.Subscription) error {
-	lst, err := startListener(c.waitingGroup, c.conn.Connection(), sub, c.matcher)
+	lst, err := startListener(c.waitingGroup, c.conn.Connection(), sub, c)
 	if err != nil {
 		return fmt.Errorf("starting amqp listener for queue %s: %w", sub.Queue(), err)
 	}
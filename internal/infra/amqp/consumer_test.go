@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	gocoreamqp "github.com/dialecticanet-com/rmq-rpc-mockserver/lib/amqp"
@@ -68,7 +71,7 @@ func TestConsumer(t *testing.T) {
 	ctx, cnl := context.WithCancel(context.Background())
 	defer cnl()
 
-	cns, err := NewConsumer(rmqCon, &testMatcher{t: t})
+	cns, err := NewConsumer(rmqCon, &testMatcher{t: t}, app.NewFallbackService(subscriptions.FallbackPolicyReply))
 	require.NoError(t, err)
 
 	go func() {
@@ -124,17 +127,129 @@ func TestConsumer(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 }
 
+// TestListener_MatchesInOrder tests that back-to-back deliveries are matched in their order of arrival,
+// so that they move a scenario from one state to the next
+func TestListener_MatchesInOrder(t *testing.T) {
+	scenario := func(required, next string) expectations.ExpectationOption {
+		sc, err := expectations.NewScenario("order", required, next)
+		require.NoError(t, err)
+		return expectations.WithScenario(sc)
+	}
+
+	expSvc := app.NewExpectationsService()
+	for _, opt := range []expectations.ExpectationOption{
+		scenario(expectations.ScenarioStateStarted, "pending"),
+		scenario("pending", "done"),
+	} {
+		cmp, err := comparators.NewRegex(".*")
+		require.NoError(t, err)
+		req, err := expectations.NewRequest(testExchange, "rk", cmp)
+		require.NoError(t, err)
+		res, err := expectations.NewResponse([]byte(`{}`))
+		require.NoError(t, err)
+		exp, err := expectations.NewExpectation(req, res, opt, expectations.WithDropReply())
+		require.NoError(t, err)
+		require.NoError(t, expSvc.Create(exp))
+	}
+
+	deliveries := make(chan amqp.Delivery, 2)
+	lst := &amqpListener{
+		subscription: subscriptions.NewSubscription("queue"),
+		deliveries:   deliveries,
+		matcher:      expSvc,
+		fallback:     app.NewFallbackService(subscriptions.FallbackPolicyDrop),
+		done:         make(chan struct{}),
+	}
+
+	acks := &testAcknowledger{}
+	for i := range 2 {
+		deliveries <- amqp.Delivery{Acknowledger: acks, DeliveryTag: uint64(i + 1), Exchange: testExchange, RoutingKey: "rk"}
+	}
+	close(deliveries)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	lst.listen(&wg)
+
+	assert.Equal(t, "done", expSvc.GetScenario("order").State)
+	assert.Equal(t, []uint64{1, 2}, acks.acked)
+}
+
+// TestListener_InvalidMessages tests that the messages which cannot be matched, like the ones published through
+// the default exchange, do not use up the prefetch count of the queue
+func TestListener_InvalidMessages(t *testing.T) {
+	ctx, cnl := context.WithCancel(context.Background())
+	defer cnl()
+
+	const prefetchCount = 2
+	cns, err := NewConsumer(rmqCon, &testMatcher{t: t}, app.NewFallbackService(subscriptions.FallbackPolicyReply),
+		WithPrefetchCount(prefetchCount))
+	require.NoError(t, err)
+
+	go func() {
+		err := cns.Run(ctx)
+		assert.NoError(t, err)
+	}()
+
+	queue, rk := createRandomQueue(t)
+	require.NoError(t, cns.Subscribe(subscriptions.NewSubscription(queue)))
+
+	// the default exchange routes the messages to the queue of the same name, the candidates have no exchange
+	for range prefetchCount + 1 {
+		err := rmqChannel.PublishWithContext(ctx, "", queue, false, false, amqp.Publishing{Body: []byte("invalid")})
+		require.NoError(t, err)
+	}
+
+	rpcClient, err := gocoreamqp.NewRPCClient(rmqCon)
+	require.NoError(t, err)
+
+	callCtx, callCnl := context.WithTimeout(ctx, 5*time.Second)
+	defer callCnl()
+
+	resp, err := rpcClient.Call(callCtx, testExchange, rk, []byte("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, "foo_bar", string(resp))
+
+	// graceful shutdown
+	cnl()
+	time.Sleep(100 * time.Millisecond)
+}
+
+// testAcknowledger records the acknowledged delivery tags.
+type testAcknowledger struct {
+	m     sync.Mutex
+	acked []uint64
+}
+
+func (a *testAcknowledger) Ack(tag uint64, _ bool) error {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.acked = append(a.acked, tag)
+	return nil
+}
+
+func (a *testAcknowledger) Nack(_ uint64, _ bool, _ bool) error { return nil }
+
+func (a *testAcknowledger) Reject(_ uint64, _ bool) error { return nil }
+
 type testMatcher struct {
 	t      *testing.T
 	called atomic.Uint32
 }
 
-func (m *testMatcher) Match(candidate *expectations.Candidate) *expectations.Response {
+func (m *testMatcher) Match(candidate *expectations.Candidate) *expectations.Expectation {
 	m.t.Log("matching candidate", "body", string(candidate.Body), "rk", candidate.RoutingKey, "exchange", candidate.Exchange)
 	m.called.Add(1)
-	return &expectations.Response{Body: []byte(string(candidate.Body) + "_bar")}
+	return &expectations.Expectation{
+		Response: &expectations.Response{Body: []byte(string(candidate.Body) + "_bar")},
+		Action:   expectations.ActionReply,
+	}
 }
 
+func (m *testMatcher) RecordProxy(_ *expectations.Candidate, _ *expectations.ProxyResult) {}
+
+func (m *testMatcher) RecordReply(_ *expectations.Candidate, _ *expectations.Reply) {}
+
 // createRandomQueue creates a random queue and binds it to the test exchange
 // and returns the queue name and routing key.
 func createRandomQueue(t *testing.T) (queue string, routingKey string) {
@@ -1,12 +1,18 @@
 package amqp
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	gocoreamqp "github.com/dialecticanet-com/rmq-rpc-mockserver/lib/amqp"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
@@ -15,14 +21,26 @@ type amqpListener struct {
 	channel      *amqp.Channel
 	deliveries   <-chan amqp.Delivery
 	matcher      Matcher
+	fallback     Fallback
+	forwarder    Forwarder
+	recorder     Recorder
+	inFlight     sync.WaitGroup
+	done         chan struct{}
+	stopOnce     sync.Once
 }
 
-func startListener(wg *sync.WaitGroup, con *amqp.Connection, sub *subscriptions.Subscription, matcher Matcher) (*amqpListener, error) {
+func startListener(wg *sync.WaitGroup, con *amqp.Connection, sub *subscriptions.Subscription, cns *Consumer) (*amqpListener, error) {
 	ch, err := con.Channel()
 	if err != nil {
 		return nil, err
 	}
 
+	// the replies waiting for their delay or for the real service keep their deliveries unacknowledged,
+	// so the prefetch count bounds how many of them are pending at once
+	if err := ch.Qos(cns.prefetchCount, 0, false); err != nil {
+		return nil, err
+	}
+
 	deliveries, err := ch.Consume(sub.Queue(), "", false, false, false, false, nil)
 	if err != nil {
 		return nil, err
@@ -32,7 +50,11 @@ func startListener(wg *sync.WaitGroup, con *amqp.Connection, sub *subscriptions.
 		subscription: sub,
 		channel:      ch,
 		deliveries:   deliveries,
-		matcher:      matcher,
+		matcher:      cns.matcher,
+		fallback:     cns.fallback,
+		forwarder:    cns.forwarder,
+		recorder:     cns.recorder,
+		done:         make(chan struct{}),
 	}
 
 	wg.Add(1)
@@ -42,6 +64,9 @@ func startListener(wg *sync.WaitGroup, con *amqp.Connection, sub *subscriptions.
 }
 
 func (c *amqpListener) stop() error {
+	// abort replies that are still waiting for their delay
+	c.stopOnce.Do(func() { close(c.done) })
+
 	err := c.channel.Close()
 
 	// ignore if the connection is already closed
@@ -59,32 +84,315 @@ func (c *amqpListener) listen(wg *sync.WaitGroup) {
 	for {
 		delivery, consuming := <-c.deliveries
 		if !consuming {
+			c.inFlight.Wait()
 			slog.Info("AMQP listener stopped", "queue", c.subscription.Queue())
 			return
 		}
+
 		c.handleMessage(delivery)
 	}
 }
 
+// handleMessage matches the message before the next delivery is handled, so that requests use up expectations
+// and move scenarios in their order of arrival. Only the replies that wait, for their delay or for the real service,
+// are sent in the background, so they do not hold back the following deliveries.
 func (c *amqpListener) handleMessage(delivery amqp.Delivery) {
-	candidate, err := expectations.NewCandidate(delivery.Exchange, delivery.RoutingKey, delivery.Body)
+	headers := newCandidateHeaders(delivery.Headers)
+	candidate, err := expectations.NewCandidate(delivery.Exchange, delivery.RoutingKey, delivery.Body,
+		expectations.WithCandidateHeaders(headers),
+		expectations.WithCandidateProperties(newCandidateProperties(delivery)),
+		expectations.WithCandidateQueue(c.subscription.Queue()),
+		expectations.WithCandidateSubscriptionID(c.subscription.ID()),
+		expectations.WithCandidateNamespace(c.namespace(headers)),
+		expectations.WithCandidateDelivery(expectations.Delivery{
+			ConsumerTag: delivery.ConsumerTag,
+			DeliveryTag: delivery.DeliveryTag,
+			Redelivered: delivery.Redelivered,
+			ReceivedAt:  time.Now(),
+		}),
+	)
 	if err != nil {
 		slog.Error("failed to create candidate", "error", err)
+		// a message left unacknowledged would hold one of the prefetched messages of the queue for good
+		c.nack(delivery, false)
+		return
+	}
+
+	if c.recorder != nil {
+		if target := c.recorder.Target(); target != nil {
+			c.background(func() {
+				c.record(delivery, candidate, target)
+				c.ack(delivery)
+			})
+			return
+		}
+	}
+
+	exp := c.matcher.Match(candidate)
+	if exp == nil {
+		c.handleUnmatched(delivery, candidate)
+		return
+	}
+
+	if exp.Action == expectations.ActionDrop {
+		c.ack(delivery)
+		return
+	}
+
+	delay := exp.Delay.Duration()
+	if delay <= 0 {
+		c.reply(delivery, candidate, exp.Response)
+		c.ack(delivery)
+		return
+	}
+
+	c.background(func() {
+		if !c.wait(delay) {
+			// the listener is stopping, the message will be redelivered since it was not acknowledged
+			return
+		}
+
+		c.reply(delivery, candidate, exp.Response)
+		c.ack(delivery)
+	})
+}
+
+// background runs the rest of the handling of a message in its own goroutine, the listener waits for it when stopping.
+func (c *amqpListener) background(handle func()) {
+	c.inFlight.Add(1)
+	go func() {
+		defer c.inFlight.Done()
+		handle()
+	}()
+}
+
+// namespace returns the namespace selected by the header of the message, or else the one of the subscription.
+func (c *amqpListener) namespace(headers map[string]string) string {
+	namespace, ok := headers[app.NamespaceHeader]
+	if !ok {
+		return c.subscription.Namespace()
+	}
+
+	if err := app.ValidateNamespace(namespace); err != nil {
+		slog.Warn("ignoring the namespace header of the message", "queue", c.subscription.Queue(), "error", err)
+		return c.subscription.Namespace()
+	}
+
+	return namespace
+}
+
+func (c *amqpListener) handleUnmatched(delivery amqp.Delivery, candidate *expectations.Candidate) {
+	decision := c.fallback.Resolve(c.subscription)
+
+	switch decision.Policy {
+	case subscriptions.FallbackPolicyDrop:
+		c.ack(delivery)
+	case subscriptions.FallbackPolicyReject:
+		c.nack(delivery, false)
+	case subscriptions.FallbackPolicyRequeue:
+		c.nack(delivery, true)
+	case subscriptions.FallbackPolicyProxy:
+		c.background(func() {
+			c.proxy(delivery, candidate, decision.ProxyTarget)
+			c.ack(delivery)
+		})
+	default:
+		c.reply(delivery, candidate, decision.Response)
+		c.ack(delivery)
+	}
+}
+
+// proxy forwards the request to the real service, relays its reply to the caller and records the round trip.
+func (c *amqpListener) proxy(delivery amqp.Delivery, candidate *expectations.Candidate, target *subscriptions.ProxyTarget) {
+	if target == nil {
+		slog.Error("no proxy target configured", "queue", c.subscription.Queue())
+		c.replyError(delivery, candidate, errors.New("no proxy target configured"))
+		return
+	}
+
+	result, err := c.forward(delivery, target)
+	c.matcher.RecordProxy(candidate, result)
+	if err != nil {
+		c.replyError(delivery, candidate, fmt.Errorf("proxy: %w", err))
+		return
+	}
+
+	response, _ := expectations.NewResponse(result.Response)
+	c.reply(delivery, candidate, response)
+}
+
+// record forwards the request to the real service, relays its reply to the caller
+// and captures the request/reply pair as an expectation.
+func (c *amqpListener) record(delivery amqp.Delivery, candidate *expectations.Candidate, target *subscriptions.ProxyTarget) {
+	result, err := c.forward(delivery, target)
+	if err != nil {
+		c.replyError(delivery, candidate, fmt.Errorf("record: %w", err))
+		return
+	}
+
+	if err := c.recorder.Record(candidate, result.Response); err != nil {
+		slog.Error("failed to record request", "error", err)
+	}
+
+	response, _ := expectations.NewResponse(result.Response)
+	c.reply(delivery, candidate, response)
+}
+
+// forward calls the real service with the request and returns the outcome of the round trip.
+func (c *amqpListener) forward(delivery amqp.Delivery, target *subscriptions.ProxyTarget) (*expectations.ProxyResult, error) {
+	result := &expectations.ProxyResult{
+		Exchange:   target.Exchange,
+		RoutingKey: target.RoutingKeyFor(delivery.RoutingKey),
+	}
+
+	opts := []gocoreamqp.RPCCallOptionFunc{gocoreamqp.RPCCallWithHeaders(delivery.Headers)}
+	if delivery.ContentType != "" {
+		opts = append(opts, gocoreamqp.RPCCallWithContentType(delivery.ContentType))
+	}
+
+	body, err := c.forwarder.Call(context.Background(), result.Exchange, result.RoutingKey, delivery.Body, opts...)
+	if err != nil {
+		slog.Error("failed to forward request", "exchange", result.Exchange, "routing_key", result.RoutingKey, "error", err)
+		result.Error = err.Error()
+		return result, err
+	}
+
+	result.Response = body
+	return result, nil
+}
+
+func (c *amqpListener) reply(delivery amqp.Delivery, candidate *expectations.Candidate, response *expectations.Response) {
+	body, err := response.Render(candidate)
+	if err != nil {
+		slog.Error("failed to render response", "error", err)
+		c.replyError(delivery, candidate, err)
 		return
 	}
 
-	response := c.matcher.Match(candidate)
-	if response == nil {
-		notFoundResponse := `{"errors":"no match found"}`
-		response, _ = expectations.NewResponse([]byte(notFoundResponse))
+	c.publish(delivery, candidate, amqp.Publishing{Headers: newReplyHeaders(response.Headers), Body: body}, response.Headers)
+}
+
+func (c *amqpListener) replyError(delivery amqp.Delivery, candidate *expectations.Candidate, err error) {
+	body, _ := json.Marshal(map[string]string{"errors": err.Error()})
+	c.publish(delivery, candidate, amqp.Publishing{Body: body}, nil)
+}
+
+// publish sends the reply message to the reply-to queue of the request and records it in the assertion of the request.
+func (c *amqpListener) publish(delivery amqp.Delivery, candidate *expectations.Candidate, msg amqp.Publishing, headers map[string]string) {
+	msg.ContentType = "application/json"
+	msg.CorrelationId = delivery.CorrelationId
+
+	reply := &expectations.Reply{
+		Queue:   delivery.ReplyTo,
+		Headers: headers,
+		Properties: expectations.Properties{
+			ContentType:   msg.ContentType,
+			CorrelationID: msg.CorrelationId,
+		},
+		Body: msg.Body,
 	}
 
-	msg := amqp.Publishing{ContentType: "application/json", CorrelationId: delivery.CorrelationId, Body: response.Body}
 	if err := c.channel.Publish("", delivery.ReplyTo, false, false, msg); err != nil {
 		slog.Error("failed to publish response", "error", err)
+		reply.Error = err.Error()
+	}
+
+	reply.PublishedAt = time.Now()
+	if receivedAt := candidate.Delivery.ReceivedAt; !receivedAt.IsZero() {
+		reply.Latency = reply.PublishedAt.Sub(receivedAt)
 	}
 
+	c.matcher.RecordReply(candidate, reply)
+}
+
+func (c *amqpListener) ack(delivery amqp.Delivery) {
 	if err := delivery.Ack(false); err != nil {
 		slog.Error("failed to acknowledge message", "error", err)
 	}
 }
+
+func (c *amqpListener) nack(delivery amqp.Delivery, requeue bool) {
+	if err := delivery.Nack(false, requeue); err != nil {
+		slog.Error("failed to negatively acknowledge message", "error", err)
+	}
+}
+
+// wait blocks for the given duration. It returns false if the listener was stopped in the meantime.
+func (c *amqpListener) wait(d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
+func newReplyHeaders(headers map[string]string) amqp.Table {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	table := make(amqp.Table, len(headers))
+	for name, value := range headers {
+		table[name] = value
+	}
+
+	return table
+}
+
+func newCandidateProperties(delivery amqp.Delivery) expectations.Properties {
+	return expectations.Properties{
+		ContentType:     delivery.ContentType,
+		ContentEncoding: delivery.ContentEncoding,
+		DeliveryMode:    delivery.DeliveryMode,
+		Priority:        delivery.Priority,
+		CorrelationID:   delivery.CorrelationId,
+		ReplyTo:         delivery.ReplyTo,
+		Expiration:      delivery.Expiration,
+		MessageID:       delivery.MessageId,
+		Type:            delivery.Type,
+		UserID:          delivery.UserId,
+		AppID:           delivery.AppId,
+	}
+}
+
+// newCandidateHeaders converts AMQP headers to their string representation,
+// nested tables and arrays are rendered as JSON.
+func newCandidateHeaders(table amqp.Table) map[string]string {
+	if len(table) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string, len(table))
+	for name, value := range table {
+		headers[name] = headerValueString(value)
+	}
+
+	return headers
+}
+
+func headerValueString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case amqp.Table, []any:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(raw)
+	default:
+		return fmt.Sprint(v)
+	}
+}
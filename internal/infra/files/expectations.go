@@ -0,0 +1,224 @@
+// Package files loads expectations from a directory of JSON and YAML files and keeps them in sync with it.
+package files
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+// SourcePrefix prefixes the source of every expectation loaded from a file, it is followed by the relative file path.
+const SourcePrefix = "file:"
+
+// ExpectationsStore is an interface for replacing the file-sourced expectations.
+type ExpectationsStore interface {
+	ReplaceBySourcePrefix(prefix string, exps []*expectations.Expectation)
+}
+
+// ExpectationsLoader loads expectations from a directory and reloads them when the files change.
+// Files use the schema of the CreateExpectation API, each file contains a single expectation,
+// a list of expectations, or an object with an "expectations" list like the recordings export.
+type ExpectationsLoader struct {
+	dir         string
+	interval    time.Duration
+	store       ExpectationsStore
+	fingerprint string
+}
+
+// defaultPollInterval is used when no positive poll interval is given.
+const defaultPollInterval = 2 * time.Second
+
+// NewExpectationsLoader creates a new ExpectationsLoader polling the directory with the given interval.
+func NewExpectationsLoader(dir string, interval time.Duration, store ExpectationsStore) *ExpectationsLoader {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	return &ExpectationsLoader{
+		dir:      dir,
+		interval: interval,
+		store:    store,
+	}
+}
+
+// Load reads all expectation files and replaces the file-sourced expectations with them.
+// If any file is invalid, nothing is replaced.
+func (l *ExpectationsLoader) Load() error {
+	paths, fingerprint, err := l.scan()
+	if err != nil {
+		return err
+	}
+
+	var exps []*expectations.Expectation
+	for _, path := range paths {
+		fileExps, err := l.loadFile(path)
+		if err != nil {
+			return err
+		}
+		exps = append(exps, fileExps...)
+	}
+
+	l.store.ReplaceBySourcePrefix(SourcePrefix, exps)
+	l.fingerprint = fingerprint
+	slog.Info("expectation files loaded", "dir", l.dir, "files", len(paths), "expectations", len(exps))
+
+	return nil
+}
+
+// Run polls the directory and reloads the expectations whenever a file is added, changed or removed.
+func (l *ExpectationsLoader) Run(ctx context.Context) error {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			_, fingerprint, err := l.scan()
+			if err != nil {
+				slog.Error("failed to scan expectation files", "dir", l.dir, "error", err)
+				continue
+			}
+
+			if fingerprint == l.fingerprint {
+				continue
+			}
+
+			if err := l.Load(); err != nil {
+				slog.Error("failed to reload expectation files, keeping the previous expectations", "dir", l.dir, "error", err)
+				// do not retry until the files change again
+				l.fingerprint = fingerprint
+			}
+		}
+	}
+}
+
+// scan returns the sorted expectation file paths and a fingerprint of their names, sizes and modification times.
+func (l *ExpectationsLoader) scan() ([]string, string, error) {
+	var paths []string
+	var fingerprint strings.Builder
+
+	err := filepath.WalkDir(l.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !isExpectationFile(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		paths = append(paths, path)
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", path, info.Size(), info.ModTime().UnixNano())
+
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to scan expectations directory %s: %w", l.dir, err)
+	}
+
+	sort.Strings(paths)
+
+	return paths, fingerprint.String(), nil
+}
+
+func (l *ExpectationsLoader) loadFile(path string) ([]*expectations.Expectation, error) {
+	raw, err := os.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read expectation file %s: %w", path, err)
+	}
+
+	items, err := decodeFile(path, raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode expectation file %s: %w", path, err)
+	}
+
+	rel, err := filepath.Rel(l.dir, path)
+	if err != nil {
+		rel = path
+	}
+
+	exps := make([]*expectations.Expectation, 0, len(items))
+	for i, item := range items {
+		req := &grpcApi.CreateExpectationRequest{}
+		if err := protojson.Unmarshal(item, req); err != nil {
+			return nil, fmt.Errorf("invalid expectation #%d in file %s: %w", i+1, path, err)
+		}
+
+		exp, err := mapper.NewExpectation(req, expectations.WithSource(SourcePrefix+filepath.ToSlash(rel)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid expectation #%d in file %s: %w", i+1, path, err)
+		}
+
+		exps = append(exps, exp)
+	}
+
+	return exps, nil
+}
+
+func isExpectationFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeFile returns the JSON encoded expectations of a file.
+func decodeFile(path string, raw []byte) ([]json.RawMessage, error) {
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		var doc any
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if raw, err = json.Marshal(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+
+	if raw[0] == '[' {
+		var items []json.RawMessage
+		err := json.Unmarshal(raw, &items)
+		return items, err
+	}
+
+	var wrapper struct {
+		Expectations []json.RawMessage `json:"expectations"`
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return nil, err
+	}
+
+	if wrapper.Expectations != nil {
+		return wrapper.Expectations, nil
+	}
+
+	return []json.RawMessage{raw}, nil
+}
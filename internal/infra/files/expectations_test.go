@@ -0,0 +1,166 @@
+package files
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jsonExpectation = `{
+  "request": {"exchange": "orders", "routing_key": "order.get", "json_body": {"body": {"id": "1"}, "match_type": "MATCH_TYPE_EXACT"}},
+  "response": {"body": {"status": "shipped"}},
+  "times": {"unlimited": true}
+}`
+
+const yamlExpectations = `
+- request:
+    exchange: orders
+    routing_key: order.create
+    json_body:
+      body:
+        item: book
+      match_type: MATCH_TYPE_PARTIAL
+  response:
+    body:
+      status: created
+- request:
+    exchange: orders
+    routing_key: order.delete
+    regex_body:
+      regex: ".*"
+  response:
+    body:
+      status: deleted
+`
+
+const exportedExpectations = `{
+  "recording": false,
+  "expectations": [
+    {"request": {"exchange": "users", "routing_key": "user.get", "regex_body": {"regex": ".*"}}, "response": {"body": {"name": "John"}}}
+  ]
+}`
+
+type testStore struct {
+	m            sync.Mutex
+	prefix       string
+	expectations []*expectations.Expectation
+	calls        int
+}
+
+func (s *testStore) ReplaceBySourcePrefix(prefix string, exps []*expectations.Expectation) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.prefix = prefix
+	s.expectations = exps
+	s.calls++
+}
+
+func (s *testStore) snapshot() ([]*expectations.Expectation, int) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.expectations, s.calls
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func sources(exps []*expectations.Expectation) []string {
+	res := make([]string, 0, len(exps))
+	for _, exp := range exps {
+		res = append(res, exp.Source)
+	}
+
+	return res
+}
+
+func TestExpectationsLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("supported formats", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "a.json"), jsonExpectation)
+		writeFile(t, filepath.Join(dir, "b.yaml"), yamlExpectations)
+		writeFile(t, filepath.Join(dir, "nested", "c.json"), exportedExpectations)
+		writeFile(t, filepath.Join(dir, "README.md"), "not an expectation")
+
+		store := &testStore{}
+		require.NoError(t, NewExpectationsLoader(dir, time.Second, store).Load())
+
+		exps, _ := store.snapshot()
+		assert.Equal(t, SourcePrefix, store.prefix)
+		assert.Equal(t, []string{"file:a.json", "file:b.yaml", "file:b.yaml", "file:nested/c.json"}, sources(exps))
+		assert.True(t, exps[0].Times.Unlimited)
+		assert.Equal(t, "order.delete", exps[2].Request.RoutingKey)
+	})
+
+	t.Run("invalid file keeps the current expectations", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "a.json"), jsonExpectation)
+		writeFile(t, filepath.Join(dir, "b.json"), `{"request": {"exchange": "orders"}, "unknown": 1}`)
+
+		store := &testStore{}
+		require.Error(t, NewExpectationsLoader(dir, time.Second, store).Load())
+
+		_, calls := store.snapshot()
+		assert.Zero(t, calls)
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		t.Parallel()
+
+		store := &testStore{}
+		require.Error(t, NewExpectationsLoader(filepath.Join(t.TempDir(), "missing"), time.Second, store).Load())
+	})
+}
+
+func TestExpectationsLoader_Run(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.json"), jsonExpectation)
+
+	store := &testStore{}
+	loader := NewExpectationsLoader(dir, 10*time.Millisecond, store)
+	require.NoError(t, loader.Load())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- loader.Run(ctx) }()
+
+	writeFile(t, filepath.Join(dir, "b.yaml"), yamlExpectations)
+	require.Eventually(t, func() bool {
+		exps, _ := store.snapshot()
+		return len(exps) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// an invalid edit keeps the previously loaded expectations
+	writeFile(t, filepath.Join(dir, "b.yaml"), "- request: [")
+	time.Sleep(50 * time.Millisecond)
+	exps, _ := store.snapshot()
+	assert.Len(t, exps, 3)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.yaml")))
+	require.Eventually(t, func() bool {
+		exps, _ := store.snapshot()
+		return len(exps) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
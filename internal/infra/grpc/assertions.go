@@ -2,35 +2,220 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
 	"github.com/google/uuid"
 )
 
-func (s *AmqpMockServerServiceServer) GetAssertions(_ context.Context, req *grpcApi.GetAssertionsRequest) (*grpcApi.GetAssertionsResponse, error) {
-	appReq := app.GetAssertionsRequest{
-		Status:  req.Status,
-		Include: req.Include,
+func (s *AmqpMockServerServiceServer) GetAssertions(ctx context.Context, req *grpcApi.GetAssertionsRequest) (*grpcApi.GetAssertionsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.ExpectationId != nil {
-		expUID, err := uuid.Parse(*req.ExpectationId)
-		if err != nil {
-			return nil, fmt.Errorf("invalid expectation id: %w", err)
+	query, err := newAssertionsQuery(req.ExpectationId, req.Status, req.GetExchange(), req.GetRoutingKey())
+	if err != nil {
+		return nil, err
+	}
+
+	query.Queue = req.GetQueue()
+	query.Headers = req.GetHeaders()
+	query.BodyContains = req.GetBodyContains()
+
+	if query.LabelSelector, err = newLabelSelector(req.LabelSelector); err != nil {
+		return nil, err
+	}
+
+	if req.Since != nil {
+		if query.Since, err = time.Parse(time.RFC3339, req.GetSince()); err != nil {
+			return nil, fmt.Errorf("invalid since: %w", err)
 		}
-		appReq.ExpectationID = &expUID
 	}
 
-	assertions := s.expectationsService.GetAssertions(appReq)
+	if req.Until != nil {
+		if query.Until, err = time.Parse(time.RFC3339, req.GetUntil()); err != nil {
+			return nil, fmt.Errorf("invalid until: %w", err)
+		}
+	}
+
+	page, err := expSvc.GetAssertions(app.GetAssertionsRequest{
+		Query:       query,
+		Include:     req.Include,
+		NewestFirst: req.GetNewestFirst(),
+		PageSize:    int(req.GetPageSize()),
+		PageToken:   req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assertions: %w", err)
+	}
+
+	assertionsDTO := make([]*grpcApi.Assertion, 0, len(page.Assertions))
+	for _, assertion := range page.Assertions {
+		assertionsDTO = append(assertionsDTO, newProtoAssertion(assertion, req.Include))
+	}
+
+	return &grpcApi.GetAssertionsResponse{
+		Assertions:    assertionsDTO,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// GetAssertion returns a single assertion, always along with the matched expectation.
+func (s *AmqpMockServerServiceServer) GetAssertion(ctx context.Context, req *grpcApi.GetAssertionRequest) (*grpcApi.GetAssertionResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assertionUID, err := uuid.Parse(req.GetAssertionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid assertion id: %w", err)
+	}
+
+	assertion, err := expSvc.GetAssertion(assertionUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assertion: %w", err)
+	}
+
+	return &grpcApi.GetAssertionResponse{
+		Assertion: newProtoAssertion(assertion, []string{"expectation"}),
+	}, nil
+}
+
+// ResetAssertions clears the history of assertions.
+func (s *AmqpMockServerServiceServer) ResetAssertions(ctx context.Context, _ *grpcApi.ResetAssertionsRequest) (*grpcApi.ResetAssertionsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expSvc.ResetAssertions()
+
+	return &grpcApi.ResetAssertionsResponse{}, nil
+}
+
+// GetAssertionsStats returns the size and the retention limits of the history of assertions.
+func (s *AmqpMockServerServiceServer) GetAssertionsStats(ctx context.Context, _ *grpcApi.GetAssertionsStatsRequest) (*grpcApi.GetAssertionsStatsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := expSvc.AssertionsStats()
+
+	return &grpcApi.GetAssertionsStatsResponse{
+		Count:         uint64(stats.Count), // nolint: gosec
+		Evicted:       stats.Evicted,
+		MaxCount:      uint64(stats.Retention.MaxCount), // nolint: gosec
+		MaxAgeSeconds: float32(stats.Retention.MaxAge.Seconds()),
+	}, nil
+}
+
+// defaultWaitTimeout is how long WaitForAssertions waits if the request does not set a timeout.
+const defaultWaitTimeout = 10 * time.Second
+
+// WaitForAssertions waits until enough assertions match the filter or the timeout elapses.
+func (s *AmqpMockServerServiceServer) WaitForAssertions(ctx context.Context, req *grpcApi.WaitForAssertionsRequest) (*grpcApi.WaitForAssertionsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := newWaitQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(req.GetCount())
+	if count == 0 {
+		count = 1
+	}
+
+	timeout := defaultWaitTimeout
+	if req.TimeoutSeconds != nil {
+		if req.GetTimeoutSeconds() <= 0 {
+			return nil, fmt.Errorf("timeout must be greater than 0")
+		}
+		timeout = time.Duration(float64(req.GetTimeoutSeconds()) * float64(time.Second))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	assertions, err := expSvc.WaitForAssertions(ctx, query, count)
+	if err != nil && !errors.Is(err, app.ErrWaitTimeout) {
+		return nil, fmt.Errorf("failed to wait for assertions: %w", err)
+	}
 
 	assertionsDTO := make([]*grpcApi.Assertion, 0, len(assertions))
 	for _, assertion := range assertions {
 		assertionsDTO = append(assertionsDTO, newProtoAssertion(assertion, req.Include))
 	}
 
-	return &grpcApi.GetAssertionsResponse{
+	return &grpcApi.WaitForAssertionsResponse{
+		Satisfied:  err == nil,
 		Assertions: assertionsDTO,
 	}, nil
 }
+
+// newAssertionsQuery creates a query from the filters shared by the assertion requests.
+func newAssertionsQuery(expectationID, status *string, exchange, routingKey string) (expectations.AssertionsQuery, error) {
+	query := expectations.AssertionsQuery{
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+	}
+
+	if expectationID != nil {
+		expUID, err := uuid.Parse(*expectationID)
+		if err != nil {
+			return expectations.AssertionsQuery{}, fmt.Errorf("invalid expectation id: %w", err)
+		}
+		query.ExpectationID = &expUID
+	}
+
+	if status != nil {
+		st, err := expectations.ParseAssertionStatus(*status)
+		if err != nil {
+			return expectations.AssertionsQuery{}, err
+		}
+		query.Status = st
+	}
+
+	return query, nil
+}
+
+func newWaitQuery(req *grpcApi.WaitForAssertionsRequest) (expectations.AssertionsQuery, error) {
+	query, err := newAssertionsQuery(req.ExpectationId, req.Status, req.GetExchange(), req.GetRoutingKey())
+	if err != nil {
+		return expectations.AssertionsQuery{}, err
+	}
+
+	if query.LabelSelector, err = newLabelSelector(req.LabelSelector); err != nil {
+		return expectations.AssertionsQuery{}, err
+	}
+
+	// the body is matched by the same comparators as the request of an expectation
+	var bodyReq *grpcApi.Request
+	switch body := req.GetBody().(type) {
+	case *grpcApi.WaitForAssertionsRequest_JsonBody:
+		bodyReq = &grpcApi.Request{Body: &grpcApi.Request_JsonBody{JsonBody: body.JsonBody}}
+	case *grpcApi.WaitForAssertionsRequest_RegexBody:
+		bodyReq = &grpcApi.Request{Body: &grpcApi.Request_RegexBody{RegexBody: body.RegexBody}}
+	}
+
+	if bodyReq != nil {
+		cmp, err := mapper.NewBodyComparator(bodyReq)
+		if err != nil {
+			return expectations.AssertionsQuery{}, fmt.Errorf("failed to create body comparator: %w", err)
+		}
+		query.BodyComparator = cmp
+	}
+
+	return query, nil
+}
@@ -103,67 +103,6 @@ func TestNewProtoAssertionInAssertions(t *testing.T) {
 	assert.Nil(t, protoAssertionWithoutInclude.Expectation)
 }
 
-// TestExpectationsService is a simple implementation of the ExpectationsService interface for testing
-type TestExpectationsService struct {
-	assertions []*expectations.Assertion
-}
-
-func (s *TestExpectationsService) Create(_ *expectations.Expectation) error {
-	return nil
-}
-
-func (s *TestExpectationsService) Reset() {
-}
-
-func (s *TestExpectationsService) Match(_ *expectations.Candidate) *expectations.Response {
-	return nil
-}
-
-func (s *TestExpectationsService) GetExpectations(_ app.GetExpectationsRequest) []*expectations.Expectation {
-	return nil
-}
-
-func (s *TestExpectationsService) GetExpectation(_ uuid.UUID) *expectations.Expectation {
-	return nil
-}
-
-func (s *TestExpectationsService) GetAssertions(req app.GetAssertionsRequest) []*expectations.Assertion {
-	// Filter assertions based on request parameters
-	var result []*expectations.Assertion
-
-	// If ExpectationID is provided, filter by specific expectation
-	if req.ExpectationID != nil {
-		for _, a := range s.assertions {
-			if a.Expectation != nil && a.Expectation.ID == *req.ExpectationID {
-				result = append(result, a)
-			}
-		}
-		return result
-	}
-
-	// If Status is provided, filter by match status
-	if req.Status != nil {
-		switch *req.Status {
-		case "matched":
-			for _, a := range s.assertions {
-				if a.Expectation != nil {
-					result = append(result, a)
-				}
-			}
-		case "unmatched":
-			for _, a := range s.assertions {
-				if a.Expectation == nil {
-					result = append(result, a)
-				}
-			}
-		}
-		return result
-	}
-
-	// Return all assertions
-	return s.assertions
-}
-
 // TestGetAssertions tests the GetAssertions handler
 func TestGetAssertions(t *testing.T) {
 	// Create test data
@@ -314,3 +253,183 @@ func TestGetAssertions(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// TestWaitForAssertions tests the WaitForAssertions handler
+func TestWaitForAssertions(t *testing.T) {
+	// Create the server with a real expectations service
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	publish := func(body string) {
+		cnd, err := expectations.NewCandidate("orders", "order.created", []byte(body))
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+	}
+
+	status, routingKey, timeout := "unmatched", "order.created", float32(5)
+	req := &grpcApi.WaitForAssertionsRequest{
+		Status:         &status,
+		RoutingKey:     &routingKey,
+		Body:           &grpcApi.WaitForAssertionsRequest_RegexBody{RegexBody: &grpcApi.RegexBodyAssertion{Regex: `"id":1`}},
+		Count:          2,
+		TimeoutSeconds: &timeout,
+	}
+
+	// The request is published after the wait started
+	publish(`{"id":1}`)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		publish(`{"id":2}`)
+		publish(`{"id":1}`)
+	}()
+
+	resp, err := server.WaitForAssertions(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.Satisfied)
+	assert.Len(t, resp.Assertions, 2)
+
+	// The timeout elapses with the assertions recorded so far
+	timeout = 0.02
+	req.Count = 3
+	resp, err = server.WaitForAssertions(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.Satisfied)
+	assert.Len(t, resp.Assertions, 2)
+
+	// Invalid filters
+	invalidID := "invalid"
+	_, err = server.WaitForAssertions(context.Background(), &grpcApi.WaitForAssertionsRequest{ExpectationId: &invalidID})
+	require.Error(t, err)
+
+	negative := float32(-1)
+	_, err = server.WaitForAssertions(context.Background(), &grpcApi.WaitForAssertionsRequest{TimeoutSeconds: &negative})
+	require.Error(t, err)
+}
+
+// TestResetAssertions tests the ResetAssertions and GetAssertionsStats handlers
+func TestResetAssertions(t *testing.T) {
+	expSvc := app.NewExpectationsService(app.WithAssertionsRetention(expectations.Retention{MaxCount: 1, MaxAge: time.Minute}))
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	for _, rk := range []string{"order.created", "order.shipped"} {
+		cnd, err := expectations.NewCandidate("orders", rk, []byte(`{}`))
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+	}
+
+	stats, err := server.GetAssertionsStats(context.Background(), &grpcApi.GetAssertionsStatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Count)
+	assert.Equal(t, uint64(1), stats.Evicted)
+	assert.Equal(t, uint64(1), stats.MaxCount)
+	assert.Equal(t, float32(60), stats.MaxAgeSeconds)
+
+	_, err = server.ResetAssertions(context.Background(), &grpcApi.ResetAssertionsRequest{})
+	require.NoError(t, err)
+
+	stats, err = server.GetAssertionsStats(context.Background(), &grpcApi.GetAssertionsStatsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), stats.Count)
+	assert.Equal(t, uint64(1), stats.Evicted)
+}
+
+// TestGetAssertionsQuery tests the filters and the pages of the GetAssertions handler
+func TestGetAssertionsQuery(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	for _, tenant := range []string{"acme", "globex", "acme"} {
+		cnd, err := expectations.NewCandidate("orders", "order.created", []byte(`{"tenant":"`+tenant+`"}`),
+			expectations.WithCandidateQueue("orders-queue"),
+			expectations.WithCandidateHeaders(map[string]string{"x-tenant": tenant}),
+		)
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+	}
+
+	since := time.Now().Add(-time.Minute).Format(time.RFC3339)
+	req := &grpcApi.GetAssertionsRequest{
+		Status:     stringPtr("unmatched"),
+		RoutingKey: stringPtr("order.created"),
+		Queue:      stringPtr("orders-queue"),
+		Headers:    map[string]string{"x-tenant": "acme"},
+		Since:      &since,
+		PageSize:   1,
+	}
+
+	resp, err := server.GetAssertions(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Assertions, 1)
+	assert.Equal(t, "orders-queue", resp.Assertions[0].Candidate.Queue)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	req.PageToken = &resp.NextPageToken
+	resp, err = server.GetAssertions(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Assertions, 1)
+	assert.Empty(t, resp.NextPageToken)
+
+	resp, err = server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{BodyContains: stringPtr("globex")})
+	require.NoError(t, err)
+	assert.Len(t, resp.Assertions, 1)
+
+	// Invalid filters
+	_, err = server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{Status: stringPtr("unknown")})
+	require.Error(t, err)
+
+	_, err = server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{Until: stringPtr("yesterday")})
+	require.Error(t, err)
+
+	_, err = server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{PageToken: stringPtr("invalid")})
+	require.Error(t, err)
+}
+
+// TestGetAssertion tests the GetAssertion handler
+func TestGetAssertion(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	bodyComparator, err := comparators.NewJSONBody([]byte(`{"id":1}`), comparators.MatchTypePartial)
+	require.NoError(t, err)
+	request, err := expectations.NewRequest("orders", "order.created", bodyComparator)
+	require.NoError(t, err)
+	response, err := expectations.NewResponse([]byte(`{}`))
+	require.NoError(t, err)
+	exp, err := expectations.NewExpectation(request, response)
+	require.NoError(t, err)
+	require.NoError(t, expSvc.Create(exp))
+
+	cnd, err := expectations.NewCandidate("orders", "order.created", []byte(`{"id":1}`))
+	require.NoError(t, err)
+	expSvc.Match(cnd)
+
+	// The IDs are stable across calls
+	listed, err := server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.Assertions, 1)
+	again, err := server.GetAssertions(context.Background(), &grpcApi.GetAssertionsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, listed.Assertions[0].Id, again.Assertions[0].Id)
+
+	// The expectation is always included
+	resp, err := server.GetAssertion(context.Background(), &grpcApi.GetAssertionRequest{AssertionId: listed.Assertions[0].Id})
+	require.NoError(t, err)
+	assert.Equal(t, listed.Assertions[0].Id, resp.Assertion.Id)
+	require.NotNil(t, resp.Assertion.Expectation)
+	assert.Equal(t, exp.ID.String(), resp.Assertion.Expectation.Id)
+	assert.Equal(t, "order.created", resp.Assertion.Candidate.RoutingKey)
+
+	_, err = server.GetAssertion(context.Background(), &grpcApi.GetAssertionRequest{AssertionId: uuid.NewString()})
+	require.ErrorIs(t, err, app.ErrAssertionNotFound)
+
+	_, err = server.GetAssertion(context.Background(), &grpcApi.GetAssertionRequest{AssertionId: "invalid"})
+	require.Error(t, err)
+}
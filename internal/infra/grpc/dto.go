@@ -5,119 +5,57 @@ import (
 	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
-	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func newSubscription(sub *subscriptions.Subscription) *grpcApi.Subscription {
 	return &grpcApi.Subscription{
-		Id:    sub.ID().String(),
-		Queue: sub.Queue(),
+		Id:             sub.ID().String(),
+		Queue:          sub.Queue(),
+		FallbackPolicy: newProtoFallbackPolicy(sub.FallbackPolicy()),
+		ProxyTarget:    newProtoProxyTarget(sub.ProxyTarget()),
+		Namespace:      sub.Namespace(),
+		SessionId:      mapper.NewProtoSessionID(sub.SessionID()),
 	}
 }
 
-func newProtoExpectation(exp *expectations.Expectation) *grpcApi.Expectation {
-	expDTO := &grpcApi.Expectation{
-		Id:        exp.ID.String(),
-		Request:   newProtoRequest(exp.Request),
-		Response:  newProtoResponse(exp.Response),
-		CreatedAt: exp.CreatedAt.Format(time.RFC3339),
-	}
-
-	if exp.Times != nil {
-		if exp.Times.Unlimited {
-			expDTO.Times = &grpcApi.Times{
-				Times: &grpcApi.Times_Unlimited{
-					Unlimited: true,
-				},
-			}
-		} else {
-			expDTO.Times = &grpcApi.Times{
-				Times: &grpcApi.Times_RemainingTimes{
-					RemainingTimes: exp.Times.RemainingTimes,
-				},
-			}
-		}
+func newProtoProxyTarget(t *subscriptions.ProxyTarget) *grpcApi.ProxyTarget {
+	if t == nil {
+		return nil
 	}
 
-	if exp.TimeToLive != nil {
-		expiresAt := exp.CreatedAt.Add(exp.TimeToLive.TTL).Format(time.RFC3339)
-		expDTO.ExpiresAt = &expiresAt
+	return &grpcApi.ProxyTarget{
+		Exchange:   t.Exchange,
+		RoutingKey: t.RoutingKey,
 	}
-
-	return expDTO
 }
 
-func newProtoRequest(req *expectations.Request) *grpcApi.Request {
-	protoReq := &grpcApi.Request{
-		Exchange:   req.Exchange,
-		RoutingKey: req.RoutingKey,
-	}
-
-	switch b := req.BodyComparator.(type) {
-	case *comparators.JSONBody:
-		var v map[string]interface{}
-		if err := json.Unmarshal(b.Body, &v); err != nil {
-			return nil
-		}
-
-		pbValue, err := structpb.NewStruct(v)
-		if err != nil {
-			return nil
-		}
-
-		protoReq.Body = &grpcApi.Request_JsonBody{
-			JsonBody: &grpcApi.JSONBodyAssertion{
-				Body:      pbValue,
-				MatchType: newProtoMatchType(b.MatchType),
-			},
-		}
-	case *comparators.Regex:
-		protoReq.Body = &grpcApi.Request_RegexBody{
-			RegexBody: &grpcApi.RegexBodyAssertion{
-				Regex: b.Regex.String(),
-			},
-		}
-	}
-
-	return protoReq
-}
-
-func newProtoMatchType(mt comparators.MatchType) grpcApi.JSONBodyAssertion_MatchType {
-	switch mt {
-	case comparators.MatchTypeExact:
-		return grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT
-	case comparators.MatchTypePartial:
-		return grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL
+func newProtoFallbackPolicy(p subscriptions.FallbackPolicy) grpcApi.FallbackPolicy {
+	switch p {
+	case subscriptions.FallbackPolicyReply:
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_REPLY
+	case subscriptions.FallbackPolicyDrop:
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_DROP
+	case subscriptions.FallbackPolicyReject:
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_REJECT
+	case subscriptions.FallbackPolicyRequeue:
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_REQUEUE
+	case subscriptions.FallbackPolicyProxy:
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_PROXY
 	default:
-		return grpcApi.JSONBodyAssertion_MATCH_TYPE_UNSPECIFIED
-	}
-}
-
-func newProtoResponse(res *expectations.Response) *grpcApi.Response {
-	var v interface{}
-	if err := json.Unmarshal(res.Body, &v); err != nil {
-		return nil
+		return grpcApi.FallbackPolicy_FALLBACK_POLICY_UNSPECIFIED
 	}
-
-	pbValue, err := structpb.NewValue(v)
-	if err != nil {
-		return nil
-	}
-
-	return &grpcApi.Response{Body: pbValue}
 }
 
 func newProtoAssertion(assertion *expectations.Assertion, include []string) *grpcApi.Assertion {
 	protoAssertion := &grpcApi.Assertion{
-		Id: uuid.New().String(), // Generate new ID for the assertion
-		Candidate: &grpcApi.Assertion_Candidate{
-			Exchange:   assertion.Candidate.Exchange,
-			RoutingKey: assertion.Candidate.RoutingKey,
-		},
+		Id:        assertion.ID.String(),
+		Candidate: newProtoCandidate(assertion.Candidate),
+		Reply:     newProtoReply(assertion.Reply),
 		CreatedAt: assertion.CreatedAt.Format(time.RFC3339),
 	}
 
@@ -136,6 +74,16 @@ func newProtoAssertion(assertion *expectations.Assertion, include []string) *grp
 		protoAssertion.Matched = true
 	}
 
+	if assertion.IsProxied() {
+		protoAssertion.Proxied = true
+		protoAssertion.Proxy = &grpcApi.Assertion_ProxyResult{
+			Exchange:   assertion.Proxy.Exchange,
+			RoutingKey: assertion.Proxy.RoutingKey,
+			Response:   string(assertion.Proxy.Response),
+			Error:      assertion.Proxy.Error,
+		}
+	}
+
 	// Set matched expectation if exists and if "expectation" is in the include array
 	includeExpectation := false
 	for _, inc := range include {
@@ -146,8 +94,78 @@ func newProtoAssertion(assertion *expectations.Assertion, include []string) *grp
 	}
 
 	if includeExpectation && assertion.Expectation != nil {
-		protoAssertion.Expectation = newProtoExpectation(assertion.Expectation)
+		protoAssertion.Expectation = mapper.NewProtoExpectation(assertion.Expectation)
+	}
+
+	for _, nm := range assertion.NearMisses {
+		protoNearMiss := &grpcApi.Assertion_NearMiss{
+			ExpectationId: nm.Expectation.ID.String(),
+			Reasons:       nm.Reasons,
+			BodyDiff:      nm.BodyDiff,
+		}
+		if includeExpectation {
+			protoNearMiss.Expectation = mapper.NewProtoExpectation(nm.Expectation)
+		}
+		protoAssertion.NearMisses = append(protoAssertion.NearMisses, protoNearMiss)
 	}
 
 	return protoAssertion
 }
+
+// newProtoCandidate converts the candidate without its body, which callers convert as they see fit.
+func newProtoCandidate(cnd *expectations.Candidate) *grpcApi.Assertion_Candidate {
+	protoCandidate := &grpcApi.Assertion_Candidate{
+		Exchange:   cnd.Exchange,
+		RoutingKey: cnd.RoutingKey,
+		Queue:      cnd.Queue,
+		Headers:    cnd.Headers,
+		Properties: newProtoMessageProperties(cnd.Properties),
+		Delivery: &grpcApi.Assertion_Delivery{
+			ConsumerTag: cnd.Delivery.ConsumerTag,
+			DeliveryTag: cnd.Delivery.DeliveryTag,
+			Redelivered: cnd.Delivery.Redelivered,
+		},
+	}
+
+	if cnd.SubscriptionID != uuid.Nil {
+		protoCandidate.SubscriptionId = cnd.SubscriptionID.String()
+	}
+
+	if !cnd.Delivery.ReceivedAt.IsZero() {
+		protoCandidate.Delivery.ReceivedAt = cnd.Delivery.ReceivedAt.Format(time.RFC3339Nano)
+	}
+
+	return protoCandidate
+}
+
+func newProtoReply(reply *expectations.Reply) *grpcApi.Assertion_Reply {
+	if reply == nil {
+		return nil
+	}
+
+	return &grpcApi.Assertion_Reply{
+		Queue:       reply.Queue,
+		Headers:     reply.Headers,
+		Properties:  newProtoMessageProperties(reply.Properties),
+		Body:        string(reply.Body),
+		PublishedAt: reply.PublishedAt.Format(time.RFC3339Nano),
+		LatencyMs:   float64(reply.Latency) / float64(time.Millisecond),
+		Error:       reply.Error,
+	}
+}
+
+func newProtoMessageProperties(props expectations.Properties) *grpcApi.MessageProperties {
+	return &grpcApi.MessageProperties{
+		ContentType:     props.ContentType,
+		ContentEncoding: props.ContentEncoding,
+		DeliveryMode:    uint32(props.DeliveryMode),
+		Priority:        uint32(props.Priority),
+		CorrelationId:   props.CorrelationID,
+		ReplyTo:         props.ReplyTo,
+		Expiration:      props.Expiration,
+		MessageId:       props.MessageID,
+		Type:            props.Type,
+		UserId:          props.UserID,
+		AppId:           props.AppID,
+	}
+}
@@ -1,14 +1,13 @@
 package grpc
 
 import (
-	"encoding/json"
 	"testing"
 	"time"
 
-	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,174 +25,16 @@ func TestNewSubscription(t *testing.T) {
 	assert.Equal(t, sub.Queue(), protoSub.Queue)
 }
 
-func TestNewProtoExpectation(t *testing.T) {
-	// Create request with JSONBody comparator
-	jsonBody := []byte(`{"foo":"bar"}`)
-	bodyComparator, err := comparators.NewJSONBody(jsonBody, comparators.MatchTypePartial)
-	require.NoError(t, err)
-
-	request, err := expectations.NewRequest("test-exchange", "test-routing-key", bodyComparator)
-	require.NoError(t, err)
-
-	// Create response
-	responseBody := []byte(`{"result":"success"}`)
-	response, err := expectations.NewResponse(responseBody)
-	require.NoError(t, err)
-
-	t.Run("expectation with unlimited times", func(t *testing.T) {
-		// Create expectation with unlimited times
-		exp, err := expectations.NewExpectation(request, response, expectations.WithUnlimitedTimes())
-		require.NoError(t, err)
-
-		// Convert to proto
-		protoExp := newProtoExpectation(exp)
-
-		// Verify the conversion
-		assert.Equal(t, exp.ID.String(), protoExp.Id)
-		assert.Equal(t, exp.CreatedAt.Format(time.RFC3339), protoExp.CreatedAt)
-		assert.NotNil(t, protoExp.Request)
-		assert.NotNil(t, protoExp.Response)
-		assert.NotNil(t, protoExp.Times)
-		assert.True(t, protoExp.GetTimes().GetUnlimited())
-		assert.Nil(t, protoExp.ExpiresAt)
-	})
-
-	t.Run("expectation with limited times", func(t *testing.T) {
-		// Create expectation with limited times
-		exp, err := expectations.NewExpectation(request, response, expectations.WithLimitedTimes(5))
-		require.NoError(t, err)
-
-		// Convert to proto
-		protoExp := newProtoExpectation(exp)
-
-		// Verify the conversion
-		assert.Equal(t, exp.ID.String(), protoExp.Id)
-		assert.NotNil(t, protoExp.Times)
-		assert.Equal(t, uint32(5), protoExp.GetTimes().GetRemainingTimes())
-	})
-
-	t.Run("expectation with TTL", func(t *testing.T) {
-		// Create expectation with TTL
-		ttl := 10 * time.Minute
-		exp, err := expectations.NewExpectation(request, response, expectations.WithTimeToLive(ttl))
-		require.NoError(t, err)
-
-		// Convert to proto
-		protoExp := newProtoExpectation(exp)
-
-		// Verify the conversion
-		assert.Equal(t, exp.ID.String(), protoExp.Id)
-		assert.NotNil(t, protoExp.ExpiresAt)
-
-		// Calculate expected expiration time
-		expectedExpiresAt := exp.CreatedAt.Add(ttl).Format(time.RFC3339)
-		assert.Equal(t, expectedExpiresAt, *protoExp.ExpiresAt)
-	})
-}
-
-func TestNewProtoRequest(t *testing.T) {
-	exchange := "test-exchange"
-	routingKey := "test-routing-key"
-
-	t.Run("request with JSON body", func(t *testing.T) {
-		// Create request with JSONBody comparator
-		jsonBody := []byte(`{"foo":"bar"}`)
-		bodyComparator, err := comparators.NewJSONBody(jsonBody, comparators.MatchTypePartial)
-		require.NoError(t, err)
-
-		request, err := expectations.NewRequest(exchange, routingKey, bodyComparator)
-		require.NoError(t, err)
-
-		// Convert to proto
-		protoReq := newProtoRequest(request)
-
-		// Verify the conversion
-		assert.Equal(t, exchange, protoReq.Exchange)
-		assert.Equal(t, routingKey, protoReq.RoutingKey)
-		assert.NotNil(t, protoReq.GetJsonBody())
-		assert.Equal(t, grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL, protoReq.GetJsonBody().MatchType)
-	})
-
-	t.Run("request with Regex body", func(t *testing.T) {
-		// Create request with Regex comparator
-		regexPattern := "foo.*bar"
-		bodyComparator, err := comparators.NewRegex(regexPattern)
-		require.NoError(t, err)
-
-		request, err := expectations.NewRequest(exchange, routingKey, bodyComparator)
-		require.NoError(t, err)
-
-		// Convert to proto
-		protoReq := newProtoRequest(request)
-
-		// Verify the conversion
-		assert.Equal(t, exchange, protoReq.Exchange)
-		assert.Equal(t, routingKey, protoReq.RoutingKey)
-		assert.NotNil(t, protoReq.GetRegexBody())
-		assert.Equal(t, regexPattern, protoReq.GetRegexBody().Regex)
-	})
-}
-
-func TestNewProtoMatchType(t *testing.T) {
-	tests := []struct {
-		name      string
-		matchType comparators.MatchType
-		expected  grpcApi.JSONBodyAssertion_MatchType
-	}{
-		{
-			name:      "exact match",
-			matchType: comparators.MatchTypeExact,
-			expected:  grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT,
-		},
-		{
-			name:      "partial match",
-			matchType: comparators.MatchTypePartial,
-			expected:  grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := newProtoMatchType(tt.matchType)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
-func TestNewProtoResponse(t *testing.T) {
-	// Create response
-	responseBody := []byte(`{"result":"success"}`)
-	response, err := expectations.NewResponse(responseBody)
-	require.NoError(t, err)
-
-	// Convert to proto
-	protoRes := newProtoResponse(response)
-	require.NotNil(t, protoRes)
-
-	// Verify the conversion
-	assert.NotNil(t, protoRes)
-
-	// Convert proto value back to JSON for comparison
-	protoJSON, err := protoRes.Body.MarshalJSON()
-	require.NoError(t, err)
-
-	// Compare the JSON content
-	var originalMap, protoMap map[string]interface{}
-	err = json.Unmarshal(responseBody, &originalMap)
-	require.NoError(t, err)
-	err = json.Unmarshal(protoJSON, &protoMap)
-	require.NoError(t, err)
-
-	assert.Equal(t, originalMap, protoMap)
-}
-
 func TestNewProtoAssertion(t *testing.T) {
 	// Create a candidate
 	exchange := "test-exchange"
 	routingKey := "test-routing-key"
 	body := []byte(`{"foo":"bar"}`)
 
-	candidate, err := expectations.NewCandidate(exchange, routingKey, body)
+	candidate, err := expectations.NewCandidate(exchange, routingKey, body,
+		expectations.WithCandidateHeaders(map[string]string{"x-tenant": "acme"}),
+		expectations.WithCandidateProperties(expectations.Properties{Type: "invoice.created", Priority: 3}),
+	)
 	require.NoError(t, err)
 
 	// Create an assertion
@@ -211,6 +52,9 @@ func TestNewProtoAssertion(t *testing.T) {
 		assert.Equal(t, exchange, protoAssertion.Candidate.Exchange)
 		assert.Equal(t, routingKey, protoAssertion.Candidate.RoutingKey)
 		assert.NotNil(t, protoAssertion.Candidate.Body)
+		assert.Equal(t, map[string]string{"x-tenant": "acme"}, protoAssertion.Candidate.Headers)
+		assert.Equal(t, "invoice.created", protoAssertion.Candidate.Properties.Type)
+		assert.Equal(t, uint32(3), protoAssertion.Candidate.Properties.Priority)
 		assert.Equal(t, assertion.CreatedAt.Format(time.RFC3339), protoAssertion.CreatedAt)
 		assert.Nil(t, protoAssertion.Expectation)
 	})
@@ -248,4 +92,102 @@ func TestNewProtoAssertion(t *testing.T) {
 		assert.NotNil(t, protoAssertion.Expectation)
 		assert.Equal(t, exp.ID.String(), protoAssertion.Expectation.Id)
 	})
+
+	t.Run("proxied assertion", func(t *testing.T) {
+		// Create a proxied assertion
+		proxiedAssertion := &expectations.Assertion{
+			Candidate: candidate,
+			CreatedAt: time.Now(),
+			Proxy: &expectations.ProxyResult{
+				Exchange:   "real-exchange",
+				RoutingKey: routingKey,
+				Response:   []byte(`{"result":"real"}`),
+			},
+		}
+
+		// Convert to proto
+		protoAssertion := newProtoAssertion(proxiedAssertion, nil)
+
+		// Verify the conversion
+		assert.False(t, protoAssertion.Matched)
+		assert.True(t, protoAssertion.Proxied)
+		require.NotNil(t, protoAssertion.Proxy)
+		assert.Equal(t, "real-exchange", protoAssertion.Proxy.Exchange)
+		assert.Equal(t, routingKey, protoAssertion.Proxy.RoutingKey)
+		assert.JSONEq(t, `{"result":"real"}`, protoAssertion.Proxy.Response)
+		assert.Empty(t, protoAssertion.Proxy.Error)
+	})
+
+	t.Run("assertion with near misses", func(t *testing.T) {
+		request, err := expectations.NewRequest(exchange, "other-routing-key", nil)
+		require.NoError(t, err)
+		response, err := expectations.NewResponse([]byte(`{}`))
+		require.NoError(t, err)
+		exp, err := expectations.NewExpectation(request, response)
+		require.NoError(t, err)
+
+		unmatchedAssertion := &expectations.Assertion{
+			Candidate: candidate,
+			CreatedAt: time.Now(),
+			NearMisses: []*expectations.NearMiss{
+				{Expectation: exp, Reasons: []string{"routing key", "body"}, BodyDiff: `[{"op":"remove","path":"/foo"}]`},
+			},
+		}
+
+		protoAssertion := newProtoAssertion(unmatchedAssertion, nil)
+		require.Len(t, protoAssertion.NearMisses, 1)
+		assert.Equal(t, exp.ID.String(), protoAssertion.NearMisses[0].ExpectationId)
+		assert.Equal(t, []string{"routing key", "body"}, protoAssertion.NearMisses[0].Reasons)
+		assert.Equal(t, `[{"op":"remove","path":"/foo"}]`, protoAssertion.NearMisses[0].BodyDiff)
+		assert.Nil(t, protoAssertion.NearMisses[0].Expectation)
+
+		// the expectation is embedded on request
+		protoAssertion = newProtoAssertion(unmatchedAssertion, []string{"expectation"})
+		require.NotNil(t, protoAssertion.NearMisses[0].Expectation)
+		assert.Equal(t, "other-routing-key", protoAssertion.NearMisses[0].Expectation.Request.RoutingKey)
+	})
+
+	t.Run("assertion with reply and delivery metadata", func(t *testing.T) {
+		receivedAt := time.Now()
+		subscriptionID := uuid.New()
+		delivered, err := expectations.NewCandidate(exchange, routingKey, body,
+			expectations.WithCandidateSubscriptionID(subscriptionID),
+			expectations.WithCandidateDelivery(expectations.Delivery{DeliveryTag: 7, Redelivered: true, ReceivedAt: receivedAt}),
+		)
+		require.NoError(t, err)
+
+		repliedAssertion := &expectations.Assertion{
+			Candidate: delivered,
+			CreatedAt: time.Now(),
+			Reply: &expectations.Reply{
+				Queue:       "reply-to",
+				Headers:     map[string]string{"x-mock": "true"},
+				Properties:  expectations.Properties{ContentType: "application/json", CorrelationID: "42"},
+				Body:        []byte(`{"result":"success"}`),
+				PublishedAt: receivedAt.Add(1500 * time.Microsecond),
+				Latency:     1500 * time.Microsecond,
+				Error:       "channel closed",
+			},
+		}
+
+		protoAssertion := newProtoAssertion(repliedAssertion, nil)
+
+		assert.Equal(t, subscriptionID.String(), protoAssertion.Candidate.SubscriptionId)
+		assert.Equal(t, uint64(7), protoAssertion.Candidate.Delivery.DeliveryTag)
+		assert.True(t, protoAssertion.Candidate.Delivery.Redelivered)
+		assert.Equal(t, receivedAt.Format(time.RFC3339Nano), protoAssertion.Candidate.Delivery.ReceivedAt)
+		require.NotNil(t, protoAssertion.Reply)
+		assert.Equal(t, "reply-to", protoAssertion.Reply.Queue)
+		assert.Equal(t, map[string]string{"x-mock": "true"}, protoAssertion.Reply.Headers)
+		assert.Equal(t, "42", protoAssertion.Reply.Properties.CorrelationId)
+		assert.JSONEq(t, `{"result":"success"}`, protoAssertion.Reply.Body)
+		assert.InDelta(t, 1.5, protoAssertion.Reply.LatencyMs, 0.001)
+		assert.Equal(t, "channel closed", protoAssertion.Reply.Error)
+
+		// no reply and no delivery metadata
+		protoAssertion = newProtoAssertion(assertion, nil)
+		assert.Nil(t, protoAssertion.Reply)
+		assert.Empty(t, protoAssertion.Candidate.SubscriptionId)
+		assert.Empty(t, protoAssertion.Candidate.Delivery.ReceivedAt)
+	})
 }
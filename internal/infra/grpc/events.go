@@ -0,0 +1,215 @@
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// eventsMarshaler encodes the server-sent events the same way the gateway encodes its responses.
+var eventsMarshaler = protojson.MarshalOptions{
+	EmitUnpopulated: true,
+	UseProtoNames:   true,
+}
+
+// eventsHeartbeat is how often a comment is sent on an idle server-sent events stream, to keep proxies from closing it.
+const eventsHeartbeat = 15 * time.Second
+
+var protoEventTypes = map[app.EventType]grpcApi.EventType{
+	app.EventExpectationCreated:   grpcApi.EventType_EVENT_TYPE_EXPECTATION_CREATED,
+	app.EventExpectationUpdated:   grpcApi.EventType_EVENT_TYPE_EXPECTATION_UPDATED,
+	app.EventExpectationDeleted:   grpcApi.EventType_EVENT_TYPE_EXPECTATION_DELETED,
+	app.EventExpectationUsed:      grpcApi.EventType_EVENT_TYPE_EXPECTATION_USED,
+	app.EventExpectationExhausted: grpcApi.EventType_EVENT_TYPE_EXPECTATION_EXHAUSTED,
+	app.EventExpectationExpired:   grpcApi.EventType_EVENT_TYPE_EXPECTATION_EXPIRED,
+	app.EventRequestMatched:       grpcApi.EventType_EVENT_TYPE_REQUEST_MATCHED,
+	app.EventRequestUnmatched:     grpcApi.EventType_EVENT_TYPE_REQUEST_UNMATCHED,
+	app.EventSubscriptionAdded:    grpcApi.EventType_EVENT_TYPE_SUBSCRIPTION_ADDED,
+	app.EventSubscriptionRemoved:  grpcApi.EventType_EVENT_TYPE_SUBSCRIPTION_REMOVED,
+}
+
+// WatchEvents streams the events matching the filter until the client goes away.
+func (s *AmqpMockServerServiceServer) WatchEvents(req *grpcApi.WatchEventsRequest, stream grpc.ServerStreamingServer[grpcApi.Event]) error {
+	filter, err := newEventFilter(req)
+	if err != nil {
+		return err
+	}
+
+	if filter.Namespace, err = namespaceFromContext(stream.Context()); err != nil {
+		return err
+	}
+
+	watcher := s.eventsService.Watch(filter)
+	defer watcher.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-watcher.C():
+			if err := stream.Send(newProtoEvent(event)); err != nil {
+				return fmt.Errorf("failed to send event: %w", err)
+			}
+		}
+	}
+}
+
+// EventsHandler serves the events as server-sent events. The filter is taken from the query parameters
+// type (repeatable, e.g. request.matched), expectation_id, exchange, routing_key and queue.
+// The namespace is selected with the X-Mockserver-Namespace header, or the namespace query parameter.
+func (s *AmqpMockServerServiceServer) EventsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		req := &grpcApi.WatchEventsRequest{}
+		for _, t := range query["type"] {
+			protoType, ok := protoEventTypes[app.EventType(t)]
+			if !ok {
+				http.Error(w, fmt.Sprintf("invalid event type: %s", t), http.StatusBadRequest)
+				return
+			}
+			req.Types = append(req.Types, protoType)
+		}
+
+		for name, field := range map[string]**string{
+			"expectation_id": &req.ExpectationId,
+			"exchange":       &req.Exchange,
+			"routing_key":    &req.RoutingKey,
+			"queue":          &req.Queue,
+		} {
+			if query.Has(name) {
+				value := query.Get(name)
+				*field = &value
+			}
+		}
+
+		filter, err := newEventFilter(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		filter.Namespace = r.Header.Get(app.NamespaceHeader)
+		if query.Has("namespace") {
+			filter.Namespace = query.Get("namespace")
+		}
+		if err := app.ValidateNamespace(filter.Namespace); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// the stream outlives the write timeout of the server
+		rc := http.NewResponseController(w)
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		watcher := s.eventsService.Watch(filter)
+		defer watcher.Close()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(eventsHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			case event := <-watcher.C():
+				data, err := eventsMarshaler.Marshal(newProtoEvent(event))
+				if err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+					return
+				}
+			}
+
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	})
+}
+
+func newEventFilter(req *grpcApi.WatchEventsRequest) (app.EventFilter, error) {
+	filter := app.EventFilter{
+		Exchange:   req.GetExchange(),
+		RoutingKey: req.GetRoutingKey(),
+		Queue:      req.GetQueue(),
+	}
+
+	for _, protoType := range req.GetTypes() {
+		found := false
+		for t, pt := range protoEventTypes {
+			if pt == protoType {
+				filter.Types = append(filter.Types, t)
+				found = true
+			}
+		}
+		if !found {
+			return app.EventFilter{}, fmt.Errorf("invalid event type: %s", protoType)
+		}
+	}
+
+	if req.ExpectationId != nil {
+		expUID, err := uuid.Parse(*req.ExpectationId)
+		if err != nil {
+			return app.EventFilter{}, fmt.Errorf("invalid expectation id: %w", err)
+		}
+		filter.ExpectationID = &expUID
+	}
+
+	return filter, nil
+}
+
+func newProtoEvent(event app.Event) *grpcApi.Event {
+	protoEvent := &grpcApi.Event{
+		Type:       protoEventTypes[event.Type],
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339Nano),
+		Exchange:   event.Exchange,
+		RoutingKey: event.RoutingKey,
+		Namespace:  event.Namespace,
+	}
+
+	if event.ExpectationID != nil {
+		id := event.ExpectationID.String()
+		protoEvent.ExpectationId = &id
+	}
+
+	if event.SubscriptionID != nil {
+		id := event.SubscriptionID.String()
+		protoEvent.SubscriptionId = &id
+		protoEvent.Queue = &event.Queue
+	}
+
+	if cnd := event.Candidate; cnd != nil {
+		protoEvent.Candidate = newProtoCandidate(cnd)
+
+		// the body is left out if it is not a JSON object
+		var v map[string]interface{}
+		if err := json.Unmarshal(cnd.Body, &v); err == nil {
+			if body, err := structpb.NewStruct(v); err == nil {
+				protoEvent.Candidate.Body = body
+			}
+		}
+	}
+
+	return protoEvent
+}
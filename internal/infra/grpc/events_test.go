@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// testEventsStream is a server stream collecting the sent events
+type testEventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *grpcApi.Event
+}
+
+func (s *testEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testEventsStream) Send(event *grpcApi.Event) error {
+	s.events <- event
+	return nil
+}
+
+// TestWatchEvents tests the WatchEvents handler
+func TestWatchEvents(t *testing.T) {
+	events := app.NewEvents()
+	expSvc := app.NewExpectationsService(app.WithExpectationsEvents(events))
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+		eventsService:       events,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testEventsStream{ctx: ctx, events: make(chan *grpcApi.Event, 10)}
+
+	done := make(chan error)
+	go func() {
+		done <- server.WatchEvents(&grpcApi.WatchEventsRequest{
+			Types: []grpcApi.EventType{grpcApi.EventType_EVENT_TYPE_REQUEST_UNMATCHED},
+		}, stream)
+	}()
+
+	// Wait for the watcher to be registered
+	require.Eventually(t, func() bool {
+		cnd, err := expectations.NewCandidate("orders", "order.created", []byte(`{"id":1}`))
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+		return len(stream.events) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	event := <-stream.events
+	assert.Equal(t, grpcApi.EventType_EVENT_TYPE_REQUEST_UNMATCHED, event.Type)
+	assert.Equal(t, "orders", event.Exchange)
+	assert.Equal(t, "order.created", event.RoutingKey)
+	require.NotNil(t, event.Candidate)
+	assert.Equal(t, float64(1), event.Candidate.Body.AsMap()["id"])
+	assert.Nil(t, event.ExpectationId)
+
+	// The stream ends when the client goes away
+	cancel()
+	require.NoError(t, <-done)
+
+	// Invalid filters
+	invalidID := "invalid"
+	err := server.WatchEvents(&grpcApi.WatchEventsRequest{ExpectationId: &invalidID}, stream)
+	require.Error(t, err)
+}
+
+// TestEventsHandler tests the server-sent events handler
+func TestEventsHandler(t *testing.T) {
+	events := app.NewEvents()
+	expSvc := app.NewExpectationsService(app.WithExpectationsEvents(events))
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+		eventsService:       events,
+	}
+
+	httpServer := httptest.NewServer(server.EventsHandler())
+	defer httpServer.Close()
+
+	// Invalid filters
+	resp, err := http.Get(httpServer.URL + "?type=unknown")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(httpServer.URL + "?type=expectation.created&routing_key=order.created")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// The headers are flushed once the watcher is registered
+	exp := newTestExpectation(t, "orders", "order.shipped")
+	require.NoError(t, expSvc.Create(exp))
+	exp = newTestExpectation(t, "orders", "order.created")
+	require.NoError(t, expSvc.Create(exp))
+
+	reader := bufio.NewReader(resp.Body)
+	eventLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: expectation.created\n", eventLine)
+
+	dataLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(dataLine, "data: "))
+
+	event := &grpcApi.Event{}
+	require.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data: ")), event))
+	assert.Equal(t, grpcApi.EventType_EVENT_TYPE_EXPECTATION_CREATED, event.Type)
+	assert.Equal(t, exp.ID.String(), event.GetExpectationId())
+}
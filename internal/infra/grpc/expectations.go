@@ -3,59 +3,192 @@ package grpc
 import (
 	"context"
 	"fmt"
-	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
-	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
 	"github.com/google/uuid"
 )
 
 // CreateExpectation creates a new expectation.
-func (s *AmqpMockServerServiceServer) CreateExpectation(_ context.Context, req *grpcApi.CreateExpectationRequest) (*grpcApi.CreateExpectationResponse, error) {
-	request, err := newExpectationsRequest(req.Request)
+func (s *AmqpMockServerServiceServer) CreateExpectation(ctx context.Context, req *grpcApi.CreateExpectationRequest) (*grpcApi.CreateExpectationResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create expectation request: %w", err)
+		return nil, err
 	}
 
-	response, err := newExpectationsResponse(req.Response)
+	sessionID, err := s.sessionFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create expectation response: %w", err)
+		return nil, err
 	}
 
-	exp, err := expectations.NewExpectation(request, response, newExpectationOptions(req)...)
+	exp, err := mapper.NewExpectation(req, expectations.WithSessionID(sessionID))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create expectation: %w", err)
+		return nil, err
 	}
 
-	err = s.expectationsService.Create(exp)
+	err = expSvc.Create(exp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create expectation: %w", err)
 	}
 
+	if err := s.checkSessionOpen(sessionID); err != nil {
+		_ = expSvc.Delete(exp.ID)
+		return nil, err
+	}
+
 	return &grpcApi.CreateExpectationResponse{
 		ExpectationId: exp.ID.String(),
 	}, nil
 }
 
+// UpdateExpectation replaces the definition of an expectation.
+func (s *AmqpMockServerServiceServer) UpdateExpectation(ctx context.Context, req *grpcApi.UpdateExpectationRequest) (*grpcApi.UpdateExpectationResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expUID, err := uuid.Parse(req.ExpectationId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expectation id: %w", err)
+	}
+
+	if req.GetExpectation() == nil {
+		return nil, fmt.Errorf("expectation is required")
+	}
+
+	exp, err := mapper.NewExpectation(req.Expectation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := expSvc.Update(expUID, exp); err != nil {
+		return nil, fmt.Errorf("failed to update expectation: %w", err)
+	}
+
+	return &grpcApi.UpdateExpectationResponse{}, nil
+}
+
+// UpsertExpectation replaces the expectation with the given ID or name, or creates it.
+func (s *AmqpMockServerServiceServer) UpsertExpectation(ctx context.Context, req *grpcApi.UpsertExpectationRequest) (*grpcApi.UpsertExpectationResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetExpectation() == nil {
+		return nil, fmt.Errorf("expectation is required")
+	}
+
+	if req.ExpectationId == nil && req.Expectation.GetName() == "" {
+		return nil, fmt.Errorf("expectation id or name is required")
+	}
+
+	sessionID, err := s.sessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []expectations.ExpectationOption{expectations.WithSessionID(sessionID)}
+	if req.ExpectationId != nil {
+		expUID, err := uuid.Parse(req.GetExpectationId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid expectation id: %w", err)
+		}
+		opts = append(opts, expectations.WithID(expUID))
+	}
+
+	exp, err := mapper.NewExpectation(req.Expectation, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := expSvc.Upsert(exp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert expectation: %w", err)
+	}
+
+	if err := s.checkSessionOpen(sessionID); err != nil {
+		_ = expSvc.Delete(exp.ID)
+		return nil, err
+	}
+
+	return &grpcApi.UpsertExpectationResponse{
+		ExpectationId: exp.ID.String(),
+		Created:       created,
+	}, nil
+}
+
+// DeleteExpectation removes a single expectation.
+func (s *AmqpMockServerServiceServer) DeleteExpectation(ctx context.Context, req *grpcApi.DeleteExpectationRequest) (*grpcApi.DeleteExpectationResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expUID, err := uuid.Parse(req.ExpectationId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expectation id: %w", err)
+	}
+
+	if err := expSvc.Delete(expUID); err != nil {
+		return nil, fmt.Errorf("failed to delete expectation: %w", err)
+	}
+
+	return &grpcApi.DeleteExpectationResponse{}, nil
+}
+
 // ResetExpectations removes all expectations from the service.
-func (s *AmqpMockServerServiceServer) ResetExpectations(_ context.Context, _ *grpcApi.ResetExpectationsRequest) (*grpcApi.ResetExpectationsResponse, error) {
-	s.expectationsService.Reset()
+func (s *AmqpMockServerServiceServer) ResetExpectations(ctx context.Context, req *grpcApi.ResetExpectationsRequest) (*grpcApi.ResetExpectationsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LabelSelector == nil {
+		expSvc.Reset()
+		return &grpcApi.ResetExpectationsResponse{}, nil
+	}
+
+	selector, err := newLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty selector would select everything, the plain reset does that already
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("%w: the selector is empty", expectations.ErrInvalidLabelSelector)
+	}
+
+	expSvc.DeleteBySelector(selector)
+
 	return &grpcApi.ResetExpectationsResponse{}, nil
 }
 
 // GetExpectations returns list of expectations.
-func (s *AmqpMockServerServiceServer) GetExpectations(_ context.Context, req *grpcApi.GetExpectationsRequest) (*grpcApi.GetExpectationsResponse, error) {
+func (s *AmqpMockServerServiceServer) GetExpectations(ctx context.Context, req *grpcApi.GetExpectationsRequest) (*grpcApi.GetExpectationsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := newLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	appReq := app.GetExpectationsRequest{
-		Status: req.Status,
+		Status:   req.Status,
+		Selector: selector,
 	}
 
-	exps := s.expectationsService.GetExpectations(appReq)
+	exps := expSvc.GetExpectations(appReq)
 
 	expDTOs := make([]*grpcApi.Expectation, 0, len(exps))
 	for _, exp := range exps {
-		expDTOs = append(expDTOs, newProtoExpectation(exp))
+		expDTOs = append(expDTOs, mapper.NewProtoExpectation(exp))
 	}
 
 	return &grpcApi.GetExpectationsResponse{
@@ -64,89 +197,99 @@ func (s *AmqpMockServerServiceServer) GetExpectations(_ context.Context, req *gr
 }
 
 // GetExpectation returns a single expectation.
-func (s *AmqpMockServerServiceServer) GetExpectation(_ context.Context, req *grpcApi.GetExpectationRequest) (*grpcApi.GetExpectationResponse, error) {
+func (s *AmqpMockServerServiceServer) GetExpectation(ctx context.Context, req *grpcApi.GetExpectationRequest) (*grpcApi.GetExpectationResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	expUID, err := uuid.Parse(req.ExpectationId)
 	if err != nil {
 		return nil, fmt.Errorf("invalid expectation id: %w", err)
 	}
 
-	exp := s.expectationsService.GetExpectation(expUID)
+	exp := expSvc.GetExpectation(expUID)
 	if exp == nil {
 		return nil, fmt.Errorf("expectation not found")
 	}
 
 	return &grpcApi.GetExpectationResponse{
-		Expectation: newProtoExpectation(exp),
+		Expectation: mapper.NewProtoExpectation(exp),
 	}, nil
 }
 
-func newExpectationsRequest(req *grpcApi.Request) (*expectations.Request, error) {
-	comparator, err := newComparator(req)
+// SimulateMatch tells which expectations a request would match, without side effects.
+func (s *AmqpMockServerServiceServer) SimulateMatch(ctx context.Context, req *grpcApi.SimulateMatchRequest) (*grpcApi.SimulateMatchResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create body comparator: %w", err)
+		return nil, err
 	}
 
-	request, err := expectations.NewRequest(req.Exchange, req.RoutingKey, comparator)
+	candidate, err := newSimulatedCandidate(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create expectation request: %w", err)
+		return nil, fmt.Errorf("failed to create candidate: %w", err)
 	}
 
-	return request, nil
-}
+	sim := expSvc.SimulateMatch(candidate)
 
-func newExpectationsResponse(res *grpcApi.Response) (*expectations.Response, error) {
-	resBodyJSON, err := res.Body.MarshalJSON()
-	if err != nil {
-		return nil, fmt.Errorf("unable to read expectation response JSON body: %w", err)
+	resp := &grpcApi.SimulateMatchResponse{
+		Matched: len(sim.Matches) > 0,
 	}
 
-	response, err := expectations.NewResponse(resBodyJSON)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create expectation response: %w", err)
+	for _, exp := range sim.Matches {
+		resp.Matches = append(resp.Matches, mapper.NewProtoExpectation(exp))
 	}
 
-	return response, nil
-}
-
-func newExpectationOptions(req *grpcApi.CreateExpectationRequest) []expectations.ExpectationOption {
-	expOpts := make([]expectations.ExpectationOption, 0)
-
-	if req.GetTimes() != nil {
-		if req.GetTimes().GetRemainingTimes() > 0 {
-			expOpts = append(expOpts, expectations.WithLimitedTimes(req.GetTimes().GetRemainingTimes()))
-		} else {
-			expOpts = append(expOpts, expectations.WithUnlimitedTimes())
-		}
+	if resp.Matched {
+		resp.Expectation = resp.Matches[0]
 	}
 
-	if req.TimeToLiveSeconds != nil {
-		expOpts = append(expOpts, expectations.WithTimeToLive(time.Duration(float64(*req.TimeToLiveSeconds)*float64(time.Second))))
+	for _, skipped := range sim.Skipped {
+		resp.Skipped = append(resp.Skipped, &grpcApi.SimulateMatchResponse_SkippedExpectation{
+			Expectation: mapper.NewProtoExpectation(skipped.Expectation),
+			Reasons:     skipped.Reasons,
+		})
 	}
 
-	return expOpts
+	return resp, nil
 }
 
-// nolint: ireturn
-func newComparator(req *grpcApi.Request) (expectations.BodyComparator, error) {
-	switch body := req.GetBody().(type) {
-	case *grpcApi.Request_JsonBody:
-		matchType := comparators.MatchTypeExact
-		switch body.JsonBody.GetMatchType() {
-		case grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT:
-			matchType = comparators.MatchTypeExact
-		case grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL:
-			matchType = comparators.MatchTypePartial
+func newSimulatedCandidate(req *grpcApi.SimulateMatchRequest) (*expectations.Candidate, error) {
+	var body []byte
+	if req.RawBody != nil {
+		body = []byte(req.GetRawBody())
+	} else if req.GetBody() != nil {
+		var err error
+		if body, err = req.GetBody().MarshalJSON(); err != nil {
+			return nil, fmt.Errorf("unable to read JSON body: %w", err)
 		}
+	}
 
-		rawBody, err := body.JsonBody.Body.MarshalJSON()
-		if err != nil {
-			return nil, fmt.Errorf("unable to read expectation request JSON body: %w", err)
-		}
+	props := req.GetProperties()
 
-		return comparators.NewJSONBody(rawBody, matchType)
-	case *grpcApi.Request_RegexBody:
-		return comparators.NewRegex(body.RegexBody.GetRegex())
-	default:
-		return nil, fmt.Errorf("unsupported request body type: %T", body)
+	return expectations.NewCandidate(req.GetExchange(), req.GetRoutingKey(), body,
+		expectations.WithCandidateHeaders(req.GetHeaders()),
+		expectations.WithCandidateProperties(expectations.Properties{
+			ContentType:     props.GetContentType(),
+			ContentEncoding: props.GetContentEncoding(),
+			DeliveryMode:    uint8(props.GetDeliveryMode()), // nolint: gosec
+			Priority:        uint8(props.GetPriority()),     // nolint: gosec
+			CorrelationID:   props.GetCorrelationId(),
+			ReplyTo:         props.GetReplyTo(),
+			Expiration:      props.GetExpiration(),
+			MessageID:       props.GetMessageId(),
+			Type:            props.GetType(),
+			UserID:          props.GetUserId(),
+			AppID:           props.GetAppId(),
+		}),
+	)
+}
+
+// newLabelSelector parses the label selector of a request, nil if it is not set.
+func newLabelSelector(selector *string) (expectations.LabelSelector, error) {
+	if selector == nil {
+		return nil, nil // nolint: nilnil
 	}
+
+	return expectations.ParseLabelSelector(*selector)
 }
@@ -2,13 +2,10 @@ package grpc
 
 import (
 	"context"
-	"encoding/json"
 	"testing"
-	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
-	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,278 +13,10 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-// TestNewExpectationsRequest tests the newExpectationsRequest function
-func TestNewExpectationsRequest(t *testing.T) {
-	// Create a proto request with JSON body
-	protoReq := &grpcApi.Request{
-		Exchange:   "test-exchange",
-		RoutingKey: "test-routing-key",
-		Body: &grpcApi.Request_JsonBody{
-			JsonBody: &grpcApi.JSONBodyAssertion{
-				MatchType: grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
-				Body:      createJSONStruct(t, `{"foo":"bar"}`),
-			},
-		},
-	}
-
-	// Convert to domain request
-	domainReq, err := newExpectationsRequest(protoReq)
-
-	// Verify the conversion
-	require.NoError(t, err)
-	assert.Equal(t, "test-exchange", domainReq.Exchange)
-	assert.Equal(t, "test-routing-key", domainReq.RoutingKey)
-
-	// Verify the body comparator
-	jsonBody, ok := domainReq.BodyComparator.(*comparators.JSONBody)
-	require.True(t, ok, "Expected JSONBody comparator")
-	assert.Equal(t, comparators.MatchTypePartial, jsonBody.MatchType)
-
-	// Create a proto request with Regex body
-	protoReq = &grpcApi.Request{
-		Exchange:   "test-exchange",
-		RoutingKey: "test-routing-key",
-		Body: &grpcApi.Request_RegexBody{
-			RegexBody: &grpcApi.RegexBodyAssertion{
-				Regex: "foo.*bar",
-			},
-		},
-	}
-
-	// Convert to domain request
-	domainReq, err = newExpectationsRequest(protoReq)
-
-	// Verify the conversion
-	require.NoError(t, err)
-	assert.Equal(t, "test-exchange", domainReq.Exchange)
-	assert.Equal(t, "test-routing-key", domainReq.RoutingKey)
-
-	// Verify the body comparator
-	regexBody, ok := domainReq.BodyComparator.(*comparators.Regex)
-	require.True(t, ok, "Expected Regex comparator")
-	assert.Equal(t, "foo.*bar", regexBody.Regex.String())
-}
-
-// TestNewExpectationsResponse tests the newExpectationsResponse function
-func TestNewExpectationsResponse(t *testing.T) {
-	// Create a proto response
-	protoRes := &grpcApi.Response{
-		Body: createJSONValue(t, `{"result":"success"}`),
-	}
-
-	// Convert to domain response
-	domainRes, err := newExpectationsResponse(protoRes)
-
-	// Verify the conversion
-	require.NoError(t, err)
-	assert.JSONEq(t, `{"result":"success"}`, string(domainRes.Body))
-}
-
-// TestNewExpectationOptions tests the newExpectationOptions function
-func TestNewExpectationOptions(t *testing.T) {
-	t.Run("with limited times", func(t *testing.T) {
-		// Create a proto request with limited times
-		times := uint32(5)
-		protoReq := &grpcApi.CreateExpectationRequest{
-			Times: &grpcApi.Times{
-				Times: &grpcApi.Times_RemainingTimes{
-					RemainingTimes: times,
-				},
-			},
-		}
-
-		// Get the options
-		options := newExpectationOptions(protoReq)
-
-		// Create an expectation with these options
-		exp, err := createTestExpectation("exchange", "rk", options...)
-		require.NoError(t, err)
-
-		// Verify the times
-		assert.NotNil(t, exp.Times)
-		assert.False(t, exp.Times.Unlimited)
-		assert.Equal(t, times, exp.Times.RemainingTimes)
-	})
-
-	t.Run("with unlimited times", func(t *testing.T) {
-		// Create a proto request with unlimited times
-		protoReq := &grpcApi.CreateExpectationRequest{
-			Times: &grpcApi.Times{
-				Times: &grpcApi.Times_Unlimited{
-					Unlimited: true,
-				},
-			},
-		}
-
-		// Get the options
-		options := newExpectationOptions(protoReq)
-
-		// Create an expectation with these options
-		exp, err := createTestExpectation("exchange", "rk", options...)
-		require.NoError(t, err)
-
-		// Verify the times
-		assert.NotNil(t, exp.Times)
-		assert.True(t, exp.Times.Unlimited)
-	})
-
-	t.Run("with TTL", func(t *testing.T) {
-		// Create a proto request with TTL
-		ttlSeconds := float32(60)
-		protoReq := &grpcApi.CreateExpectationRequest{
-			TimeToLiveSeconds: &ttlSeconds,
-		}
-
-		// Get the options
-		options := newExpectationOptions(protoReq)
-
-		// Create an expectation with these options
-		exp, err := createTestExpectation("exchange", "rk", options...)
-		require.NoError(t, err)
-
-		// Verify the TTL
-		assert.NotNil(t, exp.TimeToLive)
-		assert.Equal(t, time.Duration(float64(ttlSeconds)*float64(time.Second)), exp.TimeToLive.TTL)
-	})
-}
-
-// TestNewComparator tests the newComparator function
-func TestNewComparator(t *testing.T) {
-	t.Run("with JSON body", func(t *testing.T) {
-		// Create a proto request with JSON body
-		protoReq := &grpcApi.Request{
-			Body: &grpcApi.Request_JsonBody{
-				JsonBody: &grpcApi.JSONBodyAssertion{
-					MatchType: grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
-					Body:      createJSONStruct(t, `{"foo":"bar"}`),
-				},
-			},
-		}
-
-		// Create a comparator
-		comparator, err := newComparator(protoReq)
-
-		// Verify the comparator
-		require.NoError(t, err)
-		jsonBody, ok := comparator.(*comparators.JSONBody)
-		require.True(t, ok, "Expected JSONBody comparator")
-		assert.Equal(t, comparators.MatchTypePartial, jsonBody.MatchType)
-	})
-
-	t.Run("with Regex body", func(t *testing.T) {
-		// Create a proto request with Regex body
-		protoReq := &grpcApi.Request{
-			Body: &grpcApi.Request_RegexBody{
-				RegexBody: &grpcApi.RegexBodyAssertion{
-					Regex: "foo.*bar",
-				},
-			},
-		}
-
-		// Create a comparator
-		comparator, err := newComparator(protoReq)
-
-		// Verify the comparator
-		require.NoError(t, err)
-		regexBody, ok := comparator.(*comparators.Regex)
-		require.True(t, ok, "Expected Regex comparator")
-		assert.Equal(t, "foo.*bar", regexBody.Regex.String())
-	})
-}
-
-// Helper function to create a test expectation
-func createTestExpectation(exchange, routingKey string, opts ...expectations.ExpectationOption) (*expectations.Expectation, error) {
-	// Create a body comparator
-	bodyComparator, err := comparators.NewJSONBody([]byte(`{"foo":"bar"}`), comparators.MatchTypePartial)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create a request
-	request, err := expectations.NewRequest(exchange, routingKey, bodyComparator)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create a response
-	response, err := expectations.NewResponse([]byte(`{"result":"success"}`))
-	if err != nil {
-		return nil, err
-	}
-
-	// Create an expectation
-	return expectations.NewExpectation(request, response, opts...)
-}
-
-// Helper function to create a JSON struct for testing
-func createJSONStruct(t *testing.T, jsonStr string) *structpb.Struct {
-	t.Helper()
-
-	var v map[string]interface{}
-	err := json.Unmarshal([]byte(jsonStr), &v)
-	require.NoError(t, err)
-
-	pbValue, err := structpb.NewStruct(v)
-	require.NoError(t, err)
-
-	return pbValue
-}
-
-// Helper function to create a JSON value for testing
-func createJSONValue(t *testing.T, jsonStr string) *structpb.Value {
-	t.Helper()
-
-	var v interface{}
-	err := json.Unmarshal([]byte(jsonStr), &v)
-	require.NoError(t, err)
-
-	pbValue, err := structpb.NewValue(v)
-	require.NoError(t, err)
-
-	return pbValue
-}
-
-// MockExpectationsService is a simple implementation of the ExpectationsService interface for testing
-type MockExpectationsService struct {
-	expectations []*expectations.Expectation
-	resetCalled  bool
-}
-
-func (s *MockExpectationsService) Create(exp *expectations.Expectation) error {
-	s.expectations = append(s.expectations, exp)
-	return nil
-}
-
-func (s *MockExpectationsService) Reset() {
-	s.resetCalled = true
-	s.expectations = nil
-}
-
-func (s *MockExpectationsService) Match(_ *expectations.Candidate) *expectations.Response {
-	return nil
-}
-
-func (s *MockExpectationsService) GetExpectations(_ app.GetExpectationsRequest) []*expectations.Expectation {
-	return s.expectations
-}
-
-func (s *MockExpectationsService) GetExpectation(id uuid.UUID) *expectations.Expectation {
-	for _, exp := range s.expectations {
-		if exp.ID == id {
-			return exp
-		}
-	}
-	return nil
-}
-
-func (s *MockExpectationsService) GetAssertions(_ app.GetAssertionsRequest) []*expectations.Assertion {
-	return nil
-}
-
 // TestCreateExpectation tests the CreateExpectation handler
 func TestCreateExpectation(t *testing.T) {
 	// Create a mock expectations service
-	mockSvc := &MockExpectationsService{}
+	mockSvc := &TestExpectationsService{}
 
 	// Create the server with the mock service
 	server := &AmqpMockServerServiceServer{
@@ -309,6 +38,7 @@ func TestCreateExpectation(t *testing.T) {
 		Response: &grpcApi.Response{
 			Body: createJSONValue(t, `{"result":"success"}`),
 		},
+		Priority: 5,
 	}
 
 	// Call the handler
@@ -323,12 +53,13 @@ func TestCreateExpectation(t *testing.T) {
 	assert.Len(t, mockSvc.expectations, 1)
 	assert.Equal(t, "test-exchange", mockSvc.expectations[0].Request.Exchange)
 	assert.Equal(t, "test-routing-key", mockSvc.expectations[0].Request.RoutingKey)
+	assert.Equal(t, 5, mockSvc.expectations[0].Priority)
 }
 
 // TestResetExpectations tests the ResetExpectations handler
 func TestResetExpectations(t *testing.T) {
 	// Create a mock expectations service
-	mockSvc := &MockExpectationsService{}
+	mockSvc := &TestExpectationsService{}
 
 	// Create the server with the mock service
 	server := &AmqpMockServerServiceServer{
@@ -346,19 +77,93 @@ func TestResetExpectations(t *testing.T) {
 	assert.True(t, mockSvc.resetCalled)
 }
 
+// TestUpdateUpsertDeleteExpectation tests the handlers changing a single expectation
+func TestUpdateUpsertDeleteExpectation(t *testing.T) {
+	// Create the server with a real expectations service
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	newRequest := func(body, name string) *grpcApi.CreateExpectationRequest {
+		req := newTestCreateExpectationRequest(t, "orders", "order.get")
+		req.Request.Body = &grpcApi.Request_RegexBody{RegexBody: &grpcApi.RegexBodyAssertion{Regex: ".*"}}
+		req.Response.Body = createJSONValue(t, body)
+		req.Name = name
+		return req
+	}
+
+	// Upsert requires an ID or a name
+	_, err := server.UpsertExpectation(context.Background(), &grpcApi.UpsertExpectationRequest{Expectation: newRequest(`{}`, "")})
+	require.Error(t, err)
+
+	// Upsert with a client-supplied ID creates the expectation
+	id := uuid.NewString()
+	upserted, err := server.UpsertExpectation(context.Background(), &grpcApi.UpsertExpectationRequest{
+		ExpectationId: &id,
+		Expectation:   newRequest(`{"v":1}`, ""),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id, upserted.ExpectationId)
+	assert.True(t, upserted.Created)
+
+	// Upsert by name creates and then replaces the expectation
+	upserted, err = server.UpsertExpectation(context.Background(), &grpcApi.UpsertExpectationRequest{Expectation: newRequest(`{"v":1}`, "named")})
+	require.NoError(t, err)
+	assert.True(t, upserted.Created)
+	namedID := upserted.ExpectationId
+
+	upserted, err = server.UpsertExpectation(context.Background(), &grpcApi.UpsertExpectationRequest{Expectation: newRequest(`{"v":2}`, "named")})
+	require.NoError(t, err)
+	assert.False(t, upserted.Created)
+	assert.Equal(t, namedID, upserted.ExpectationId)
+
+	// Update replaces the definition
+	_, err = server.UpdateExpectation(context.Background(), &grpcApi.UpdateExpectationRequest{
+		ExpectationId: id,
+		Expectation:   newRequest(`{"v":3}`, ""),
+	})
+	require.NoError(t, err)
+
+	got, err := server.GetExpectation(context.Background(), &grpcApi.GetExpectationRequest{ExpectationId: id})
+	require.NoError(t, err)
+	body, err := got.Expectation.Response.Body.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"v":3}`, string(body))
+
+	// Update of an unknown expectation fails
+	_, err = server.UpdateExpectation(context.Background(), &grpcApi.UpdateExpectationRequest{
+		ExpectationId: uuid.NewString(),
+		Expectation:   newRequest(`{}`, ""),
+	})
+	require.ErrorIs(t, err, app.ErrExpectationNotFound)
+
+	// Delete removes only the given expectation
+	_, err = server.DeleteExpectation(context.Background(), &grpcApi.DeleteExpectationRequest{ExpectationId: id})
+	require.NoError(t, err)
+
+	_, err = server.DeleteExpectation(context.Background(), &grpcApi.DeleteExpectationRequest{ExpectationId: id})
+	require.ErrorIs(t, err, app.ErrExpectationNotFound)
+
+	_, err = server.DeleteExpectation(context.Background(), &grpcApi.DeleteExpectationRequest{ExpectationId: "invalid"})
+	require.Error(t, err)
+
+	exps := expSvc.GetExpectations(app.GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, namedID, exps[0].ID.String())
+}
+
 // TestGetExpectations tests the GetExpectations handler
 func TestGetExpectations(t *testing.T) {
 	// Create a mock expectations service with some test expectations
-	mockSvc := &MockExpectationsService{}
+	mockSvc := &TestExpectationsService{}
 
 	// Add some test expectations
-	exp1, err := createTestExpectation("exchange1", "rk1")
-	require.NoError(t, err)
-	err = mockSvc.Create(exp1)
+	exp1 := newTestExpectation(t, "exchange1", "rk1")
+	err := mockSvc.Create(exp1)
 	require.NoError(t, err)
 
-	exp2, err := createTestExpectation("exchange2", "rk2")
-	require.NoError(t, err)
+	exp2 := newTestExpectation(t, "exchange2", "rk2")
 	err = mockSvc.Create(exp2)
 	require.NoError(t, err)
 
@@ -383,12 +188,11 @@ func TestGetExpectations(t *testing.T) {
 // TestGetExpectation tests the GetExpectation handler
 func TestGetExpectation(t *testing.T) {
 	// Create a mock expectations service with a test expectation
-	mockSvc := &MockExpectationsService{}
+	mockSvc := &TestExpectationsService{}
 
 	// Add a test expectation
-	exp, err := createTestExpectation("exchange", "rk")
-	require.NoError(t, err)
-	err = mockSvc.Create(exp)
+	exp := newTestExpectation(t, "exchange", "rk")
+	err := mockSvc.Create(exp)
 	require.NoError(t, err)
 
 	// Create the server with the mock service
@@ -423,3 +227,106 @@ func TestGetExpectation(t *testing.T) {
 	// Verify the error
 	require.Error(t, err)
 }
+
+// TestSimulateMatch tests the SimulateMatch handler
+func TestSimulateMatch(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	low := newTestExpectation(t, "exchange", "rk")
+	high := newTestExpectation(t, "exchange", "rk", expectations.WithPriority(5))
+	exhausted := newTestExpectation(t, "exchange", "rk", expectations.WithLimitedTimes(1))
+	exhausted.Use()
+	for _, exp := range []*expectations.Expectation{low, high, exhausted} {
+		require.NoError(t, expSvc.Create(exp))
+	}
+
+	body, err := structpb.NewValue(map[string]any{"foo": "bar", "id": 1})
+	require.NoError(t, err)
+
+	resp, err := server.SimulateMatch(context.Background(), &grpcApi.SimulateMatchRequest{
+		Exchange:   "exchange",
+		RoutingKey: "rk",
+		Body:       body,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Matched)
+	assert.Equal(t, high.ID.String(), resp.Expectation.Id)
+	require.Len(t, resp.Matches, 2)
+	assert.Equal(t, high.ID.String(), resp.Matches[0].Id)
+	assert.Equal(t, low.ID.String(), resp.Matches[1].Id)
+	require.Len(t, resp.Skipped, 1)
+	assert.Equal(t, exhausted.ID.String(), resp.Skipped[0].Expectation.Id)
+	assert.Equal(t, []string{"times exhausted"}, resp.Skipped[0].Reasons)
+
+	// The expectations were not used
+	assert.Equal(t, uint32(1), expSvc.GetExpectation(high.ID).Times.RemainingTimes)
+
+	// A body which is not JSON matches nothing here
+	rawBody := "not json"
+	resp, err = server.SimulateMatch(context.Background(), &grpcApi.SimulateMatchRequest{
+		Exchange:   "exchange",
+		RoutingKey: "rk",
+		RawBody:    &rawBody,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Matched)
+	assert.Nil(t, resp.Expectation)
+
+	// Invalid requests
+	_, err = server.SimulateMatch(context.Background(), &grpcApi.SimulateMatchRequest{RoutingKey: "rk"})
+	require.Error(t, err)
+}
+
+// TestExpectationLabels tests the handlers listing and resetting expectations by label selector
+func TestExpectationLabels(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	newRequest := func(labels map[string]string) *grpcApi.CreateExpectationRequest {
+		req := newTestCreateExpectationRequest(t, "exchange", "rk")
+		req.Labels = labels
+		return req
+	}
+	create := func(labels map[string]string) string {
+		resp, err := server.CreateExpectation(context.Background(), newRequest(labels))
+		require.NoError(t, err)
+		return resp.ExpectationId
+	}
+
+	checkout := create(map[string]string{"suite": "checkout", "team": "payments"})
+	create(map[string]string{"suite": "search"})
+	unlabelled := create(nil)
+
+	_, err := server.CreateExpectation(context.Background(), newRequest(map[string]string{"suite": "not valid"}))
+	require.ErrorIs(t, err, expectations.ErrInvalidLabel)
+
+	selector := "suite=checkout"
+	resp, err := server.GetExpectations(context.Background(), &grpcApi.GetExpectationsRequest{LabelSelector: &selector})
+	require.NoError(t, err)
+	require.Len(t, resp.Expectations, 1)
+	assert.Equal(t, checkout, resp.Expectations[0].Id)
+	assert.Equal(t, map[string]string{"suite": "checkout", "team": "payments"}, resp.Expectations[0].Labels)
+
+	selector = "suite in ("
+	_, err = server.GetExpectations(context.Background(), &grpcApi.GetExpectationsRequest{LabelSelector: &selector})
+	require.ErrorIs(t, err, expectations.ErrInvalidLabelSelector)
+
+	// an empty selector is refused rather than resetting everything
+	selector = ""
+	_, err = server.ResetExpectations(context.Background(), &grpcApi.ResetExpectationsRequest{LabelSelector: &selector})
+	require.ErrorIs(t, err, expectations.ErrInvalidLabelSelector)
+
+	selector = "suite in (checkout, search)"
+	_, err = server.ResetExpectations(context.Background(), &grpcApi.ResetExpectationsRequest{LabelSelector: &selector})
+	require.NoError(t, err)
+
+	resp, err = server.GetExpectations(context.Background(), &grpcApi.GetExpectationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Expectations, 1)
+	assert.Equal(t, unlabelled, resp.Expectations[0].Id)
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+)
+
+// GetDefaultResponse returns the response published for unmatched requests.
+func (s *AmqpMockServerServiceServer) GetDefaultResponse(_ context.Context, _ *grpcApi.GetDefaultResponseRequest) (*grpcApi.GetDefaultResponseResponse, error) {
+	return &grpcApi.GetDefaultResponseResponse{
+		Response: mapper.NewProtoResponse(s.fallbackService.DefaultResponse()),
+	}, nil
+}
+
+// SetDefaultResponse replaces the response published for unmatched requests.
+func (s *AmqpMockServerServiceServer) SetDefaultResponse(_ context.Context, req *grpcApi.SetDefaultResponseRequest) (*grpcApi.SetDefaultResponseResponse, error) {
+	if req.GetResponse() == nil {
+		return nil, fmt.Errorf("response is required")
+	}
+
+	response, err := mapper.NewResponse(req.GetResponse())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create default response: %w", err)
+	}
+
+	s.fallbackService.SetDefaultResponse(response)
+
+	return &grpcApi.SetDefaultResponseResponse{}, nil
+}
+
+// ResetDefaultResponse restores the built-in response for unmatched requests.
+func (s *AmqpMockServerServiceServer) ResetDefaultResponse(_ context.Context, _ *grpcApi.ResetDefaultResponseRequest) (*grpcApi.ResetDefaultResponseResponse, error) {
+	s.fallbackService.ResetDefaultResponse()
+	return &grpcApi.ResetDefaultResponseResponse{}, nil
+}
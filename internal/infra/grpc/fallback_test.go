@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDefaultResponse tests the default response handlers
+func TestDefaultResponse(t *testing.T) {
+	// Create the server with a real fallback service
+	server := &AmqpMockServerServiceServer{
+		fallbackService: app.NewFallbackService(subscriptions.FallbackPolicyReply),
+	}
+
+	// The built-in response is returned by default
+	resp, err := server.GetDefaultResponse(context.Background(), &grpcApi.GetDefaultResponseRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "no match found", resp.Response.Body.GetStructValue().Fields["errors"].GetStringValue())
+
+	// Replace the default response
+	_, err = server.SetDefaultResponse(context.Background(), &grpcApi.SetDefaultResponseRequest{
+		Response: &grpcApi.Response{
+			Body:    createJSONValue(t, `{"error":{"code":"NOT_FOUND"}}`),
+			Headers: map[string]string{"x-error": "true"},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err = server.GetDefaultResponse(context.Background(), &grpcApi.GetDefaultResponseRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "NOT_FOUND", resp.Response.Body.GetStructValue().Fields["error"].GetStructValue().Fields["code"].GetStringValue())
+	assert.Equal(t, map[string]string{"x-error": "true"}, resp.Response.Headers)
+
+	// A response is required
+	_, err = server.SetDefaultResponse(context.Background(), &grpcApi.SetDefaultResponseRequest{})
+	require.Error(t, err)
+
+	// Restore the built-in response
+	_, err = server.ResetDefaultResponse(context.Background(), &grpcApi.ResetDefaultResponseRequest{})
+	require.NoError(t, err)
+
+	resp, err = server.GetDefaultResponse(context.Background(), &grpcApi.GetDefaultResponseRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Response.Headers)
+}
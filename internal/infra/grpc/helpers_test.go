@@ -0,0 +1,190 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestExpectationsService is a simple implementation of the ExpectationsService interface for testing.
+// It keeps the created expectations and serves the given assertions, the other methods do nothing.
+type TestExpectationsService struct {
+	expectations          []*expectations.Expectation
+	assertions            []*expectations.Assertion
+	resetCalled           bool
+	resetAssertionsCalled bool
+}
+
+func (s *TestExpectationsService) Create(exp *expectations.Expectation) error {
+	s.expectations = append(s.expectations, exp)
+	return nil
+}
+
+func (s *TestExpectationsService) Update(_ uuid.UUID, _ *expectations.Expectation) error {
+	return nil
+}
+
+func (s *TestExpectationsService) Upsert(exp *expectations.Expectation) (bool, error) {
+	s.expectations = append(s.expectations, exp)
+	return true, nil
+}
+
+func (s *TestExpectationsService) Delete(_ uuid.UUID) error {
+	return nil
+}
+
+func (s *TestExpectationsService) Reset() {
+	s.resetCalled = true
+	s.expectations = nil
+}
+
+func (s *TestExpectationsService) Match(_ *expectations.Candidate) *expectations.Expectation {
+	return nil
+}
+
+func (s *TestExpectationsService) GetExpectations(_ app.GetExpectationsRequest) []*expectations.Expectation {
+	return s.expectations
+}
+
+func (s *TestExpectationsService) GetExpectation(id uuid.UUID) *expectations.Expectation {
+	for _, exp := range s.expectations {
+		if exp.ID == id {
+			return exp
+		}
+	}
+	return nil
+}
+
+func (s *TestExpectationsService) GetAssertions(req app.GetAssertionsRequest) (*app.AssertionsPage, error) {
+	// Filter assertions based on the request query
+	var result []*expectations.Assertion
+	for _, a := range s.assertions {
+		if req.Query.Matches(a) {
+			result = append(result, a)
+		}
+	}
+
+	return &app.AssertionsPage{Assertions: result}, nil
+}
+
+func (s *TestExpectationsService) Verify(_ []app.VerifyRequest) ([]*expectations.VerificationResult, error) {
+	return nil, nil
+}
+
+func (s *TestExpectationsService) VerifySequence(_ []*expectations.Request, _ bool) (*expectations.SequenceResult, error) {
+	return nil, nil
+}
+
+func (s *TestExpectationsService) WaitForAssertions(_ context.Context, _ expectations.AssertionsQuery, _ int) ([]*expectations.Assertion, error) {
+	return nil, nil
+}
+
+func (s *TestExpectationsService) ResetAssertions() {
+	s.resetAssertionsCalled = true
+}
+
+func (s *TestExpectationsService) AssertionsStats() app.AssertionsStats {
+	return app.AssertionsStats{}
+}
+
+func (s *TestExpectationsService) GetAssertion(id uuid.UUID) (*expectations.Assertion, error) {
+	for _, a := range s.assertions {
+		if a.ID == id {
+			return a, nil
+		}
+	}
+
+	return nil, app.ErrAssertionNotFound
+}
+
+func (s *TestExpectationsService) SimulateMatch(_ *expectations.Candidate) *app.MatchSimulation {
+	return &app.MatchSimulation{}
+}
+
+func (s *TestExpectationsService) DeleteBySelector(_ expectations.LabelSelector) int {
+	return 0
+}
+
+func (s *TestExpectationsService) GetScenarios() []app.ScenarioState {
+	return nil
+}
+
+func (s *TestExpectationsService) GetScenario(name string) app.ScenarioState {
+	return app.ScenarioState{Name: name, State: expectations.ScenarioStateStarted}
+}
+
+func (s *TestExpectationsService) SetScenarioState(_, _ string) error {
+	return nil
+}
+
+func (s *TestExpectationsService) ResetScenario(_ string) {
+}
+
+func (s *TestExpectationsService) ResetScenarios() {
+}
+
+// newTestCreateExpectationRequest returns a request creating an expectation on the exchange and routing key,
+// matching the {"foo":"bar"} body partially.
+func newTestCreateExpectationRequest(t *testing.T, exchange, routingKey string) *grpcApi.CreateExpectationRequest {
+	t.Helper()
+
+	return &grpcApi.CreateExpectationRequest{
+		Request: &grpcApi.Request{
+			Exchange:   exchange,
+			RoutingKey: routingKey,
+			Body: &grpcApi.Request_JsonBody{
+				JsonBody: &grpcApi.JSONBodyAssertion{
+					MatchType: grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
+					Body:      createJSONStruct(t, `{"foo":"bar"}`),
+				},
+			},
+		},
+		Response: &grpcApi.Response{
+			Body: createJSONValue(t, `{"result":"success"}`),
+		},
+	}
+}
+
+// newTestExpectation creates the expectation of newTestCreateExpectationRequest.
+func newTestExpectation(t *testing.T, exchange, routingKey string, opts ...expectations.ExpectationOption) *expectations.Expectation {
+	t.Helper()
+
+	exp, err := mapper.NewExpectation(newTestCreateExpectationRequest(t, exchange, routingKey), opts...)
+	require.NoError(t, err)
+
+	return exp
+}
+
+func createJSONStruct(t *testing.T, jsonStr string) *structpb.Struct {
+	t.Helper()
+
+	var v map[string]interface{}
+	err := json.Unmarshal([]byte(jsonStr), &v)
+	require.NoError(t, err)
+
+	pbValue, err := structpb.NewStruct(v)
+	require.NoError(t, err)
+
+	return pbValue
+}
+
+func createJSONValue(t *testing.T, jsonStr string) *structpb.Value {
+	t.Helper()
+
+	var v interface{}
+	err := json.Unmarshal([]byte(jsonStr), &v)
+	require.NoError(t, err)
+
+	pbValue, err := structpb.NewValue(v)
+	require.NoError(t, err)
+
+	return pbValue
+}
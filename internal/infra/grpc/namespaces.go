@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+)
+
+// ListNamespaces lists the namespaces created so far, besides the default one.
+func (s *AmqpMockServerServiceServer) ListNamespaces(_ context.Context, _ *grpcApi.ListNamespacesRequest) (*grpcApi.ListNamespacesResponse, error) {
+	names := []string{}
+	if s.namespacesService != nil {
+		names = s.namespacesService.Names()
+	}
+
+	return &grpcApi.ListNamespacesResponse{
+		Namespaces: names,
+	}, nil
+}
+
+// DeleteNamespace removes the namespace and the subscriptions bound to it.
+func (s *AmqpMockServerServiceServer) DeleteNamespace(_ context.Context, req *grpcApi.DeleteNamespaceRequest) (*grpcApi.DeleteNamespaceResponse, error) {
+	if err := app.ValidateNamespace(req.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	if req.GetNamespace() == app.DefaultNamespace {
+		return nil, app.ErrDefaultNamespace
+	}
+
+	if err := s.subscriptionsService.UnsubscribeByNamespace(req.GetNamespace()); err != nil {
+		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	if s.namespacesService != nil {
+		if err := s.namespacesService.Delete(req.GetNamespace()); err != nil {
+			return nil, fmt.Errorf("failed to delete namespace: %w", err)
+		}
+	}
+
+	return &grpcApi.DeleteNamespaceResponse{}, nil
+}
@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// namespaceContext returns a context selecting the namespace, as the gateway does with the X-Mockserver-Namespace header
+func namespaceContext(namespace string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(app.NamespaceHeader, namespace))
+}
+
+// TestNamespaces tests that the handlers work on the namespace selected by the call
+func TestNamespaces(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	nsSvc := &TestNamespacesService{app.NewNamespaces(expSvc)}
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+		namespacesService:   nsSvc,
+	}
+
+	exp := newTestExpectation(t, "exchange", "rk")
+	require.NoError(t, nsSvc.Get("suite-a").Create(exp))
+
+	// the expectation is only visible in its namespace
+	resp, err := server.GetExpectations(namespaceContext("suite-a"), &grpcApi.GetExpectationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Expectations, 1)
+	assert.Equal(t, exp.ID.String(), resp.Expectations[0].Id)
+
+	resp, err = server.GetExpectations(context.Background(), &grpcApi.GetExpectationsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Expectations)
+
+	resp, err = server.GetExpectations(namespaceContext("suite-b"), &grpcApi.GetExpectationsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Expectations)
+
+	// the namespace is validated
+	_, err = server.GetExpectations(namespaceContext("not valid"), &grpcApi.GetExpectationsRequest{})
+	require.ErrorIs(t, err, app.ErrInvalidNamespace)
+
+	list, err := server.ListNamespaces(context.Background(), &grpcApi.ListNamespacesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"suite-a", "suite-b"}, list.Namespaces)
+}
+
+// TestAddSubscription_Namespace tests that subscriptions are bound to the namespace of the call, or the one of the request
+func TestAddSubscription_Namespace(t *testing.T) {
+	mockSvc := &TestSubscriptionsService{}
+	nsSvc := &TestNamespacesService{app.NewNamespaces(app.NewExpectationsService())}
+	server := &AmqpMockServerServiceServer{
+		subscriptionsService: mockSvc,
+		namespacesService:    nsSvc,
+	}
+
+	resp, err := server.AddSubscription(namespaceContext("suite-a"), &grpcApi.AddSubscriptionRequest{Queue: "queue"})
+	require.NoError(t, err)
+	assert.Equal(t, "suite-a", resp.Subscription.Namespace)
+
+	namespace := "suite-b"
+	resp, err = server.AddSubscription(namespaceContext("suite-a"), &grpcApi.AddSubscriptionRequest{Queue: "queue", Namespace: &namespace})
+	require.NoError(t, err)
+	assert.Equal(t, "suite-b", resp.Subscription.Namespace)
+	assert.Equal(t, "suite-b", mockSvc.subscriptions[1].Namespace())
+
+	// the namespaces of the subscriptions are created, the requests they receive cannot create them
+	assert.Equal(t, []string{"suite-a", "suite-b"}, nsSvc.Names())
+
+	namespace = "not valid"
+	_, err = server.AddSubscription(context.Background(), &grpcApi.AddSubscriptionRequest{Queue: "queue", Namespace: &namespace})
+	require.ErrorIs(t, err, app.ErrInvalidNamespace)
+}
+
+// TestSubscriptions_Namespace tests that the subscription handlers only see the subscriptions of the namespace
+// selected by the call
+func TestSubscriptions_Namespace(t *testing.T) {
+	mockSvc := &TestSubscriptionsService{}
+	server := &AmqpMockServerServiceServer{
+		subscriptionsService: mockSvc,
+	}
+
+	own := subscriptions.NewSubscription("queue", subscriptions.WithNamespace("suite-a"))
+	other := subscriptions.NewSubscription("queue", subscriptions.WithNamespace("suite-b"))
+	mockSvc.subscriptions = append(mockSvc.subscriptions, own, other)
+
+	list, err := server.GetAllSubscriptions(namespaceContext("suite-a"), &grpcApi.GetAllSubscriptionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Subscriptions, 1)
+	assert.Equal(t, own.ID().String(), list.Subscriptions[0].Id)
+
+	// the subscription of another namespace is not found
+	_, err = server.DeleteSubscription(namespaceContext("suite-a"), &grpcApi.DeleteSubscriptionRequest{SubscriptionId: other.ID().String()})
+	require.ErrorIs(t, err, app.ErrSubscriptionNotFound)
+
+	// unsubscribing from the queue leaves the subscription of the other namespace
+	_, err = server.UnsubscribeFromQueue(namespaceContext("suite-a"), &grpcApi.UnsubscribeFromQueueRequest{Queue: "queue"})
+	require.NoError(t, err)
+	require.Len(t, mockSvc.subscriptions, 1)
+	assert.Equal(t, other.ID(), mockSvc.subscriptions[0].ID())
+
+	_, err = server.DeleteSubscription(namespaceContext("suite-b"), &grpcApi.DeleteSubscriptionRequest{SubscriptionId: other.ID().String()})
+	require.NoError(t, err)
+	assert.Empty(t, mockSvc.subscriptions)
+}
+
+// TestResetAll_Namespace tests that the ResetAll handler only resets the namespace selected by the call
+func TestResetAll_Namespace(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	nsSvc := &TestNamespacesService{app.NewNamespaces(expSvc)}
+	subSvc := &TestSubscriptionsService{}
+	server := &AmqpMockServerServiceServer{
+		expectationsService:  expSvc,
+		subscriptionsService: subSvc,
+		namespacesService:    nsSvc,
+	}
+
+	for _, namespace := range []string{app.DefaultNamespace, "suite-a"} {
+		exp := newTestExpectation(t, "exchange", "rk")
+		require.NoError(t, nsSvc.Get(namespace).Create(exp))
+
+		_, err := subSvc.Subscribe("queue", false, subscriptions.WithNamespace(namespace))
+		require.NoError(t, err)
+	}
+
+	_, err := server.ResetAll(namespaceContext("suite-a"), &grpcApi.ResetAllRequest{})
+	require.NoError(t, err)
+
+	assert.Empty(t, nsSvc.Get("suite-a").GetExpectations(app.GetExpectationsRequest{}))
+	assert.Len(t, expSvc.GetExpectations(app.GetExpectationsRequest{}), 1)
+	require.Len(t, subSvc.subscriptions, 1)
+	assert.Equal(t, app.DefaultNamespace, subSvc.subscriptions[0].Namespace())
+}
+
+// TestDeleteNamespace tests the DeleteNamespace handler
+func TestDeleteNamespace(t *testing.T) {
+	nsSvc := &TestNamespacesService{app.NewNamespaces(app.NewExpectationsService())}
+	subSvc := &TestSubscriptionsService{}
+	server := &AmqpMockServerServiceServer{
+		subscriptionsService: subSvc,
+		namespacesService:    nsSvc,
+	}
+
+	nsSvc.Get("suite-a")
+	_, err := subSvc.Subscribe("queue", false, subscriptions.WithNamespace("suite-a"))
+	require.NoError(t, err)
+
+	_, err = server.DeleteNamespace(context.Background(), &grpcApi.DeleteNamespaceRequest{Namespace: "suite-a"})
+	require.NoError(t, err)
+	assert.Empty(t, nsSvc.Names())
+	assert.Empty(t, subSvc.subscriptions)
+
+	_, err = server.DeleteNamespace(context.Background(), &grpcApi.DeleteNamespaceRequest{})
+	require.ErrorIs(t, err, app.ErrDefaultNamespace)
+}
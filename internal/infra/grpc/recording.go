@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+)
+
+// StartRecording turns the record mode on.
+func (s *AmqpMockServerServiceServer) StartRecording(_ context.Context, req *grpcApi.StartRecordingRequest) (*grpcApi.StartRecordingResponse, error) {
+	var target *subscriptions.ProxyTarget
+	if req.GetTarget() != nil {
+		if req.GetTarget().GetExchange() == "" {
+			return nil, fmt.Errorf("recording target exchange is required")
+		}
+		target = &subscriptions.ProxyTarget{
+			Exchange:   req.GetTarget().GetExchange(),
+			RoutingKey: req.GetTarget().GetRoutingKey(),
+		}
+	}
+
+	if err := s.recordingService.Start(target); err != nil {
+		return nil, fmt.Errorf("failed to start recording: %w", err)
+	}
+
+	return &grpcApi.StartRecordingResponse{}, nil
+}
+
+// StopRecording turns the record mode off.
+func (s *AmqpMockServerServiceServer) StopRecording(_ context.Context, _ *grpcApi.StopRecordingRequest) (*grpcApi.StopRecordingResponse, error) {
+	s.recordingService.Stop()
+	return &grpcApi.StopRecordingResponse{}, nil
+}
+
+// GetRecordings exports the recorded expectations.
+func (s *AmqpMockServerServiceServer) GetRecordings(_ context.Context, _ *grpcApi.GetRecordingsRequest) (*grpcApi.GetRecordingsResponse, error) {
+	recordings := s.recordingService.Recordings()
+
+	expDTOs := make([]*grpcApi.CreateExpectationRequest, 0, len(recordings))
+	for _, exp := range recordings {
+		expDTOs = append(expDTOs, mapper.NewCreateExpectationRequest(exp))
+	}
+
+	return &grpcApi.GetRecordingsResponse{
+		Recording:    s.recordingService.Target() != nil,
+		Expectations: expDTOs,
+	}, nil
+}
+
+// ResetRecordings removes all recorded expectations.
+func (s *AmqpMockServerServiceServer) ResetRecordings(_ context.Context, _ *grpcApi.ResetRecordingsRequest) (*grpcApi.ResetRecordingsResponse, error) {
+	s.recordingService.Reset()
+	return &grpcApi.ResetRecordingsResponse{}, nil
+}
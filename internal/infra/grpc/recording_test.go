@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecording tests the record mode handlers
+func TestRecording(t *testing.T) {
+	// Create the server with a real recording service without a default target
+	recSvc := app.NewRecordingService(nil)
+	server := &AmqpMockServerServiceServer{
+		recordingService: recSvc,
+	}
+
+	// Recording cannot start without a target
+	_, err := server.StartRecording(context.Background(), &grpcApi.StartRecordingRequest{})
+	require.ErrorIs(t, err, app.ErrNoRecordingTarget)
+
+	// Start recording with an explicit target
+	_, err = server.StartRecording(context.Background(), &grpcApi.StartRecordingRequest{
+		Target: &grpcApi.ProxyTarget{Exchange: "real-exchange"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "real-exchange", recSvc.Target().Exchange)
+
+	// Record a request/reply pair as the listener would
+	candidate, err := expectations.NewCandidate("exchange", "rk", []byte(`{"id":1}`))
+	require.NoError(t, err)
+	require.NoError(t, recSvc.Record(candidate, []byte(`{"name":"foo"}`)))
+
+	// Export the recordings
+	resp, err := server.GetRecordings(context.Background(), &grpcApi.GetRecordingsRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Recording)
+	require.Len(t, resp.Expectations, 1)
+
+	// The exported request can be used to create the expectation as is
+	exported := resp.Expectations[0]
+	assert.Equal(t, grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT, exported.Request.GetJsonBody().GetMatchType())
+	assert.Equal(t, uint32(1), exported.Times.GetRemainingTimes())
+
+	request, err := mapper.NewRequest(exported.Request)
+	require.NoError(t, err)
+	assert.True(t, request.Matches(candidate))
+
+	response, err := mapper.NewResponse(exported.Response)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"foo"}`, string(response.Body))
+
+	// Requests and replies that are not JSON objects are exported so that they can be imported back
+	arrayCandidate, err := expectations.NewCandidate("exchange", "rk", []byte(`[1, "two"]`))
+	require.NoError(t, err)
+	require.NoError(t, recSvc.Record(arrayCandidate, []byte(`["a","b"]`)))
+
+	textCandidate, err := expectations.NewCandidate("exchange", "rk", []byte(`"quoted"`))
+	require.NoError(t, err)
+	require.NoError(t, recSvc.Record(textCandidate, []byte(`plain {{ text }}`)))
+
+	resp, err = server.GetRecordings(context.Background(), &grpcApi.GetRecordingsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Expectations, 3)
+
+	for i, tc := range []struct {
+		candidate *expectations.Candidate
+		reply     string
+		json      bool
+	}{
+		{arrayCandidate, `["a","b"]`, true},
+		{textCandidate, `plain {{ text }}`, false},
+	} {
+		exported := resp.Expectations[i+1]
+		require.NotNil(t, exported.Request)
+		require.NotNil(t, exported.Response)
+
+		exp, err := mapper.NewExpectation(exported)
+		require.NoError(t, err)
+		assert.True(t, exp.Matches(tc.candidate))
+
+		body, err := exp.Response.Render(tc.candidate)
+		require.NoError(t, err)
+		if tc.json {
+			assert.JSONEq(t, tc.reply, string(body))
+		} else {
+			assert.Equal(t, tc.reply, string(body))
+		}
+	}
+
+	// Stop recording, the recordings are kept
+	_, err = server.StopRecording(context.Background(), &grpcApi.StopRecordingRequest{})
+	require.NoError(t, err)
+
+	resp, err = server.GetRecordings(context.Background(), &grpcApi.GetRecordingsRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.Recording)
+	assert.Len(t, resp.Expectations, 3)
+
+	// Reset the recordings
+	_, err = server.ResetRecordings(context.Background(), &grpcApi.ResetRecordingsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, recSvc.Recordings())
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+)
+
+// GetScenarios returns the current state of all scenarios.
+func (s *AmqpMockServerServiceServer) GetScenarios(ctx context.Context, _ *grpcApi.GetScenariosRequest) (*grpcApi.GetScenariosResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarios := expSvc.GetScenarios()
+
+	scenarioDTOs := make([]*grpcApi.ScenarioState, 0, len(scenarios))
+	for _, sc := range scenarios {
+		scenarioDTOs = append(scenarioDTOs, newProtoScenarioState(sc))
+	}
+
+	return &grpcApi.GetScenariosResponse{
+		Scenarios: scenarioDTOs,
+	}, nil
+}
+
+// GetScenario returns the current state of a scenario.
+func (s *AmqpMockServerServiceServer) GetScenario(ctx context.Context, req *grpcApi.GetScenarioRequest) (*grpcApi.GetScenarioResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, fmt.Errorf("scenario name is required")
+	}
+
+	return &grpcApi.GetScenarioResponse{
+		Scenario: newProtoScenarioState(expSvc.GetScenario(req.GetName())),
+	}, nil
+}
+
+// SetScenarioState forces a scenario into a state.
+func (s *AmqpMockServerServiceServer) SetScenarioState(ctx context.Context, req *grpcApi.SetScenarioStateRequest) (*grpcApi.SetScenarioStateResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := expSvc.SetScenarioState(req.GetName(), req.GetState()); err != nil {
+		return nil, fmt.Errorf("failed to set scenario state: %w", err)
+	}
+
+	return &grpcApi.SetScenarioStateResponse{}, nil
+}
+
+// ResetScenario moves a scenario back to its initial state.
+func (s *AmqpMockServerServiceServer) ResetScenario(ctx context.Context, req *grpcApi.ResetScenarioRequest) (*grpcApi.ResetScenarioResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, fmt.Errorf("scenario name is required")
+	}
+
+	expSvc.ResetScenario(req.GetName())
+	return &grpcApi.ResetScenarioResponse{}, nil
+}
+
+// ResetScenarios moves all scenarios back to their initial state.
+func (s *AmqpMockServerServiceServer) ResetScenarios(ctx context.Context, _ *grpcApi.ResetScenariosRequest) (*grpcApi.ResetScenariosResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expSvc.ResetScenarios()
+	return &grpcApi.ResetScenariosResponse{}, nil
+}
+
+func newProtoScenarioState(sc app.ScenarioState) *grpcApi.ScenarioState {
+	return &grpcApi.ScenarioState{
+		Name:  sc.Name,
+		State: sc.State,
+	}
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScenarios tests the scenario handlers
+func TestScenarios(t *testing.T) {
+	// Create the server with a real expectations service
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	request := &grpcApi.Request{
+		Exchange:   "orders",
+		RoutingKey: "order.get",
+		Body:       &grpcApi.Request_RegexBody{RegexBody: &grpcApi.RegexBodyAssertion{Regex: ".*"}},
+	}
+
+	// Create an expectation that is part of a scenario
+	_, err := server.CreateExpectation(context.Background(), &grpcApi.CreateExpectationRequest{
+		Request:  request,
+		Response: &grpcApi.Response{Body: createJSONValue(t, `{"status":"pending"}`)},
+		Scenario: &grpcApi.Scenario{Name: "order", RequiredState: expectations.ScenarioStateStarted, NewState: "pending"},
+	})
+	require.NoError(t, err)
+
+	exps := expSvc.GetExpectations(app.GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, "pending", mapper.NewProtoExpectation(exps[0]).GetScenario().GetNewState())
+	assert.Equal(t, "order", mapper.NewCreateExpectationRequest(exps[0]).GetScenario().GetName())
+
+	// The scenario name is required
+	_, err = server.CreateExpectation(context.Background(), &grpcApi.CreateExpectationRequest{
+		Request:  request,
+		Response: &grpcApi.Response{Body: createJSONValue(t, `{}`)},
+		Scenario: &grpcApi.Scenario{RequiredState: "pending"},
+	})
+	require.ErrorIs(t, err, expectations.ErrEmptyScenarioName)
+
+	// The scenario of the expectation is listed in its initial state
+	resp, err := server.GetScenarios(context.Background(), &grpcApi.GetScenariosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Scenarios, 1)
+	assert.Equal(t, "order", resp.Scenarios[0].Name)
+	assert.Equal(t, expectations.ScenarioStateStarted, resp.Scenarios[0].State)
+
+	// Force the scenario into a state
+	_, err = server.SetScenarioState(context.Background(), &grpcApi.SetScenarioStateRequest{Name: "order", State: "done"})
+	require.NoError(t, err)
+
+	scenario, err := server.GetScenario(context.Background(), &grpcApi.GetScenarioRequest{Name: "order"})
+	require.NoError(t, err)
+	assert.Equal(t, "done", scenario.Scenario.State)
+
+	// The state is required
+	_, err = server.SetScenarioState(context.Background(), &grpcApi.SetScenarioStateRequest{Name: "order"})
+	require.ErrorIs(t, err, expectations.ErrEmptyScenarioState)
+
+	// Reset a single scenario
+	_, err = server.ResetScenario(context.Background(), &grpcApi.ResetScenarioRequest{Name: "order"})
+	require.NoError(t, err)
+
+	scenario, err = server.GetScenario(context.Background(), &grpcApi.GetScenarioRequest{Name: "order"})
+	require.NoError(t, err)
+	assert.Equal(t, expectations.ScenarioStateStarted, scenario.Scenario.State)
+
+	// Reset all scenarios
+	_, err = server.SetScenarioState(context.Background(), &grpcApi.SetScenarioStateRequest{Name: "other", State: "x"})
+	require.NoError(t, err)
+
+	_, err = server.ResetScenarios(context.Background(), &grpcApi.ResetScenariosRequest{})
+	require.NoError(t, err)
+
+	resp, err = server.GetScenarios(context.Background(), &grpcApi.GetScenariosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Scenarios, 1)
+	assert.Equal(t, expectations.ScenarioStateStarted, resp.Scenarios[0].State)
+}
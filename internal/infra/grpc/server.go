@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
@@ -9,25 +11,80 @@ import (
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
 )
 
 // ExpectationsService is the interface that wraps the basic expectations service methods.
 type ExpectationsService interface {
 	Create(exp *expectations.Expectation) error
+	Update(id uuid.UUID, exp *expectations.Expectation) error
+	Upsert(exp *expectations.Expectation) (bool, error)
+	Delete(id uuid.UUID) error
+	DeleteBySelector(selector expectations.LabelSelector) int
 	Reset()
-	Match(cnd *expectations.Candidate) *expectations.Response
+	Match(cnd *expectations.Candidate) *expectations.Expectation
+	SimulateMatch(cnd *expectations.Candidate) *app.MatchSimulation
 	GetExpectations(req app.GetExpectationsRequest) []*expectations.Expectation
 	GetExpectation(id uuid.UUID) *expectations.Expectation
-	GetAssertions(req app.GetAssertionsRequest) []*expectations.Assertion
+	GetAssertions(req app.GetAssertionsRequest) (*app.AssertionsPage, error)
+	GetAssertion(id uuid.UUID) (*expectations.Assertion, error)
+	ResetAssertions()
+	AssertionsStats() app.AssertionsStats
+	WaitForAssertions(ctx context.Context, query expectations.AssertionsQuery, count int) ([]*expectations.Assertion, error)
+	Verify(reqs []app.VerifyRequest) ([]*expectations.VerificationResult, error)
+	VerifySequence(steps []*expectations.Request, strict bool) (*expectations.SequenceResult, error)
+	GetScenarios() []app.ScenarioState
+	GetScenario(name string) app.ScenarioState
+	SetScenarioState(name, state string) error
+	ResetScenario(name string)
+	ResetScenarios()
 }
 
 // SubscriptionsService is the interface that wraps the basic subscriptions service methods.
 type SubscriptionsService interface {
-	Subscribe(queue string, idempotent bool) (*subscriptions.Subscription, error)
+	Subscribe(queue string, idempotent bool, opts ...subscriptions.Option) (*subscriptions.Subscription, error)
 	UnsubscribeByID(id uuid.UUID) error
-	UnsubscribeByQueue(queue string) error
+	UnsubscribeByQueue(queue, namespace string) error
 	GetAllSubscriptions() []*subscriptions.Subscription
 	UnsubscribeAll() error
+	UnsubscribeByNamespace(namespace string) error
+}
+
+// FallbackService is the interface that wraps the methods managing the response to unmatched requests.
+type FallbackService interface {
+	DefaultResponse() *expectations.Response
+	SetDefaultResponse(res *expectations.Response)
+	ResetDefaultResponse()
+}
+
+// RecordingService is the interface that wraps the record mode methods.
+type RecordingService interface {
+	Start(target *subscriptions.ProxyTarget) error
+	Stop()
+	Target() *subscriptions.ProxyTarget
+	Recordings() []*expectations.Expectation
+	Reset()
+}
+
+// EventsService is the interface that wraps the method watching the server events.
+type EventsService interface {
+	Watch(filter app.EventFilter) *app.EventWatcher
+}
+
+// NamespacesService is the interface that wraps the methods managing the namespaces other than the default one.
+type NamespacesService interface {
+	Get(namespace string) ExpectationsService
+	Names() []string
+	Delete(namespace string) error
+}
+
+// SessionsService is the interface that wraps the methods managing the leased sessions.
+type SessionsService interface {
+	Create(namespace string, lease time.Duration) (*app.Session, error)
+	Get(id uuid.UUID) (*app.Session, error)
+	GetAll() []*app.Session
+	Heartbeat(id uuid.UUID) (*app.Session, error)
+	Close(id uuid.UUID) error
 }
 
 // AmqpMockServerServiceServer is the gRPC server implementation for the AmqpMockServerService service.
@@ -35,14 +92,33 @@ type AmqpMockServerServiceServer struct {
 	grpcApi.UnimplementedAmqpMockServerServiceServer
 	expectationsService  ExpectationsService
 	subscriptionsService SubscriptionsService
+	fallbackService      FallbackService
+	recordingService     RecordingService
+	eventsService        EventsService
+	namespacesService    NamespacesService
+	sessionsService      SessionsService
 	serviceInfo          *config.ServiceInfo
 }
 
 // NewAmqpMockServerServiceServer creates a new AmqpMockServerServiceServer instance.
-func NewAmqpMockServerServiceServer(expSvc ExpectationsService, subSvc SubscriptionsService, si *config.ServiceInfo) *AmqpMockServerServiceServer {
+func NewAmqpMockServerServiceServer(
+	expSvc ExpectationsService,
+	subSvc SubscriptionsService,
+	fbSvc FallbackService,
+	recSvc RecordingService,
+	evSvc EventsService,
+	nsSvc NamespacesService,
+	sessSvc SessionsService,
+	si *config.ServiceInfo,
+) *AmqpMockServerServiceServer {
 	return &AmqpMockServerServiceServer{
 		expectationsService:  expSvc,
 		subscriptionsService: subSvc,
+		fallbackService:      fbSvc,
+		recordingService:     recSvc,
+		eventsService:        evSvc,
+		namespacesService:    nsSvc,
+		sessionsService:      sessSvc,
 		serviceInfo:          si,
 	}
 }
@@ -54,3 +130,83 @@ func (s *AmqpMockServerServiceServer) GetVersion(_ context.Context, _ *grpcApi.G
 		BuildDate:  s.serviceInfo.BuildDate,
 	}, nil
 }
+
+// expectationsFor returns the expectations service of the namespace selected by the call.
+func (s *AmqpMockServerServiceServer) expectationsFor(ctx context.Context) (ExpectationsService, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace == app.DefaultNamespace || s.namespacesService == nil {
+		return s.expectationsService, nil
+	}
+
+	return s.namespacesService.Get(namespace), nil
+}
+
+// namespaceFromContext returns the namespace selected by the metadata of the call, the default one if there is none.
+func namespaceFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return app.DefaultNamespace, nil
+	}
+
+	values := md.Get(app.NamespaceHeader)
+	if len(values) == 0 {
+		return app.DefaultNamespace, nil
+	}
+
+	if err := app.ValidateNamespace(values[0]); err != nil {
+		return "", err
+	}
+
+	return values[0], nil
+}
+
+// sessionFromContext returns the session selected by the metadata of the call, nil if there is none.
+// The session must be open and belong to the namespace of the call.
+func (s *AmqpMockServerServiceServer) sessionFromContext(ctx context.Context) (uuid.UUID, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(app.SessionHeader)
+	if len(values) == 0 {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(values[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	if s.sessionsService == nil {
+		return uuid.Nil, fmt.Errorf("%w: %s", app.ErrSessionNotFound, id)
+	}
+
+	session, err := s.sessionsService.Get(id)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if session.Namespace != namespace {
+		return uuid.Nil, fmt.Errorf("%w: %q", app.ErrSessionNamespace, session.Namespace)
+	}
+
+	return id, nil
+}
+
+// checkSessionOpen returns an error if the session ended in the meantime, so that what was just created
+// under it can be removed again, since the clean-up of the session may have missed it.
+func (s *AmqpMockServerServiceServer) checkSessionOpen(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	_, err := s.sessionsService.Get(id)
+
+	return err
+}
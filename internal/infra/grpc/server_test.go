@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/config"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/google/uuid"
@@ -17,6 +19,11 @@ func TestNewAmqpMockServerServiceServer(t *testing.T) {
 	// Create mock dependencies
 	expSvc := &TestExpectationsService{}
 	subSvc := &TestSubscriptionsService{}
+	fbSvc := app.NewFallbackService(subscriptions.FallbackPolicyReply)
+	recSvc := app.NewRecordingService(nil)
+	evSvc := app.NewEvents()
+	nsSvc := &TestNamespacesService{app.NewNamespaces(app.NewExpectationsService())}
+	sessSvc := app.NewSessionsService(nsSvc.Namespaces, app.NewSubscriptionsService(nil))
 	si := &config.ServiceInfo{
 		Service:    "test-service",
 		Version:    "1.0.0",
@@ -25,12 +32,17 @@ func TestNewAmqpMockServerServiceServer(t *testing.T) {
 	}
 
 	// Create the server
-	server := NewAmqpMockServerServiceServer(expSvc, subSvc, si)
+	server := NewAmqpMockServerServiceServer(expSvc, subSvc, fbSvc, recSvc, evSvc, nsSvc, sessSvc, si)
 
 	// Verify the server was created correctly
 	assert.NotNil(t, server)
 	assert.Equal(t, expSvc, server.expectationsService)
 	assert.Equal(t, subSvc, server.subscriptionsService)
+	assert.Equal(t, fbSvc, server.fallbackService)
+	assert.Equal(t, recSvc, server.recordingService)
+	assert.Equal(t, evSvc, server.eventsService)
+	assert.Equal(t, nsSvc, server.namespacesService)
+	assert.Equal(t, sessSvc, server.sessionsService)
 	assert.Equal(t, si, server.serviceInfo)
 }
 
@@ -39,6 +51,11 @@ func TestGetVersion(t *testing.T) {
 	// Create mock dependencies
 	expSvc := &TestExpectationsService{}
 	subSvc := &TestSubscriptionsService{}
+	fbSvc := app.NewFallbackService(subscriptions.FallbackPolicyReply)
+	recSvc := app.NewRecordingService(nil)
+	evSvc := app.NewEvents()
+	nsSvc := &TestNamespacesService{app.NewNamespaces(app.NewExpectationsService())}
+	sessSvc := app.NewSessionsService(nsSvc.Namespaces, app.NewSubscriptionsService(nil))
 	si := &config.ServiceInfo{
 		Service:    "test-service",
 		Version:    "1.0.0",
@@ -47,7 +64,7 @@ func TestGetVersion(t *testing.T) {
 	}
 
 	// Create the server
-	server := NewAmqpMockServerServiceServer(expSvc, subSvc, si)
+	server := NewAmqpMockServerServiceServer(expSvc, subSvc, fbSvc, recSvc, evSvc, nsSvc, sessSvc, si)
 
 	// Call the GetVersion method
 	resp, err := server.GetVersion(context.Background(), &grpcApi.GetVersionRequest{})
@@ -64,17 +81,23 @@ type TestSubscriptionsService struct {
 	subscriptions []*subscriptions.Subscription
 }
 
-func (s *TestSubscriptionsService) Subscribe(queue string, _ bool) (*subscriptions.Subscription, error) {
-	sub := subscriptions.NewSubscription(queue)
+func (s *TestSubscriptionsService) Subscribe(queue string, _ bool, opts ...subscriptions.Option) (*subscriptions.Subscription, error) {
+	sub := subscriptions.NewSubscription(queue, opts...)
 	s.subscriptions = append(s.subscriptions, sub)
 	return sub, nil
 }
 
-func (s *TestSubscriptionsService) UnsubscribeByID(_ uuid.UUID) error {
+func (s *TestSubscriptionsService) UnsubscribeByID(id uuid.UUID) error {
+	s.subscriptions = slices.DeleteFunc(s.subscriptions, func(sub *subscriptions.Subscription) bool {
+		return sub.ID() == id
+	})
 	return nil
 }
 
-func (s *TestSubscriptionsService) UnsubscribeByQueue(_ string) error {
+func (s *TestSubscriptionsService) UnsubscribeByQueue(queue, namespace string) error {
+	s.subscriptions = slices.DeleteFunc(s.subscriptions, func(sub *subscriptions.Subscription) bool {
+		return sub.Queue() == queue && sub.Namespace() == namespace
+	})
 	return nil
 }
 
@@ -86,3 +109,19 @@ func (s *TestSubscriptionsService) UnsubscribeAll() error {
 	s.subscriptions = nil
 	return nil
 }
+
+func (s *TestSubscriptionsService) UnsubscribeByNamespace(namespace string) error {
+	s.subscriptions = slices.DeleteFunc(s.subscriptions, func(sub *subscriptions.Subscription) bool {
+		return sub.Namespace() == namespace
+	})
+	return nil
+}
+
+// TestNamespacesService adapts the namespaces of the app to the NamespacesService interface for testing
+type TestNamespacesService struct {
+	*app.Namespaces
+}
+
+func (n *TestNamespacesService) Get(namespace string) ExpectationsService {
+	return n.Namespaces.Get(namespace)
+}
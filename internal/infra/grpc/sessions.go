@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/google/uuid"
+)
+
+// defaultSessionLease is how long a session lives without a heartbeat if the request does not set it.
+const defaultSessionLease = 30 * time.Second
+
+// CreateSession starts a session in the namespace of the call.
+func (s *AmqpMockServerServiceServer) CreateSession(ctx context.Context, req *grpcApi.CreateSessionRequest) (*grpcApi.CreateSessionResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lease := defaultSessionLease
+	if req.LeaseSeconds != nil {
+		lease = time.Duration(float64(req.GetLeaseSeconds()) * float64(time.Second))
+	}
+
+	session, err := s.sessionsService.Create(namespace, lease)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return &grpcApi.CreateSessionResponse{
+		Session: newProtoSession(session),
+	}, nil
+}
+
+// GetSessions lists the open sessions.
+func (s *AmqpMockServerServiceServer) GetSessions(_ context.Context, _ *grpcApi.GetSessionsRequest) (*grpcApi.GetSessionsResponse, error) {
+	sessions := s.sessionsService.GetAll()
+	sessionsDTO := make([]*grpcApi.Session, 0, len(sessions))
+	for _, session := range sessions {
+		sessionsDTO = append(sessionsDTO, newProtoSession(session))
+	}
+
+	return &grpcApi.GetSessionsResponse{
+		Sessions: sessionsDTO,
+	}, nil
+}
+
+// GetSession retrieves an open session.
+func (s *AmqpMockServerServiceServer) GetSession(_ context.Context, req *grpcApi.GetSessionRequest) (*grpcApi.GetSessionResponse, error) {
+	id, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	session, err := s.sessionsService.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcApi.GetSessionResponse{
+		Session: newProtoSession(session),
+	}, nil
+}
+
+// HeartbeatSession renews the lease of a session.
+func (s *AmqpMockServerServiceServer) HeartbeatSession(_ context.Context, req *grpcApi.HeartbeatSessionRequest) (*grpcApi.HeartbeatSessionResponse, error) {
+	id, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	session, err := s.sessionsService.Heartbeat(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew session: %w", err)
+	}
+
+	return &grpcApi.HeartbeatSessionResponse{
+		Session: newProtoSession(session),
+	}, nil
+}
+
+// CloseSession ends a session, removing the expectations and subscriptions it owns.
+func (s *AmqpMockServerServiceServer) CloseSession(_ context.Context, req *grpcApi.CloseSessionRequest) (*grpcApi.CloseSessionResponse, error) {
+	id, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id: %w", err)
+	}
+
+	if err := s.sessionsService.Close(id); err != nil {
+		return nil, fmt.Errorf("failed to close session: %w", err)
+	}
+
+	return &grpcApi.CloseSessionResponse{}, nil
+}
+
+func newProtoSession(session *app.Session) *grpcApi.Session {
+	return &grpcApi.Session{
+		Id:           session.ID.String(),
+		Namespace:    session.Namespace,
+		LeaseSeconds: float32(session.Lease.Seconds()),
+		CreatedAt:    session.CreatedAt.Format(time.RFC3339Nano),
+		ExpiresAt:    session.ExpiresAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// sessionContext returns a context selecting the session and the namespace, as the gateway does with the headers
+func sessionContext(sessionID, namespace string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(app.SessionHeader, sessionID, app.NamespaceHeader, namespace))
+}
+
+// TestSessions tests the session handlers and that what is created under a session is removed with it
+func TestSessions(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	namespaces := app.NewNamespaces(expSvc)
+	subSvc := app.NewSubscriptionsService(&TestConsumer{})
+	server := &AmqpMockServerServiceServer{
+		expectationsService:  expSvc,
+		subscriptionsService: subSvc,
+		namespacesService:    &TestNamespacesService{namespaces},
+		sessionsService:      app.NewSessionsService(namespaces, subSvc),
+	}
+
+	created, err := server.CreateSession(context.Background(), &grpcApi.CreateSessionRequest{})
+	require.NoError(t, err)
+	session := created.Session
+	assert.NotEmpty(t, session.Id)
+	assert.InDelta(t, 30, session.LeaseSeconds, 0.001)
+
+	lease := float32(60)
+	other, err := server.CreateSession(namespaceContext("suite-a"), &grpcApi.CreateSessionRequest{LeaseSeconds: &lease})
+	require.NoError(t, err)
+	assert.Equal(t, "suite-a", other.Session.Namespace)
+	assert.InDelta(t, 60, other.Session.LeaseSeconds, 0.001)
+
+	list, err := server.GetSessions(context.Background(), &grpcApi.GetSessionsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Sessions, 2)
+
+	// create an expectation and a subscription owned by the session, and others that are not
+	ctx := sessionContext(session.Id, app.DefaultNamespace)
+	owned, err := server.CreateExpectation(ctx, newTestCreateExpectationRequest(t, "test-exchange", "test-routing-key"))
+	require.NoError(t, err)
+	kept, err := server.CreateExpectation(context.Background(), newTestCreateExpectationRequest(t, "test-exchange", "test-routing-key"))
+	require.NoError(t, err)
+
+	sub, err := server.AddSubscription(ctx, &grpcApi.AddSubscriptionRequest{Queue: "queue"})
+	require.NoError(t, err)
+	assert.Equal(t, session.Id, sub.Subscription.GetSessionId())
+	_, err = server.AddSubscription(context.Background(), &grpcApi.AddSubscriptionRequest{Queue: "queue"})
+	require.NoError(t, err)
+
+	got, err := server.GetExpectation(context.Background(), &grpcApi.GetExpectationRequest{ExpectationId: owned.ExpectationId})
+	require.NoError(t, err)
+	assert.Equal(t, session.Id, got.Expectation.GetSessionId())
+
+	renewed, err := server.HeartbeatSession(context.Background(), &grpcApi.HeartbeatSessionRequest{SessionId: session.Id})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, renewed.Session.ExpiresAt, session.ExpiresAt)
+
+	_, err = server.CloseSession(context.Background(), &grpcApi.CloseSessionRequest{SessionId: session.Id})
+	require.NoError(t, err)
+
+	exps := expSvc.GetExpectations(app.GetExpectationsRequest{})
+	require.Len(t, exps, 1)
+	assert.Equal(t, kept.ExpectationId, exps[0].ID.String())
+	subs := subSvc.GetAllSubscriptions()
+	require.Len(t, subs, 1)
+	assert.NotEqual(t, sub.Subscription.Id, subs[0].ID().String())
+
+	// the session is gone
+	_, err = server.GetSession(context.Background(), &grpcApi.GetSessionRequest{SessionId: session.Id})
+	require.ErrorIs(t, err, app.ErrSessionNotFound)
+	_, err = server.CreateExpectation(ctx, newTestCreateExpectationRequest(t, "test-exchange", "test-routing-key"))
+	require.ErrorIs(t, err, app.ErrSessionNotFound)
+
+	// the session must belong to the namespace of the call
+	_, err = server.CreateExpectation(sessionContext(other.Session.Id, app.DefaultNamespace), newTestCreateExpectationRequest(t, "test-exchange", "test-routing-key"))
+	require.ErrorIs(t, err, app.ErrSessionNamespace)
+
+	_, err = server.CreateExpectation(sessionContext("not-a-uuid", app.DefaultNamespace), newTestCreateExpectationRequest(t, "test-exchange", "test-routing-key"))
+	require.Error(t, err)
+}
+
+// TestConsumer is a simple implementation of the app Consumer interface for testing
+type TestConsumer struct {
+	subscriptions []*subscriptions.Subscription
+}
+
+func (c *TestConsumer) Subscribe(sub *subscriptions.Subscription) error {
+	c.subscriptions = append(c.subscriptions, sub)
+	return nil
+}
+
+func (c *TestConsumer) Unsubscribe(id uuid.UUID) error {
+	c.subscriptions = slices.DeleteFunc(c.subscriptions, func(sub *subscriptions.Subscription) bool {
+		return sub.ID() == id
+	})
+	return nil
+}
+
+func (c *TestConsumer) UnsubscribeFromQueue(queue string) error {
+	c.subscriptions = slices.DeleteFunc(c.subscriptions, func(sub *subscriptions.Subscription) bool {
+		return sub.Queue() == queue
+	})
+	return nil
+}
+
+func (c *TestConsumer) GetAllSubscriptions() []*subscriptions.Subscription {
+	return slices.Clone(c.subscriptions)
+}
+
+func (c *TestConsumer) GetQueueSubscriptions(queue string) []*subscriptions.Subscription {
+	var subs []*subscriptions.Subscription
+	for _, sub := range c.subscriptions {
+		if sub.Queue() == queue {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+func (c *TestConsumer) UnsubscribeAll() error {
+	c.subscriptions = nil
+	return nil
+}
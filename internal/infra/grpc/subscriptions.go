@@ -3,45 +3,113 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/google/uuid"
 )
 
 // AddSubscription adds a new subscription to the server.
-func (s *AmqpMockServerServiceServer) AddSubscription(_ context.Context, request *grpcApi.AddSubscriptionRequest) (*grpcApi.AddSubscriptionResponse, error) {
-	sub, err := s.subscriptionsService.Subscribe(request.Queue, request.Idempotent)
+func (s *AmqpMockServerServiceServer) AddSubscription(ctx context.Context, request *grpcApi.AddSubscriptionRequest) (*grpcApi.AddSubscriptionResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Namespace != nil {
+		if err := app.ValidateNamespace(request.GetNamespace()); err != nil {
+			return nil, err
+		}
+		namespace = request.GetNamespace()
+	}
+
+	// the requests received by the subscription are matched in its namespace, which only the API calls create
+	if namespace != app.DefaultNamespace && s.namespacesService != nil {
+		s.namespacesService.Get(namespace)
+	}
+
+	sessionID, err := s.sessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []subscriptions.Option{subscriptions.WithNamespace(namespace), subscriptions.WithSessionID(sessionID)}
+	if policy := newFallbackPolicy(request.GetFallbackPolicy()); policy != "" {
+		opts = append(opts, subscriptions.WithFallbackPolicy(policy))
+	}
+
+	if target := request.GetProxyTarget(); target != nil {
+		if target.GetExchange() == "" {
+			return nil, fmt.Errorf("proxy target exchange is required")
+		}
+		opts = append(opts, subscriptions.WithProxyTarget(&subscriptions.ProxyTarget{
+			Exchange:   target.GetExchange(),
+			RoutingKey: target.GetRoutingKey(),
+		}))
+	}
+
+	sub, err := s.subscriptionsService.Subscribe(request.Queue, request.Idempotent, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to subscribe: %w", err)
 	}
 
+	if err := s.checkSessionOpen(sessionID); err != nil {
+		_ = s.subscriptionsService.UnsubscribeByID(sub.ID())
+		return nil, err
+	}
+
 	return &grpcApi.AddSubscriptionResponse{
 		Subscription: newSubscription(sub),
 	}, nil
 }
 
-// DeleteSubscription deletes a subscription from the server.
-func (s *AmqpMockServerServiceServer) DeleteSubscription(_ context.Context, request *grpcApi.DeleteSubscriptionRequest) (*grpcApi.DeleteSubscriptionResponse, error) {
+// DeleteSubscription deletes a subscription of the namespace selected by the call from the server.
+func (s *AmqpMockServerServiceServer) DeleteSubscription(ctx context.Context, request *grpcApi.DeleteSubscriptionRequest) (*grpcApi.DeleteSubscriptionResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(request.SubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription id: %w", err)
+	}
+
+	if !slices.ContainsFunc(s.namespaceSubscriptions(namespace), func(sub *subscriptions.Subscription) bool { return sub.ID() == id }) {
+		return nil, fmt.Errorf("%w: %s", app.ErrSubscriptionNotFound, id)
+	}
 
-	if err := s.subscriptionsService.UnsubscribeByID(uuid.MustParse(request.SubscriptionId)); err != nil {
+	if err := s.subscriptionsService.UnsubscribeByID(id); err != nil {
 		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
 	}
 
 	return &grpcApi.DeleteSubscriptionResponse{}, nil
 }
 
-// UnsubscribeFromQueue unsubscribes from a queue.
-func (s *AmqpMockServerServiceServer) UnsubscribeFromQueue(_ context.Context, request *grpcApi.UnsubscribeFromQueueRequest) (*grpcApi.UnsubscribeFromQueueResponse, error) {
-	if err := s.subscriptionsService.UnsubscribeByQueue(request.Queue); err != nil {
+// UnsubscribeFromQueue removes the subscriptions of the namespace selected by the call to a queue.
+func (s *AmqpMockServerServiceServer) UnsubscribeFromQueue(ctx context.Context, request *grpcApi.UnsubscribeFromQueueRequest) (*grpcApi.UnsubscribeFromQueueResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.subscriptionsService.UnsubscribeByQueue(request.Queue, namespace); err != nil {
 		return nil, fmt.Errorf("failed to unsubscribe from queue: %w", err)
 	}
 
 	return &grpcApi.UnsubscribeFromQueueResponse{}, nil
 }
 
-// GetAllSubscriptions returns all subscriptions.
-func (s *AmqpMockServerServiceServer) GetAllSubscriptions(_ context.Context, _ *grpcApi.GetAllSubscriptionsRequest) (*grpcApi.GetAllSubscriptionsResponse, error) {
-	subs := s.subscriptionsService.GetAllSubscriptions()
+// GetAllSubscriptions returns the subscriptions of the namespace selected by the call.
+func (s *AmqpMockServerServiceServer) GetAllSubscriptions(ctx context.Context, _ *grpcApi.GetAllSubscriptionsRequest) (*grpcApi.GetAllSubscriptionsResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := s.namespaceSubscriptions(namespace)
 	subsDTO := make([]*grpcApi.Subscription, 0, len(subs))
 	for _, sub := range subs {
 		subsDTO = append(subsDTO, newSubscription(sub))
@@ -52,15 +120,69 @@ func (s *AmqpMockServerServiceServer) GetAllSubscriptions(_ context.Context, _ *
 	}, nil
 }
 
-func (s *AmqpMockServerServiceServer) ResetSubscriptions(_ context.Context, _ *grpcApi.ResetSubscriptionsRequest) (*grpcApi.ResetSubscriptionsResponse, error) {
-	err := s.subscriptionsService.UnsubscribeAll()
+// namespaceSubscriptions returns the subscriptions bound to the namespace.
+func (s *AmqpMockServerServiceServer) namespaceSubscriptions(namespace string) []*subscriptions.Subscription {
+	var subs []*subscriptions.Subscription
+	for _, sub := range s.subscriptionsService.GetAllSubscriptions() {
+		if sub.Namespace() == namespace {
+			subs = append(subs, sub)
+		}
+	}
+
+	return subs
+}
+
+// ResetSubscriptions removes the subscriptions of the namespace selected by the call.
+func (s *AmqpMockServerServiceServer) ResetSubscriptions(ctx context.Context, _ *grpcApi.ResetSubscriptionsRequest) (*grpcApi.ResetSubscriptionsResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.subscriptionsService.UnsubscribeByNamespace(namespace)
 
 	return &grpcApi.ResetSubscriptionsResponse{}, err
 }
 
-func (s *AmqpMockServerServiceServer) ResetAll(_ context.Context, _ *grpcApi.ResetAllRequest) (*grpcApi.ResetAllResponse, error) {
-	s.expectationsService.Reset()
-	err := s.subscriptionsService.UnsubscribeAll()
+// ResetAll resets the namespace selected by the call. The default response and the record mode are global,
+// so they are reset only with the default namespace.
+func (s *AmqpMockServerServiceServer) ResetAll(ctx context.Context, req *grpcApi.ResetAllRequest) (*grpcApi.ResetAllResponse, error) {
+	namespace, err := namespaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expSvc.Reset()
+	if req.GetClearAssertions() {
+		expSvc.ResetAssertions()
+	}
+	if namespace == app.DefaultNamespace {
+		s.fallbackService.ResetDefaultResponse()
+		s.recordingService.Reset()
+	}
+	err = s.subscriptionsService.UnsubscribeByNamespace(namespace)
 
 	return &grpcApi.ResetAllResponse{}, err
 }
+
+func newFallbackPolicy(p grpcApi.FallbackPolicy) subscriptions.FallbackPolicy {
+	switch p {
+	case grpcApi.FallbackPolicy_FALLBACK_POLICY_REPLY:
+		return subscriptions.FallbackPolicyReply
+	case grpcApi.FallbackPolicy_FALLBACK_POLICY_DROP:
+		return subscriptions.FallbackPolicyDrop
+	case grpcApi.FallbackPolicy_FALLBACK_POLICY_REJECT:
+		return subscriptions.FallbackPolicyReject
+	case grpcApi.FallbackPolicy_FALLBACK_POLICY_REQUEUE:
+		return subscriptions.FallbackPolicyRequeue
+	case grpcApi.FallbackPolicy_FALLBACK_POLICY_PROXY:
+		return subscriptions.FallbackPolicyProxy
+	default:
+		return ""
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
 	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,6 +40,36 @@ func TestAddSubscription(t *testing.T) {
 	// Verify the subscription was created in the service
 	assert.Len(t, mockSvc.subscriptions, 1)
 	assert.Equal(t, req.Queue, mockSvc.subscriptions[0].Queue())
+	assert.Equal(t, grpcApi.FallbackPolicy_FALLBACK_POLICY_UNSPECIFIED, resp.Subscription.FallbackPolicy)
+
+	// Create a subscription overriding the fallback policy
+	req = &grpcApi.AddSubscriptionRequest{
+		Queue:          "test-queue-2",
+		FallbackPolicy: grpcApi.FallbackPolicy_FALLBACK_POLICY_REJECT,
+	}
+
+	resp, err = server.AddSubscription(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grpcApi.FallbackPolicy_FALLBACK_POLICY_REJECT, resp.Subscription.FallbackPolicy)
+	assert.Equal(t, subscriptions.FallbackPolicyReject, mockSvc.subscriptions[1].FallbackPolicy())
+
+	// Create a subscription proxying unmatched requests to the real service
+	req = &grpcApi.AddSubscriptionRequest{
+		Queue:          "test-queue-3",
+		FallbackPolicy: grpcApi.FallbackPolicy_FALLBACK_POLICY_PROXY,
+		ProxyTarget:    &grpcApi.ProxyTarget{Exchange: "real-exchange"},
+	}
+
+	resp, err = server.AddSubscription(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grpcApi.FallbackPolicy_FALLBACK_POLICY_PROXY, resp.Subscription.FallbackPolicy)
+	assert.Equal(t, "real-exchange", resp.Subscription.ProxyTarget.GetExchange())
+	assert.Equal(t, "real-exchange", mockSvc.subscriptions[2].ProxyTarget().Exchange)
+
+	// The proxy target exchange is required
+	req.ProxyTarget = &grpcApi.ProxyTarget{RoutingKey: "rk"}
+	_, err = server.AddSubscription(context.Background(), req)
+	require.Error(t, err)
 }
 
 // TestDeleteSubscription tests the DeleteSubscription handler
@@ -65,6 +97,14 @@ func TestDeleteSubscription(t *testing.T) {
 	// Verify the response
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+	assert.Empty(t, mockSvc.subscriptions)
+
+	// A deleted or unknown subscription is not found, a malformed ID is rejected
+	_, err = server.DeleteSubscription(context.Background(), req)
+	require.ErrorIs(t, err, app.ErrSubscriptionNotFound)
+
+	_, err = server.DeleteSubscription(context.Background(), &grpcApi.DeleteSubscriptionRequest{SubscriptionId: "invalid"})
+	require.Error(t, err)
 }
 
 // TestUnsubscribeFromQueue tests the UnsubscribeFromQueue handler
@@ -150,24 +190,30 @@ func TestResetSubscriptions(t *testing.T) {
 // TestResetAll tests the ResetAll handler
 func TestResetAll(t *testing.T) {
 	// Create mock services
-	mockExpSvc := &MockExpectationsService{}
+	mockExpSvc := &TestExpectationsService{}
 	mockSubSvc := &TestSubscriptionsService{}
+	fbSvc := app.NewFallbackService(subscriptions.FallbackPolicyReply)
 
 	// Create the server with the mock services
 	server := &AmqpMockServerServiceServer{
 		expectationsService:  mockExpSvc,
 		subscriptionsService: mockSubSvc,
+		fallbackService:      fbSvc,
+		recordingService:     app.NewRecordingService(nil),
 	}
 
 	// Create some test data
-	exp, err := createTestExpectation("exchange", "rk")
-	require.NoError(t, err)
-	err = mockExpSvc.Create(exp)
+	exp := newTestExpectation(t, "exchange", "rk")
+	err := mockExpSvc.Create(exp)
 	require.NoError(t, err)
 
 	sub := subscriptions.NewSubscription("test-queue")
 	mockSubSvc.subscriptions = append(mockSubSvc.subscriptions, sub)
 
+	res, err := expectations.NewResponse([]byte(`{"error":"custom"}`))
+	require.NoError(t, err)
+	fbSvc.SetDefaultResponse(res)
+
 	// Call the handler
 	resp, err := server.ResetAll(context.Background(), &grpcApi.ResetAllRequest{})
 
@@ -175,6 +221,13 @@ func TestResetAll(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 
-	// Verify the reset was called on both services
+	// Verify the reset was called on all services
 	assert.True(t, mockExpSvc.resetCalled)
+	assert.False(t, mockExpSvc.resetAssertionsCalled)
+	assert.JSONEq(t, `{"errors":"no match found"}`, string(fbSvc.DefaultResponse().Body))
+
+	// The history of assertions is only cleared on request
+	_, err = server.ResetAll(context.Background(), &grpcApi.ResetAllRequest{ClearAssertions: true})
+	require.NoError(t, err)
+	assert.True(t, mockExpSvc.resetAssertionsCalled)
 }
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"github.com/google/uuid"
+)
+
+// VerifyExpectations checks the requests received so far against the verifications.
+func (s *AmqpMockServerServiceServer) VerifyExpectations(ctx context.Context, req *grpcApi.VerifyExpectationsRequest) (*grpcApi.VerifyExpectationsResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetVerifications()) == 0 {
+		return nil, fmt.Errorf("at least one verification is required")
+	}
+
+	appReqs := make([]app.VerifyRequest, 0, len(req.GetVerifications()))
+	for i, v := range req.GetVerifications() {
+		appReq, err := newVerifyRequest(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verification %d: %w", i, err)
+		}
+		appReqs = append(appReqs, appReq)
+	}
+
+	results, err := expSvc.Verify(appReqs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify expectations: %w", err)
+	}
+
+	resp := &grpcApi.VerifyExpectationsResponse{
+		Passed:  true,
+		Results: make([]*grpcApi.VerificationResult, 0, len(results)),
+	}
+
+	var failures []string
+	for _, result := range results {
+		resp.Results = append(resp.Results, &grpcApi.VerificationResult{
+			Passed: result.Passed,
+			Count:  uint32(result.Count), // nolint: gosec
+			Report: result.Report,
+		})
+
+		if !result.Passed {
+			resp.Passed = false
+			failures = append(failures, result.Report)
+		}
+	}
+	resp.Report = strings.Join(failures, "\n\n")
+
+	return resp, nil
+}
+
+func newVerifyRequest(v *grpcApi.Verification) (app.VerifyRequest, error) {
+	var exactly, atLeast, atMost *uint32
+	if t := v.GetTimes(); t != nil {
+		exactly, atLeast, atMost = t.Exactly, t.AtLeast, t.AtMost
+	}
+
+	times, err := expectations.NewVerificationTimes(exactly, atLeast, atMost)
+	if err != nil {
+		return app.VerifyRequest{}, err
+	}
+
+	appReq := app.VerifyRequest{
+		Times: times,
+	}
+
+	switch target := v.GetTarget().(type) {
+	case *grpcApi.Verification_ExpectationId:
+		expUID, err := uuid.Parse(target.ExpectationId)
+		if err != nil {
+			return app.VerifyRequest{}, fmt.Errorf("invalid expectation id: %w", err)
+		}
+		appReq.ExpectationID = &expUID
+	case *grpcApi.Verification_Request:
+		request, err := mapper.NewRequest(target.Request)
+		if err != nil {
+			return app.VerifyRequest{}, err
+		}
+		appReq.Request = request
+	default:
+		return app.VerifyRequest{}, fmt.Errorf("expectation_id or request is required")
+	}
+
+	return appReq, nil
+}
+
+// VerifySequence checks that requests matching the steps were received in order.
+func (s *AmqpMockServerServiceServer) VerifySequence(ctx context.Context, req *grpcApi.VerifySequenceRequest) (*grpcApi.VerifySequenceResponse, error) {
+	expSvc, err := s.expectationsFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]*expectations.Request, 0, len(req.GetSteps()))
+	for i, step := range req.GetSteps() {
+		request, err := mapper.NewRequest(step)
+		if err != nil {
+			return nil, fmt.Errorf("invalid step %d: %w", i, err)
+		}
+		steps = append(steps, request)
+	}
+
+	result, err := expSvc.VerifySequence(steps, req.GetMode() == grpcApi.SequenceMode_SEQUENCE_MODE_STRICT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify sequence: %w", err)
+	}
+
+	resp := &grpcApi.VerifySequenceResponse{
+		Passed: result.Passed,
+		Report: result.Report,
+	}
+
+	if result.Passed {
+		return resp, nil
+	}
+
+	for _, entry := range result.Actual {
+		protoEntry := &grpcApi.VerifySequenceResponse_SequenceEntry{
+			Exchange:   entry.Assertion.Candidate.Exchange,
+			RoutingKey: entry.Assertion.Candidate.RoutingKey,
+			CreatedAt:  entry.Assertion.CreatedAt.Format(time.RFC3339),
+		}
+		for _, step := range entry.Steps {
+			protoEntry.Steps = append(protoEntry.Steps, uint32(step)) // nolint: gosec
+		}
+		resp.ActualSequence = append(resp.ActualSequence, protoEntry)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifyExpectations tests the VerifyExpectations handler
+func TestVerifyExpectations(t *testing.T) {
+	// Create the server with a real expectations service
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	request := &grpcApi.Request{
+		Exchange:   "orders",
+		RoutingKey: "order.get",
+		Body:       &grpcApi.Request_RegexBody{RegexBody: &grpcApi.RegexBodyAssertion{Regex: "42"}},
+	}
+
+	created, err := server.CreateExpectation(context.Background(), &grpcApi.CreateExpectationRequest{
+		Request:  request,
+		Response: &grpcApi.Response{Body: createJSONValue(t, `{}`)},
+	})
+	require.NoError(t, err)
+
+	// A request that is matched and one that is not
+	for _, body := range []string{`{"id":42}`, `{"id":7}`} {
+		cnd, err := expectations.NewCandidate("orders", "order.get", []byte(body))
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+	}
+
+	zero, two := uint32(0), uint32(2)
+	resp, err := server.VerifyExpectations(context.Background(), &grpcApi.VerifyExpectationsRequest{
+		Verifications: []*grpcApi.Verification{
+			// at least once by default
+			{Target: &grpcApi.Verification_ExpectationId{ExpectationId: created.ExpectationId}},
+			{Target: &grpcApi.Verification_Request{Request: request}, Times: &grpcApi.VerificationTimes{AtMost: &two}},
+			{Target: &grpcApi.Verification_ExpectationId{ExpectationId: created.ExpectationId}, Times: &grpcApi.VerificationTimes{Exactly: &zero}},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Passed)
+	require.Len(t, resp.Results, 3)
+	assert.True(t, resp.Results[0].Passed)
+	assert.True(t, resp.Results[1].Passed)
+	assert.Equal(t, uint32(1), resp.Results[1].Count)
+	assert.False(t, resp.Results[2].Passed)
+	assert.Equal(t, resp.Results[2].Report, resp.Report)
+	assert.Contains(t, resp.Report, "was expected to never be matched, but was matched 1 time")
+
+	// Invalid verifications
+	_, err = server.VerifyExpectations(context.Background(), &grpcApi.VerifyExpectationsRequest{})
+	require.Error(t, err)
+
+	_, err = server.VerifyExpectations(context.Background(), &grpcApi.VerifyExpectationsRequest{
+		Verifications: []*grpcApi.Verification{{}},
+	})
+	require.Error(t, err)
+
+	_, err = server.VerifyExpectations(context.Background(), &grpcApi.VerifyExpectationsRequest{
+		Verifications: []*grpcApi.Verification{{
+			Target: &grpcApi.Verification_Request{Request: request},
+			Times:  &grpcApi.VerificationTimes{Exactly: &two, AtMost: &two},
+		}},
+	})
+	require.ErrorIs(t, err, expectations.ErrBadVerificationTimes)
+}
+
+// TestVerifySequence tests the VerifySequence handler
+func TestVerifySequence(t *testing.T) {
+	expSvc := app.NewExpectationsService()
+	server := &AmqpMockServerServiceServer{
+		expectationsService: expSvc,
+	}
+
+	for _, rk := range []string{"inventory.reserve", "audit.log", "payment.charge"} {
+		cnd, err := expectations.NewCandidate("shop", rk, []byte(`{}`))
+		require.NoError(t, err)
+		expSvc.Match(cnd)
+	}
+
+	step := func(rk string) *grpcApi.Request {
+		return &grpcApi.Request{
+			Exchange:   "shop",
+			RoutingKey: rk,
+			Body:       &grpcApi.Request_RegexBody{RegexBody: &grpcApi.RegexBodyAssertion{Regex: ".*"}},
+		}
+	}
+	steps := []*grpcApi.Request{step("inventory.reserve"), step("payment.charge")}
+
+	// Loose mode by default
+	resp, err := server.VerifySequence(context.Background(), &grpcApi.VerifySequenceRequest{Steps: steps})
+	require.NoError(t, err)
+	assert.True(t, resp.Passed)
+	assert.Empty(t, resp.ActualSequence)
+
+	// Strict mode fails on the request in between and returns the actual sequence
+	resp, err = server.VerifySequence(context.Background(), &grpcApi.VerifySequenceRequest{
+		Steps: steps,
+		Mode:  grpcApi.SequenceMode_SEQUENCE_MODE_STRICT,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Passed)
+	require.Len(t, resp.ActualSequence, 3)
+	assert.Equal(t, "audit.log", resp.ActualSequence[1].RoutingKey)
+	assert.Empty(t, resp.ActualSequence[1].Steps)
+	assert.Equal(t, []uint32{1}, resp.ActualSequence[2].Steps)
+
+	// At least one step is required
+	_, err = server.VerifySequence(context.Background(), &grpcApi.VerifySequenceRequest{})
+	require.ErrorIs(t, err, expectations.ErrEmptySequence)
+}
@@ -0,0 +1,223 @@
+// Package mapper converts expectations between the domain and the API schema. It is shared by the gRPC handlers
+// and the other sources of expectations in the API schema, like the expectation files and the state snapshots.
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+)
+
+// NewExpectation converts a CreateExpectationRequest to a domain expectation.
+func NewExpectation(req *grpcApi.CreateExpectationRequest, opts ...expectations.ExpectationOption) (*expectations.Expectation, error) {
+	if req.GetRequest() == nil {
+		return nil, fmt.Errorf("failed to create expectation request: request is required")
+	}
+
+	request, err := NewRequest(req.Request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation request: %w", err)
+	}
+
+	response, err := NewResponse(req.Response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation response: %w", err)
+	}
+
+	expOpts, err := newExpectationOptions(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation options: %w", err)
+	}
+
+	exp, err := expectations.NewExpectation(request, response, append(expOpts, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation: %w", err)
+	}
+
+	return exp, nil
+}
+
+// NewRequest converts the request of an expectation, or a request to verify, to a domain request.
+func NewRequest(req *grpcApi.Request) (*expectations.Request, error) {
+	comparator, err := NewBodyComparator(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create body comparator: %w", err)
+	}
+
+	opts, err := newRequestOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := expectations.NewRequest(req.Exchange, req.RoutingKey, comparator, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation request: %w", err)
+	}
+
+	return request, nil
+}
+
+func newRequestOptions(req *grpcApi.Request) ([]expectations.RequestOption, error) {
+	opts := make([]expectations.RequestOption, 0, len(req.GetHeaders())+len(req.GetProperties())+2)
+
+	if req.GetExchangeMatchType() == grpcApi.Request_EXCHANGE_MATCH_TYPE_ANY {
+		opts = append(opts, expectations.WithAnyExchange())
+	}
+
+	if req.GetRoutingKeyMatchType() == grpcApi.Request_ROUTING_KEY_MATCH_TYPE_TOPIC {
+		opts = append(opts, expectations.WithTopicRoutingKey())
+	}
+
+	for name, assertion := range req.GetHeaders() {
+		cmp, err := newValueComparator(assertion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create comparator for header %s: %w", name, err)
+		}
+		opts = append(opts, expectations.WithHeaderComparator(name, cmp))
+	}
+
+	for name, assertion := range req.GetProperties() {
+		cmp, err := newValueComparator(assertion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create comparator for property %s: %w", name, err)
+		}
+		opts = append(opts, expectations.WithPropertyComparator(name, cmp))
+	}
+
+	return opts, nil
+}
+
+func newValueComparator(assertion *grpcApi.ValueAssertion) (*comparators.Value, error) {
+	matchType := comparators.ValueMatchTypeExact
+	switch assertion.GetMatchType() {
+	case grpcApi.ValueAssertion_MATCH_TYPE_REGEX:
+		matchType = comparators.ValueMatchTypeRegex
+	case grpcApi.ValueAssertion_MATCH_TYPE_PRESENT:
+		matchType = comparators.ValueMatchTypePresent
+	case grpcApi.ValueAssertion_MATCH_TYPE_ABSENT:
+		matchType = comparators.ValueMatchTypeAbsent
+	}
+
+	return comparators.NewValue(assertion.GetValue(), matchType)
+}
+
+// NewResponse converts a response to a domain response, static or templated.
+func NewResponse(res *grpcApi.Response) (*expectations.Response, error) {
+	if res.GetTemplate() != "" {
+		response, err := expectations.NewTemplateResponse(res.GetTemplate())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create expectation response: %w", err)
+		}
+		response.Headers = res.GetHeaders()
+
+		return response, nil
+	}
+
+	resBodyJSON, err := res.Body.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read expectation response JSON body: %w", err)
+	}
+
+	response, err := expectations.NewResponse(resBodyJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expectation response: %w", err)
+	}
+	response.Headers = res.GetHeaders()
+
+	return response, nil
+}
+
+func newExpectationOptions(req *grpcApi.CreateExpectationRequest) ([]expectations.ExpectationOption, error) {
+	expOpts := make([]expectations.ExpectationOption, 0)
+
+	if req.GetTimes() != nil {
+		if req.GetTimes().GetRemainingTimes() > 0 {
+			expOpts = append(expOpts, expectations.WithLimitedTimes(req.GetTimes().GetRemainingTimes()))
+		} else {
+			expOpts = append(expOpts, expectations.WithUnlimitedTimes())
+		}
+	}
+
+	if req.TimeToLiveSeconds != nil {
+		expOpts = append(expOpts, expectations.WithTimeToLive(time.Duration(float64(*req.TimeToLiveSeconds)*float64(time.Second))))
+	}
+
+	if req.Delay != nil {
+		delay, err := newDelay(req.Delay)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delay: %w", err)
+		}
+		expOpts = append(expOpts, expectations.WithDelay(delay))
+	}
+
+	if req.GetPriority() != 0 {
+		expOpts = append(expOpts, expectations.WithPriority(int(req.GetPriority())))
+	}
+
+	if req.GetName() != "" {
+		expOpts = append(expOpts, expectations.WithName(req.GetName()))
+	}
+
+	if len(req.GetLabels()) > 0 {
+		expOpts = append(expOpts, expectations.WithLabels(req.GetLabels()))
+	}
+
+	if req.GetAction() == grpcApi.Action_ACTION_DROP {
+		expOpts = append(expOpts, expectations.WithDropReply())
+	}
+
+	if req.Scenario != nil {
+		sc, err := expectations.NewScenario(req.Scenario.GetName(), req.Scenario.GetRequiredState(), req.Scenario.GetNewState())
+		if err != nil {
+			return nil, fmt.Errorf("invalid scenario: %w", err)
+		}
+		expOpts = append(expOpts, expectations.WithScenario(sc))
+	}
+
+	return expOpts, nil
+}
+
+func newDelay(delay *grpcApi.Delay) (*expectations.Delay, error) {
+	switch d := delay.GetDelay().(type) {
+	case *grpcApi.Delay_FixedMs:
+		return expectations.NewFixedDelay(time.Duration(d.FixedMs) * time.Millisecond)
+	case *grpcApi.Delay_Uniform:
+		return expectations.NewUniformDelay(
+			time.Duration(d.Uniform.GetMinMs())*time.Millisecond,
+			time.Duration(d.Uniform.GetMaxMs())*time.Millisecond,
+		)
+	case *grpcApi.Delay_LogNormal:
+		return expectations.NewLogNormalDelay(time.Duration(d.LogNormal.GetMedianMs())*time.Millisecond, d.LogNormal.GetSigma())
+	default:
+		return nil, fmt.Errorf("unsupported delay type: %T", d)
+	}
+}
+
+// NewBodyComparator converts the body assertion of a request to a domain body comparator.
+// nolint: ireturn
+func NewBodyComparator(req *grpcApi.Request) (expectations.BodyComparator, error) {
+	switch body := req.GetBody().(type) {
+	case *grpcApi.Request_JsonBody:
+		matchType := comparators.MatchTypeExact
+		switch body.JsonBody.GetMatchType() {
+		case grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT:
+			matchType = comparators.MatchTypeExact
+		case grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL:
+			matchType = comparators.MatchTypePartial
+		}
+
+		rawBody, err := body.JsonBody.Body.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read expectation request JSON body: %w", err)
+		}
+
+		return comparators.NewJSONBody(rawBody, matchType)
+	case *grpcApi.Request_RegexBody:
+		return comparators.NewRegex(body.RegexBody.GetRegex())
+	default:
+		return nil, fmt.Errorf("unsupported request body type: %T", body)
+	}
+}
@@ -0,0 +1,363 @@
+package mapper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestNewRequest tests the NewRequest function
+func TestNewRequest(t *testing.T) {
+	// Create a proto request with JSON body
+	protoReq := &grpcApi.Request{
+		Exchange:   "test-exchange",
+		RoutingKey: "test-routing-key",
+		Body: &grpcApi.Request_JsonBody{
+			JsonBody: &grpcApi.JSONBodyAssertion{
+				MatchType: grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
+				Body:      createJSONStruct(t, `{"foo":"bar"}`),
+			},
+		},
+	}
+
+	// Convert to domain request
+	domainReq, err := NewRequest(protoReq)
+
+	// Verify the conversion
+	require.NoError(t, err)
+	assert.Equal(t, "test-exchange", domainReq.Exchange)
+	assert.Equal(t, "test-routing-key", domainReq.RoutingKey)
+
+	// Verify the body comparator
+	jsonBody, ok := domainReq.BodyComparator.(*comparators.JSONBody)
+	require.True(t, ok, "Expected JSONBody comparator")
+	assert.Equal(t, comparators.MatchTypePartial, jsonBody.MatchType)
+
+	// Create a proto request with Regex body
+	protoReq = &grpcApi.Request{
+		Exchange:   "test-exchange",
+		RoutingKey: "test-routing-key",
+		Body: &grpcApi.Request_RegexBody{
+			RegexBody: &grpcApi.RegexBodyAssertion{
+				Regex: "foo.*bar",
+			},
+		},
+	}
+
+	// Convert to domain request
+	domainReq, err = NewRequest(protoReq)
+
+	// Verify the conversion
+	require.NoError(t, err)
+	assert.Equal(t, "test-exchange", domainReq.Exchange)
+	assert.Equal(t, "test-routing-key", domainReq.RoutingKey)
+
+	// Verify the body comparator
+	regexBody, ok := domainReq.BodyComparator.(*comparators.Regex)
+	require.True(t, ok, "Expected Regex comparator")
+	assert.Equal(t, "foo.*bar", regexBody.Regex.String())
+
+	// Create a proto request with header and property matchers
+	protoReq.Headers = map[string]*grpcApi.ValueAssertion{
+		"x-tenant": {Value: "acme"},
+	}
+	protoReq.Properties = map[string]*grpcApi.ValueAssertion{
+		"type": {Value: "^invoice", MatchType: grpcApi.ValueAssertion_MATCH_TYPE_REGEX},
+	}
+
+	domainReq, err = NewRequest(protoReq)
+	require.NoError(t, err)
+	require.Contains(t, domainReq.HeaderComparators, "x-tenant")
+	require.Contains(t, domainReq.PropertyComparators, "type")
+
+	tenantCmp, ok := domainReq.HeaderComparators["x-tenant"].(*comparators.Value)
+	require.True(t, ok, "Expected Value comparator")
+	assert.Equal(t, comparators.ValueMatchTypeExact, tenantCmp.MatchType)
+
+	// Topic routing key and any exchange
+	topicReq := &grpcApi.Request{
+		RoutingKey:          "billing.*.invoice.#",
+		ExchangeMatchType:   grpcApi.Request_EXCHANGE_MATCH_TYPE_ANY,
+		RoutingKeyMatchType: grpcApi.Request_ROUTING_KEY_MATCH_TYPE_TOPIC,
+		Body:                protoReq.Body,
+	}
+	domainReq, err = NewRequest(topicReq)
+	require.NoError(t, err)
+	assert.Equal(t, expectations.ExchangeMatchTypeAny, domainReq.ExchangeMatchType)
+	assert.Equal(t, expectations.RoutingKeyMatchTypeTopic, domainReq.RoutingKeyMatchType)
+
+	// Empty exchange is rejected without the any exchange mode
+	topicReq.ExchangeMatchType = grpcApi.Request_EXCHANGE_MATCH_TYPE_EXACT
+	_, err = NewRequest(topicReq)
+	require.ErrorIs(t, err, expectations.ErrEmptyExchange)
+
+	// Unknown property names are rejected
+	protoReq.Properties = map[string]*grpcApi.ValueAssertion{"unknown": {Value: "foo"}}
+	_, err = NewRequest(protoReq)
+	require.ErrorIs(t, err, expectations.ErrUnknownProperty)
+}
+
+// TestNewResponse tests the NewResponse function
+func TestNewResponse(t *testing.T) {
+	// Create a proto response
+	protoRes := &grpcApi.Response{
+		Body: createJSONValue(t, `{"result":"success"}`),
+	}
+
+	// Convert to domain response
+	domainRes, err := NewResponse(protoRes)
+
+	// Verify the conversion
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"result":"success"}`, string(domainRes.Body))
+
+	// Create a proto response with a template
+	protoRes = &grpcApi.Response{
+		Template: `{"id": {{ json .Body.id }}}`,
+	}
+
+	domainRes, err = NewResponse(protoRes)
+	require.NoError(t, err)
+	assert.True(t, domainRes.IsTemplate())
+	assert.Equal(t, protoRes.Template, NewProtoResponse(domainRes).Template)
+
+	// Invalid templates are rejected
+	_, err = NewResponse(&grpcApi.Response{Template: `{{ .Body.id `})
+	require.Error(t, err)
+}
+
+// TestNewExpectationOptions tests the newExpectationOptions function
+func TestNewExpectationOptions(t *testing.T) {
+	t.Run("with limited times", func(t *testing.T) {
+		// Create a proto request with limited times
+		times := uint32(5)
+		protoReq := &grpcApi.CreateExpectationRequest{
+			Times: &grpcApi.Times{
+				Times: &grpcApi.Times_RemainingTimes{
+					RemainingTimes: times,
+				},
+			},
+		}
+
+		// Get the options
+		options, err := newExpectationOptions(protoReq)
+		require.NoError(t, err)
+
+		// Create an expectation with these options
+		exp, err := createTestExpectation("exchange", "rk", options...)
+		require.NoError(t, err)
+
+		// Verify the times
+		assert.NotNil(t, exp.Times)
+		assert.False(t, exp.Times.Unlimited)
+		assert.Equal(t, times, exp.Times.RemainingTimes)
+	})
+
+	t.Run("with unlimited times", func(t *testing.T) {
+		// Create a proto request with unlimited times
+		protoReq := &grpcApi.CreateExpectationRequest{
+			Times: &grpcApi.Times{
+				Times: &grpcApi.Times_Unlimited{
+					Unlimited: true,
+				},
+			},
+		}
+
+		// Get the options
+		options, err := newExpectationOptions(protoReq)
+		require.NoError(t, err)
+
+		// Create an expectation with these options
+		exp, err := createTestExpectation("exchange", "rk", options...)
+		require.NoError(t, err)
+
+		// Verify the times
+		assert.NotNil(t, exp.Times)
+		assert.True(t, exp.Times.Unlimited)
+	})
+
+	t.Run("with TTL", func(t *testing.T) {
+		// Create a proto request with TTL
+		ttlSeconds := float32(60)
+		protoReq := &grpcApi.CreateExpectationRequest{
+			TimeToLiveSeconds: &ttlSeconds,
+		}
+
+		// Get the options
+		options, err := newExpectationOptions(protoReq)
+		require.NoError(t, err)
+
+		// Create an expectation with these options
+		exp, err := createTestExpectation("exchange", "rk", options...)
+		require.NoError(t, err)
+
+		// Verify the TTL
+		assert.NotNil(t, exp.TimeToLive)
+		assert.Equal(t, time.Duration(float64(ttlSeconds)*float64(time.Second)), exp.TimeToLive.TTL)
+	})
+
+	t.Run("with delay and drop action", func(t *testing.T) {
+		// Create a proto request with a uniform delay and the drop action
+		protoReq := &grpcApi.CreateExpectationRequest{
+			Delay: &grpcApi.Delay{
+				Delay: &grpcApi.Delay_Uniform{
+					Uniform: &grpcApi.Delay_UniformDelay{MinMs: 100, MaxMs: 200},
+				},
+			},
+			Action: grpcApi.Action_ACTION_DROP,
+		}
+
+		// Get the options
+		options, err := newExpectationOptions(protoReq)
+		require.NoError(t, err)
+
+		// Create an expectation with these options
+		exp, err := createTestExpectation("exchange", "rk", options...)
+		require.NoError(t, err)
+
+		// Verify the delay and the action
+		require.NotNil(t, exp.Delay)
+		assert.Equal(t, expectations.DelayTypeUniform, exp.Delay.Type)
+		assert.Equal(t, 100*time.Millisecond, exp.Delay.Min)
+		assert.Equal(t, 200*time.Millisecond, exp.Delay.Max)
+		assert.Equal(t, expectations.ActionDrop, exp.Action)
+		assert.Equal(t, protoReq.Delay.GetUniform().GetMaxMs(), NewProtoExpectation(exp).GetDelay().GetUniform().GetMaxMs())
+		assert.Equal(t, grpcApi.Action_ACTION_DROP, NewProtoExpectation(exp).GetAction())
+	})
+
+	t.Run("with priority and name", func(t *testing.T) {
+		protoReq := &grpcApi.CreateExpectationRequest{
+			Priority: 10,
+			Name:     "order-get",
+		}
+
+		options, err := newExpectationOptions(protoReq)
+		require.NoError(t, err)
+
+		exp, err := createTestExpectation("exchange", "rk", options...)
+		require.NoError(t, err)
+
+		assert.Equal(t, 10, exp.Priority)
+		assert.Equal(t, "order-get", exp.Name)
+		assert.Equal(t, int32(10), NewProtoExpectation(exp).Priority)
+		assert.Equal(t, "order-get", NewCreateExpectationRequest(exp).Name)
+	})
+
+	t.Run("with invalid delay", func(t *testing.T) {
+		// Create a proto request with an inverted delay range
+		protoReq := &grpcApi.CreateExpectationRequest{
+			Delay: &grpcApi.Delay{
+				Delay: &grpcApi.Delay_Uniform{
+					Uniform: &grpcApi.Delay_UniformDelay{MinMs: 200, MaxMs: 100},
+				},
+			},
+		}
+
+		// Get the options
+		_, err := newExpectationOptions(protoReq)
+		require.ErrorIs(t, err, expectations.ErrBadDelayRange)
+	})
+}
+
+// TestNewBodyComparator tests the NewBodyComparator function
+func TestNewBodyComparator(t *testing.T) {
+	t.Run("with JSON body", func(t *testing.T) {
+		// Create a proto request with JSON body
+		protoReq := &grpcApi.Request{
+			Body: &grpcApi.Request_JsonBody{
+				JsonBody: &grpcApi.JSONBodyAssertion{
+					MatchType: grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL,
+					Body:      createJSONStruct(t, `{"foo":"bar"}`),
+				},
+			},
+		}
+
+		// Create a comparator
+		comparator, err := NewBodyComparator(protoReq)
+
+		// Verify the comparator
+		require.NoError(t, err)
+		jsonBody, ok := comparator.(*comparators.JSONBody)
+		require.True(t, ok, "Expected JSONBody comparator")
+		assert.Equal(t, comparators.MatchTypePartial, jsonBody.MatchType)
+	})
+
+	t.Run("with Regex body", func(t *testing.T) {
+		// Create a proto request with Regex body
+		protoReq := &grpcApi.Request{
+			Body: &grpcApi.Request_RegexBody{
+				RegexBody: &grpcApi.RegexBodyAssertion{
+					Regex: "foo.*bar",
+				},
+			},
+		}
+
+		// Create a comparator
+		comparator, err := NewBodyComparator(protoReq)
+
+		// Verify the comparator
+		require.NoError(t, err)
+		regexBody, ok := comparator.(*comparators.Regex)
+		require.True(t, ok, "Expected Regex comparator")
+		assert.Equal(t, "foo.*bar", regexBody.Regex.String())
+	})
+}
+
+// Helper function to create a test expectation
+func createTestExpectation(exchange, routingKey string, opts ...expectations.ExpectationOption) (*expectations.Expectation, error) {
+	// Create a body comparator
+	bodyComparator, err := comparators.NewJSONBody([]byte(`{"foo":"bar"}`), comparators.MatchTypePartial)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a request
+	request, err := expectations.NewRequest(exchange, routingKey, bodyComparator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a response
+	response, err := expectations.NewResponse([]byte(`{"result":"success"}`))
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an expectation
+	return expectations.NewExpectation(request, response, opts...)
+}
+
+// Helper function to create a JSON struct for testing
+func createJSONStruct(t *testing.T, jsonStr string) *structpb.Struct {
+	t.Helper()
+
+	var v map[string]interface{}
+	err := json.Unmarshal([]byte(jsonStr), &v)
+	require.NoError(t, err)
+
+	pbValue, err := structpb.NewStruct(v)
+	require.NoError(t, err)
+
+	return pbValue
+}
+
+// Helper function to create a JSON value for testing
+func createJSONValue(t *testing.T, jsonStr string) *structpb.Value {
+	t.Helper()
+
+	var v interface{}
+	err := json.Unmarshal([]byte(jsonStr), &v)
+	require.NoError(t, err)
+
+	pbValue, err := structpb.NewValue(v)
+	require.NoError(t, err)
+
+	return pbValue
+}
@@ -0,0 +1,274 @@
+package mapper
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// NewProtoSessionID returns the ID of the owning session, nil if there is none.
+func NewProtoSessionID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	s := id.String()
+
+	return &s
+}
+
+// NewProtoExpectation converts a domain expectation to its API representation.
+func NewProtoExpectation(exp *expectations.Expectation) *grpcApi.Expectation {
+	expDTO := &grpcApi.Expectation{
+		Id:        exp.ID.String(),
+		Request:   newProtoRequest(exp.Request),
+		Response:  NewProtoResponse(exp.Response),
+		CreatedAt: exp.CreatedAt.Format(time.RFC3339),
+		Action:    newProtoAction(exp.Action),
+		Source:    exp.Source,
+		Priority:  int32(exp.Priority), // nolint: gosec
+		Name:      exp.Name,
+		SessionId: NewProtoSessionID(exp.SessionID),
+		Labels:    exp.Labels,
+	}
+
+	if exp.Times != nil {
+		if exp.Times.Unlimited {
+			expDTO.Times = &grpcApi.Times{
+				Times: &grpcApi.Times_Unlimited{
+					Unlimited: true,
+				},
+			}
+		} else {
+			expDTO.Times = &grpcApi.Times{
+				Times: &grpcApi.Times_RemainingTimes{
+					RemainingTimes: exp.Times.RemainingTimes,
+				},
+			}
+		}
+	}
+
+	if exp.TimeToLive != nil {
+		expiresAt := exp.CreatedAt.Add(exp.TimeToLive.TTL).Format(time.RFC3339)
+		expDTO.ExpiresAt = &expiresAt
+		ttl := float32(exp.TimeToLive.TTL.Seconds())
+		expDTO.TimeToLiveSeconds = &ttl
+	}
+
+	if exp.Delay != nil {
+		expDTO.Delay = newProtoDelay(exp.Delay)
+	}
+
+	if exp.Scenario != nil {
+		expDTO.Scenario = newProtoScenario(exp.Scenario)
+	}
+
+	return expDTO
+}
+
+// NewCreateExpectationRequest converts an expectation to the request creating an equal expectation.
+// It is the counterpart of NewExpectation.
+func NewCreateExpectationRequest(exp *expectations.Expectation) *grpcApi.CreateExpectationRequest {
+	protoExp := NewProtoExpectation(exp)
+
+	req := &grpcApi.CreateExpectationRequest{
+		Request:           protoExp.Request,
+		Response:          protoExp.Response,
+		Times:             protoExp.Times,
+		Delay:             protoExp.Delay,
+		Action:            protoExp.Action,
+		Scenario:          protoExp.Scenario,
+		Priority:          protoExp.Priority,
+		Name:              protoExp.Name,
+		TimeToLiveSeconds: protoExp.TimeToLiveSeconds,
+		Labels:            protoExp.Labels,
+	}
+
+	return req
+}
+
+func newProtoDelay(d *expectations.Delay) *grpcApi.Delay {
+	switch d.Type {
+	case expectations.DelayTypeUniform:
+		return &grpcApi.Delay{Delay: &grpcApi.Delay_Uniform{Uniform: &grpcApi.Delay_UniformDelay{
+			MinMs: uint32(d.Min.Milliseconds()), // nolint: gosec
+			MaxMs: uint32(d.Max.Milliseconds()), // nolint: gosec
+		}}}
+	case expectations.DelayTypeLogNormal:
+		return &grpcApi.Delay{Delay: &grpcApi.Delay_LogNormal{LogNormal: &grpcApi.Delay_LogNormalDelay{
+			MedianMs: uint32(d.Median.Milliseconds()), // nolint: gosec
+			Sigma:    d.Sigma,
+		}}}
+	default:
+		return &grpcApi.Delay{Delay: &grpcApi.Delay_FixedMs{FixedMs: uint32(d.Fixed.Milliseconds())}} // nolint: gosec
+	}
+}
+
+func newProtoScenario(sc *expectations.Scenario) *grpcApi.Scenario {
+	return &grpcApi.Scenario{
+		Name:          sc.Name,
+		RequiredState: sc.RequiredState,
+		NewState:      sc.NewState,
+	}
+}
+
+func newProtoAction(a expectations.Action) grpcApi.Action {
+	switch a {
+	case expectations.ActionDrop:
+		return grpcApi.Action_ACTION_DROP
+	default:
+		return grpcApi.Action_ACTION_REPLY
+	}
+}
+
+func newProtoRequest(req *expectations.Request) *grpcApi.Request {
+	protoReq := &grpcApi.Request{
+		Exchange:            req.Exchange,
+		ExchangeMatchType:   newProtoExchangeMatchType(req.ExchangeMatchType),
+		RoutingKey:          req.RoutingKey,
+		RoutingKeyMatchType: newProtoRoutingKeyMatchType(req.RoutingKeyMatchType),
+	}
+
+	switch b := req.BodyComparator.(type) {
+	case *comparators.JSONBody:
+		var v map[string]interface{}
+		if err := json.Unmarshal(b.Body, &v); err != nil || v == nil {
+			// the API only describes JSON objects, other JSON bodies are matched literally
+			protoReq.Body = newProtoLiteralRegexBody(b.Body)
+			break
+		}
+
+		pbValue, err := structpb.NewStruct(v)
+		if err != nil {
+			protoReq.Body = newProtoLiteralRegexBody(b.Body)
+			break
+		}
+
+		protoReq.Body = &grpcApi.Request_JsonBody{
+			JsonBody: &grpcApi.JSONBodyAssertion{
+				Body:      pbValue,
+				MatchType: newProtoMatchType(b.MatchType),
+			},
+		}
+	case *comparators.Regex:
+		protoReq.Body = &grpcApi.Request_RegexBody{
+			RegexBody: &grpcApi.RegexBodyAssertion{
+				Regex: b.Regex.String(),
+			},
+		}
+	}
+
+	protoReq.Headers = newProtoValueAssertions(req.HeaderComparators)
+	protoReq.Properties = newProtoValueAssertions(req.PropertyComparators)
+
+	return protoReq
+}
+
+// newProtoLiteralRegexBody returns a regex body assertion matching exactly the given body.
+func newProtoLiteralRegexBody(body []byte) *grpcApi.Request_RegexBody {
+	return &grpcApi.Request_RegexBody{
+		RegexBody: &grpcApi.RegexBodyAssertion{
+			Regex: "^" + regexp.QuoteMeta(string(body)) + "$",
+		},
+	}
+}
+
+func newProtoExchangeMatchType(mt expectations.ExchangeMatchType) grpcApi.Request_ExchangeMatchType {
+	switch mt {
+	case expectations.ExchangeMatchTypeExact:
+		return grpcApi.Request_EXCHANGE_MATCH_TYPE_EXACT
+	case expectations.ExchangeMatchTypeAny:
+		return grpcApi.Request_EXCHANGE_MATCH_TYPE_ANY
+	default:
+		return grpcApi.Request_EXCHANGE_MATCH_TYPE_UNSPECIFIED
+	}
+}
+
+func newProtoRoutingKeyMatchType(mt expectations.RoutingKeyMatchType) grpcApi.Request_RoutingKeyMatchType {
+	switch mt {
+	case expectations.RoutingKeyMatchTypeExact:
+		return grpcApi.Request_ROUTING_KEY_MATCH_TYPE_EXACT
+	case expectations.RoutingKeyMatchTypeTopic:
+		return grpcApi.Request_ROUTING_KEY_MATCH_TYPE_TOPIC
+	default:
+		return grpcApi.Request_ROUTING_KEY_MATCH_TYPE_UNSPECIFIED
+	}
+}
+
+func newProtoValueAssertions(cmps map[string]expectations.ValueComparator) map[string]*grpcApi.ValueAssertion {
+	if len(cmps) == 0 {
+		return nil
+	}
+
+	assertions := make(map[string]*grpcApi.ValueAssertion, len(cmps))
+	for name, cmp := range cmps {
+		v, ok := cmp.(*comparators.Value)
+		if !ok {
+			continue
+		}
+
+		assertions[name] = &grpcApi.ValueAssertion{
+			Value:     v.Value,
+			MatchType: newProtoValueMatchType(v.MatchType),
+		}
+	}
+
+	return assertions
+}
+
+func newProtoValueMatchType(mt comparators.ValueMatchType) grpcApi.ValueAssertion_MatchType {
+	switch mt {
+	case comparators.ValueMatchTypeExact:
+		return grpcApi.ValueAssertion_MATCH_TYPE_EXACT
+	case comparators.ValueMatchTypeRegex:
+		return grpcApi.ValueAssertion_MATCH_TYPE_REGEX
+	case comparators.ValueMatchTypePresent:
+		return grpcApi.ValueAssertion_MATCH_TYPE_PRESENT
+	case comparators.ValueMatchTypeAbsent:
+		return grpcApi.ValueAssertion_MATCH_TYPE_ABSENT
+	default:
+		return grpcApi.ValueAssertion_MATCH_TYPE_UNSPECIFIED
+	}
+}
+
+func newProtoMatchType(mt comparators.MatchType) grpcApi.JSONBodyAssertion_MatchType {
+	switch mt {
+	case comparators.MatchTypeExact:
+		return grpcApi.JSONBodyAssertion_MATCH_TYPE_EXACT
+	case comparators.MatchTypePartial:
+		return grpcApi.JSONBodyAssertion_MATCH_TYPE_PARTIAL
+	default:
+		return grpcApi.JSONBodyAssertion_MATCH_TYPE_UNSPECIFIED
+	}
+}
+
+// NewProtoResponse converts a domain response to its API representation, nil if it has no body.
+func NewProtoResponse(res *expectations.Response) *grpcApi.Response {
+	if res.IsTemplate() {
+		return &grpcApi.Response{Template: res.Template, Headers: res.Headers}
+	}
+
+	if len(res.Body) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(res.Body, &v); err != nil {
+		// a body that is not JSON, like a recorded reply, is described by a template printing it as is
+		return &grpcApi.Response{Template: "{{" + strconv.Quote(string(res.Body)) + "}}", Headers: res.Headers}
+	}
+
+	pbValue, err := structpb.NewValue(v)
+	if err != nil {
+		return nil
+	}
+
+	return &grpcApi.Response{Body: pbValue, Headers: res.Headers}
+}
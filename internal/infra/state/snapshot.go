@@ -0,0 +1,353 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/infra/mapper"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// snapshot is the JSON representation of the server state.
+type snapshot struct {
+	Expectations  []*expectationRecord  `json:"expectations"`
+	Subscriptions []*subscriptionRecord `json:"subscriptions"`
+	Assertions    []*assertionRecord    `json:"assertions,omitempty"`
+	// ScenarioStates are keyed by scenario name, scenarios in their initial state are omitted.
+	ScenarioStates map[string]string `json:"scenario_states,omitempty"`
+}
+
+// expectationRecord keeps the definition of an expectation in the API schema along with its runtime state.
+type expectationRecord struct {
+	ID         uuid.UUID       `json:"id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Source     string          `json:"source,omitempty"`
+	Times      *timesRecord    `json:"times,omitempty"`
+	Definition json.RawMessage `json:"definition"`
+}
+
+type timesRecord struct {
+	RemainingTimes uint32 `json:"remaining_times"`
+	Unlimited      bool   `json:"unlimited"`
+}
+
+type subscriptionRecord struct {
+	ID             uuid.UUID          `json:"id"`
+	Queue          string             `json:"queue"`
+	FallbackPolicy string             `json:"fallback_policy,omitempty"`
+	ProxyTarget    *proxyTargetRecord `json:"proxy_target,omitempty"`
+	Namespace      string             `json:"namespace,omitempty"`
+}
+
+type proxyTargetRecord struct {
+	Exchange   string `json:"exchange"`
+	RoutingKey string `json:"routing_key,omitempty"`
+}
+
+type assertionRecord struct {
+	// ID is nil in snapshots written before assertions had IDs, they get a new one when restored.
+	ID          uuid.UUID          `json:"id"`
+	Candidate   *candidateRecord   `json:"candidate"`
+	Expectation *expectationRecord `json:"expectation,omitempty"`
+	Proxy       *proxyResultRecord `json:"proxy,omitempty"`
+	Reply       *replyRecord       `json:"reply,omitempty"`
+	NearMisses  []*nearMissRecord  `json:"near_misses,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type candidateRecord struct {
+	Exchange       string                  `json:"exchange"`
+	RoutingKey     string                  `json:"routing_key"`
+	Queue          string                  `json:"queue,omitempty"`
+	SubscriptionID uuid.UUID               `json:"subscription_id"`
+	Headers        map[string]string       `json:"headers,omitempty"`
+	Properties     expectations.Properties `json:"properties"`
+	Delivery       expectations.Delivery   `json:"delivery"`
+	Body           []byte                  `json:"body"`
+}
+
+type nearMissRecord struct {
+	Expectation *expectationRecord `json:"expectation"`
+	Reasons     []string           `json:"reasons"`
+	BodyDiff    string             `json:"body_diff,omitempty"`
+}
+
+type replyRecord struct {
+	Queue       string                  `json:"queue"`
+	Headers     map[string]string       `json:"headers,omitempty"`
+	Properties  expectations.Properties `json:"properties"`
+	Body        []byte                  `json:"body"`
+	PublishedAt time.Time               `json:"published_at"`
+	Latency     time.Duration           `json:"latency"`
+	Error       string                  `json:"error,omitempty"`
+}
+
+type proxyResultRecord struct {
+	Exchange   string `json:"exchange"`
+	RoutingKey string `json:"routing_key"`
+	Response   []byte `json:"response,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+func newSnapshot(state *app.State) (*snapshot, error) {
+	snap := &snapshot{
+		Expectations:   make([]*expectationRecord, 0, len(state.Expectations)),
+		Subscriptions:  make([]*subscriptionRecord, 0, len(state.Subscriptions)),
+		ScenarioStates: state.ScenarioStates,
+	}
+
+	for _, exp := range state.Expectations {
+		rec, err := newExpectationRecord(exp)
+		if err != nil {
+			return nil, err
+		}
+		snap.Expectations = append(snap.Expectations, rec)
+	}
+
+	for _, sub := range state.Subscriptions {
+		snap.Subscriptions = append(snap.Subscriptions, newSubscriptionRecord(sub))
+	}
+
+	for _, assertion := range state.Assertions {
+		rec, err := newAssertionRecord(assertion)
+		if err != nil {
+			return nil, err
+		}
+		snap.Assertions = append(snap.Assertions, rec)
+	}
+
+	return snap, nil
+}
+
+func (s *snapshot) state() (*app.State, error) {
+	state := &app.State{
+		Expectations:   make([]*expectations.Expectation, 0, len(s.Expectations)),
+		Subscriptions:  make([]*subscriptions.Subscription, 0, len(s.Subscriptions)),
+		ScenarioStates: s.ScenarioStates,
+	}
+
+	for _, rec := range s.Expectations {
+		exp, err := rec.expectation()
+		if err != nil {
+			return nil, err
+		}
+		state.Expectations = append(state.Expectations, exp)
+	}
+
+	for _, rec := range s.Subscriptions {
+		state.Subscriptions = append(state.Subscriptions, rec.subscription())
+	}
+
+	for _, rec := range s.Assertions {
+		assertion, err := rec.assertion()
+		if err != nil {
+			return nil, err
+		}
+		state.Assertions = append(state.Assertions, assertion)
+	}
+
+	return state, nil
+}
+
+func newExpectationRecord(exp *expectations.Expectation) (*expectationRecord, error) {
+	definition, err := protojson.Marshal(mapper.NewCreateExpectationRequest(exp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode expectation %s: %w", exp.ID, err)
+	}
+
+	rec := &expectationRecord{
+		ID:         exp.ID,
+		CreatedAt:  exp.CreatedAt,
+		Source:     exp.Source,
+		Definition: definition,
+	}
+
+	if exp.Times != nil {
+		rec.Times = &timesRecord{
+			RemainingTimes: exp.Times.RemainingTimes,
+			Unlimited:      exp.Times.Unlimited,
+		}
+	}
+
+	return rec, nil
+}
+
+func (r *expectationRecord) expectation() (*expectations.Expectation, error) {
+	req := &grpcApi.CreateExpectationRequest{}
+	if err := protojson.Unmarshal(r.Definition, req); err != nil {
+		return nil, fmt.Errorf("failed to decode expectation %s: %w", r.ID, err)
+	}
+
+	opts := []expectations.ExpectationOption{
+		expectations.WithID(r.ID),
+		expectations.WithCreatedAt(r.CreatedAt),
+		expectations.WithSource(r.Source),
+	}
+
+	if r.Times != nil {
+		opts = append(opts, expectations.WithTimes(&expectations.Times{
+			RemainingTimes: r.Times.RemainingTimes,
+			Unlimited:      r.Times.Unlimited,
+		}))
+	}
+
+	exp, err := mapper.NewExpectation(req, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore expectation %s: %w", r.ID, err)
+	}
+
+	return exp, nil
+}
+
+func newSubscriptionRecord(sub *subscriptions.Subscription) *subscriptionRecord {
+	rec := &subscriptionRecord{
+		ID:             sub.ID(),
+		Queue:          sub.Queue(),
+		FallbackPolicy: string(sub.FallbackPolicy()),
+		Namespace:      sub.Namespace(),
+	}
+
+	if t := sub.ProxyTarget(); t != nil {
+		rec.ProxyTarget = &proxyTargetRecord{
+			Exchange:   t.Exchange,
+			RoutingKey: t.RoutingKey,
+		}
+	}
+
+	return rec
+}
+
+func (r *subscriptionRecord) subscription() *subscriptions.Subscription {
+	opts := []subscriptions.Option{
+		subscriptions.WithID(r.ID),
+		subscriptions.WithFallbackPolicy(subscriptions.FallbackPolicy(r.FallbackPolicy)),
+		subscriptions.WithNamespace(r.Namespace),
+	}
+
+	if r.ProxyTarget != nil {
+		opts = append(opts, subscriptions.WithProxyTarget(&subscriptions.ProxyTarget{
+			Exchange:   r.ProxyTarget.Exchange,
+			RoutingKey: r.ProxyTarget.RoutingKey,
+		}))
+	}
+
+	return subscriptions.NewSubscription(r.Queue, opts...)
+}
+
+func newAssertionRecord(assertion *expectations.Assertion) (*assertionRecord, error) {
+	rec := &assertionRecord{
+		ID: assertion.ID,
+		Candidate: &candidateRecord{
+			Exchange:       assertion.Candidate.Exchange,
+			RoutingKey:     assertion.Candidate.RoutingKey,
+			Queue:          assertion.Candidate.Queue,
+			SubscriptionID: assertion.Candidate.SubscriptionID,
+			Headers:        assertion.Candidate.Headers,
+			Properties:     assertion.Candidate.Properties,
+			Delivery:       assertion.Candidate.Delivery,
+			Body:           assertion.Candidate.Body,
+		},
+		CreatedAt: assertion.CreatedAt,
+	}
+
+	if assertion.Expectation != nil {
+		exp, err := newExpectationRecord(assertion.Expectation)
+		if err != nil {
+			return nil, err
+		}
+		rec.Expectation = exp
+	}
+
+	if assertion.Proxy != nil {
+		rec.Proxy = &proxyResultRecord{
+			Exchange:   assertion.Proxy.Exchange,
+			RoutingKey: assertion.Proxy.RoutingKey,
+			Response:   assertion.Proxy.Response,
+			Error:      assertion.Proxy.Error,
+		}
+	}
+
+	if assertion.Reply != nil {
+		rec.Reply = &replyRecord{
+			Queue:       assertion.Reply.Queue,
+			Headers:     assertion.Reply.Headers,
+			Properties:  assertion.Reply.Properties,
+			Body:        assertion.Reply.Body,
+			PublishedAt: assertion.Reply.PublishedAt,
+			Latency:     assertion.Reply.Latency,
+			Error:       assertion.Reply.Error,
+		}
+	}
+
+	for _, nm := range assertion.NearMisses {
+		exp, err := newExpectationRecord(nm.Expectation)
+		if err != nil {
+			return nil, err
+		}
+		rec.NearMisses = append(rec.NearMisses, &nearMissRecord{Expectation: exp, Reasons: nm.Reasons, BodyDiff: nm.BodyDiff})
+	}
+
+	return rec, nil
+}
+
+func (r *assertionRecord) assertion() (*expectations.Assertion, error) {
+	assertion := &expectations.Assertion{
+		ID: r.ID,
+		Candidate: &expectations.Candidate{
+			Exchange:       r.Candidate.Exchange,
+			RoutingKey:     r.Candidate.RoutingKey,
+			Queue:          r.Candidate.Queue,
+			SubscriptionID: r.Candidate.SubscriptionID,
+			Headers:        r.Candidate.Headers,
+			Properties:     r.Candidate.Properties,
+			Delivery:       r.Candidate.Delivery,
+			Body:           r.Candidate.Body,
+		},
+		CreatedAt: r.CreatedAt,
+	}
+
+	if r.Expectation != nil {
+		exp, err := r.Expectation.expectation()
+		if err != nil {
+			return nil, err
+		}
+		assertion.Expectation = exp
+	}
+
+	if r.Proxy != nil {
+		assertion.Proxy = &expectations.ProxyResult{
+			Exchange:   r.Proxy.Exchange,
+			RoutingKey: r.Proxy.RoutingKey,
+			Response:   r.Proxy.Response,
+			Error:      r.Proxy.Error,
+		}
+	}
+
+	if r.Reply != nil {
+		assertion.Reply = &expectations.Reply{
+			Queue:       r.Reply.Queue,
+			Headers:     r.Reply.Headers,
+			Properties:  r.Reply.Properties,
+			Body:        r.Reply.Body,
+			PublishedAt: r.Reply.PublishedAt,
+			Latency:     r.Reply.Latency,
+			Error:       r.Reply.Error,
+		}
+	}
+
+	for _, nm := range r.NearMisses {
+		exp, err := nm.Expectation.expectation()
+		if err != nil {
+			return nil, err
+		}
+		assertion.NearMisses = append(assertion.NearMisses, &expectations.NearMiss{Expectation: exp, Reasons: nm.Reasons, BodyDiff: nm.BodyDiff})
+	}
+
+	return assertion, nil
+}
@@ -0,0 +1,183 @@
+// Package state persists snapshots of the server state, so that it survives restarts.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+)
+
+// FileStore keeps the whole snapshot in a single JSON file.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore creates a new FileStore writing to the given file.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+// Save writes the snapshot, replacing the previous one atomically.
+func (s *FileStore) Save(state *app.State) error {
+	snap, err := newSnapshot(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create state directory %s: %w", filepath.Dir(s.path), err)
+	}
+
+	return writeJSON(s.path, snap)
+}
+
+// Load reads the snapshot, it returns nil if the file does not exist yet.
+func (s *FileStore) Load() (*app.State, error) {
+	snap := &snapshot{}
+	found, err := readJSON(s.path, snap)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return snap.state()
+}
+
+// File names of the parts of the snapshot in a DirStore.
+const (
+	expectationsFile  = "expectations.json"
+	subscriptionsFile = "subscriptions.json"
+	assertionsFile    = "assertions.json"
+	scenariosFile     = "scenarios.json"
+)
+
+// DirStore keeps the snapshot in a directory, with one JSON file for each of
+// the expectations, the subscriptions, the assertions and the scenario states.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore creates a new DirStore writing to the given directory, it is created if missing.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{
+		dir: dir,
+	}
+}
+
+// Save writes the snapshot, replacing each file of the previous one atomically.
+// The assertions file is removed if the snapshot has no assertions.
+func (s *DirStore) Save(state *app.State) error {
+	snap, err := newSnapshot(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create state directory %s: %w", s.dir, err)
+	}
+
+	if err := writeJSON(filepath.Join(s.dir, expectationsFile), snap.Expectations); err != nil {
+		return err
+	}
+
+	if err := writeJSON(filepath.Join(s.dir, subscriptionsFile), snap.Subscriptions); err != nil {
+		return err
+	}
+
+	if err := writeJSON(filepath.Join(s.dir, scenariosFile), snap.ScenarioStates); err != nil {
+		return err
+	}
+
+	assertionsPath := filepath.Join(s.dir, assertionsFile)
+	if snap.Assertions == nil {
+		if err := os.Remove(assertionsPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove state file %s: %w", assertionsPath, err)
+		}
+
+		return nil
+	}
+
+	return writeJSON(assertionsPath, snap.Assertions)
+}
+
+// Load reads the snapshot, it returns nil if nothing has been saved to the directory yet.
+func (s *DirStore) Load() (*app.State, error) {
+	snap := &snapshot{}
+	anyFound := false
+
+	parts := []struct {
+		name string
+		v    any
+	}{
+		{expectationsFile, &snap.Expectations},
+		{subscriptionsFile, &snap.Subscriptions},
+		{assertionsFile, &snap.Assertions},
+		{scenariosFile, &snap.ScenarioStates},
+	}
+
+	for _, part := range parts {
+		found, err := readJSON(filepath.Join(s.dir, part.name), part.v)
+		if err != nil {
+			return nil, err
+		}
+		anyFound = anyFound || found
+	}
+
+	if !anyFound {
+		return nil, nil // nolint: nilnil
+	}
+
+	return snap.state()
+}
+
+// writeJSON writes v to a temporary file first and renames it, so that a crash never leaves a partial file behind.
+func writeJSON(path string, v any) error {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state file %s: %w", path, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create state file %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write state file %s: %w", path, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write state file %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace state file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// readJSON decodes the file into v, it reports false if the file does not exist.
+func readJSON(path string, v any) (bool, error) {
+	raw, err := os.ReadFile(path) // nolint: gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to read state file %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("failed to decode state file %s: %w", path, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,160 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/app"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/comparators"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/expectations"
+	"github.com/dialecticanet-com/rmq-rpc-mockserver/internal/domain/subscriptions"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestState(t *testing.T) *app.State {
+	t.Helper()
+
+	bodyCmp, err := comparators.NewJSONBody([]byte(`{"id":1}`), comparators.MatchTypePartial)
+	require.NoError(t, err)
+
+	req, err := expectations.NewRequest("exchange", "rk", bodyCmp)
+	require.NoError(t, err)
+
+	res, err := expectations.NewResponse([]byte(`{"name":"foo"}`))
+	require.NoError(t, err)
+
+	scenario, err := expectations.NewScenario("order", "pending", "done")
+	require.NoError(t, err)
+
+	exp, err := expectations.NewExpectation(req, res, expectations.WithLimitedTimes(1), expectations.WithTimeToLive(time.Hour),
+		expectations.WithScenario(scenario), expectations.WithLabels(map[string]string{"suite": "checkout"}), expectations.WithPriority(5))
+	require.NoError(t, err)
+	exp.Use() // used up
+
+	candidate, err := expectations.NewCandidate("exchange", "rk", []byte("not json"),
+		expectations.WithCandidateQueue("queue"),
+		expectations.WithCandidateHeaders(map[string]string{"x-tenant": "acme"}),
+		expectations.WithCandidateProperties(expectations.Properties{CorrelationID: "42"}),
+		expectations.WithCandidateSubscriptionID(uuid.New()),
+		expectations.WithCandidateDelivery(expectations.Delivery{DeliveryTag: 7, ReceivedAt: time.Now()}),
+	)
+	require.NoError(t, err)
+
+	matched := expectations.NewMatchedAssertion(candidate, exp)
+	matched.Reply = &expectations.Reply{Queue: "reply-to", Body: []byte(`{"name":"foo"}`), PublishedAt: time.Now(), Latency: time.Millisecond}
+
+	return &app.State{
+		Expectations: []*expectations.Expectation{exp},
+		Subscriptions: []*subscriptions.Subscription{
+			subscriptions.NewSubscription("queue",
+				subscriptions.WithFallbackPolicy(subscriptions.FallbackPolicyProxy),
+				subscriptions.WithProxyTarget(&subscriptions.ProxyTarget{Exchange: "real"}),
+				subscriptions.WithNamespace("suite-a"),
+			),
+		},
+		Assertions: []*expectations.Assertion{
+			matched,
+			{
+				Candidate:  candidate,
+				Proxy:      &expectations.ProxyResult{Exchange: "real", RoutingKey: "rk", Error: "timeout"},
+				NearMisses: []*expectations.NearMiss{{Expectation: exp, Reasons: []string{"body", "times exhausted"}, BodyDiff: "[]"}},
+			},
+		},
+		ScenarioStates: map[string]string{"order": "pending"},
+	}
+}
+
+func assertStateRestored(t *testing.T, expected, actual *app.State) {
+	t.Helper()
+
+	require.Len(t, actual.Expectations, 1)
+	exp := actual.Expectations[0]
+	assert.Equal(t, expected.Expectations[0].ID, exp.ID)
+	assert.True(t, expected.Expectations[0].CreatedAt.Equal(exp.CreatedAt))
+	assert.Equal(t, expected.Expectations[0].Times, exp.Times)
+	assert.Equal(t, time.Hour, exp.TimeToLive.TTL)
+	assert.False(t, exp.IsActive())
+	assert.JSONEq(t, `{"name":"foo"}`, string(exp.Response.Body))
+	assert.Equal(t, expected.Expectations[0].Scenario, exp.Scenario)
+	assert.Equal(t, map[string]string{"suite": "checkout"}, exp.Labels)
+	assert.Equal(t, 5, exp.Priority)
+	assert.Equal(t, expected.ScenarioStates, actual.ScenarioStates)
+
+	require.Len(t, actual.Subscriptions, 1)
+	sub := actual.Subscriptions[0]
+	assert.Equal(t, expected.Subscriptions[0].ID(), sub.ID())
+	assert.Equal(t, "queue", sub.Queue())
+	assert.Equal(t, subscriptions.FallbackPolicyProxy, sub.FallbackPolicy())
+	assert.Equal(t, "real", sub.ProxyTarget().Exchange)
+	assert.Equal(t, "suite-a", sub.Namespace())
+
+	require.Len(t, actual.Assertions, len(expected.Assertions))
+	assert.Equal(t, expected.Assertions[0].ID, actual.Assertions[0].ID)
+	assert.Equal(t, exp.ID, actual.Assertions[0].Expectation.ID)
+	assert.Equal(t, "not json", string(actual.Assertions[0].Candidate.Body))
+	assert.Equal(t, "queue", actual.Assertions[0].Candidate.Queue)
+	assert.Equal(t, "acme", actual.Assertions[0].Candidate.Headers["x-tenant"])
+	assert.Equal(t, "42", actual.Assertions[0].Candidate.Properties.CorrelationID)
+	assert.Equal(t, expected.Assertions[0].Candidate.SubscriptionID, actual.Assertions[0].Candidate.SubscriptionID)
+	assert.Equal(t, uint64(7), actual.Assertions[0].Candidate.Delivery.DeliveryTag)
+	assert.True(t, expected.Assertions[0].Candidate.Delivery.ReceivedAt.Equal(actual.Assertions[0].Candidate.Delivery.ReceivedAt))
+	require.NotNil(t, actual.Assertions[0].Reply)
+	assert.Equal(t, "reply-to", actual.Assertions[0].Reply.Queue)
+	assert.JSONEq(t, `{"name":"foo"}`, string(actual.Assertions[0].Reply.Body))
+	assert.Equal(t, time.Millisecond, actual.Assertions[0].Reply.Latency)
+	assert.Nil(t, actual.Assertions[1].Reply)
+	require.Len(t, actual.Assertions[1].NearMisses, 1)
+	assert.Equal(t, exp.ID, actual.Assertions[1].NearMisses[0].Expectation.ID)
+	assert.Equal(t, []string{"body", "times exhausted"}, actual.Assertions[1].NearMisses[0].Reasons)
+	assert.Equal(t, "[]", actual.Assertions[1].NearMisses[0].BodyDiff)
+	assert.Nil(t, actual.Assertions[1].Expectation)
+	assert.Equal(t, "timeout", actual.Assertions[1].Proxy.Error)
+}
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewFileStore(filepath.Join(t.TempDir(), "nested", "state.json"))
+
+	state, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	expected := newTestState(t)
+	require.NoError(t, store.Save(expected))
+
+	state, err = store.Load()
+	require.NoError(t, err)
+	assertStateRestored(t, expected, state)
+}
+
+func TestDirStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := NewDirStore(dir)
+
+	state, err := store.Load()
+	require.NoError(t, err)
+	assert.Nil(t, state)
+
+	expected := newTestState(t)
+	require.NoError(t, store.Save(expected))
+	assert.FileExists(t, filepath.Join(dir, expectationsFile))
+	assert.FileExists(t, filepath.Join(dir, subscriptionsFile))
+	assert.FileExists(t, filepath.Join(dir, assertionsFile))
+
+	state, err = store.Load()
+	require.NoError(t, err)
+	assertStateRestored(t, expected, state)
+
+	// saving without assertions removes the assertions file
+	expected.Assertions = nil
+	require.NoError(t, store.Save(expected))
+	_, err = os.Stat(filepath.Join(dir, assertionsFile))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
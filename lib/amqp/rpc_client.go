@@ -69,6 +69,9 @@ func (c *RPCClient) Call(ctx context.Context, exchange, routingKey string, body
 	}
 
 	headers := amqp.Table{}
+	for name, value := range o.headers {
+		headers[name] = value
+	}
 	if o.bearerToken != "" {
 		headers["performer"] = amqp.Table{
 			"token": o.bearerToken,
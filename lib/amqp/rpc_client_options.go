@@ -3,6 +3,8 @@ package amqp
 import (
 	"strings"
 	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type rpcClientOptions struct {
@@ -31,6 +33,7 @@ type rpcCallOptions struct {
 	mandatory   bool
 	immediate   bool
 	bearerToken string
+	headers     amqp.Table
 }
 
 func defaultRPCCallOptions() *rpcCallOptions {
@@ -81,3 +84,12 @@ func RPCCallWithContentType(contentType string) RPCCallOptionFunc {
 		return nil
 	}
 }
+
+// RPCCallWithHeaders sets the headers of the request message.
+// The bearer token header takes precedence over a header with the same name.
+func RPCCallWithHeaders(headers amqp.Table) RPCCallOptionFunc {
+	return func(o *rpcCallOptions) error {
+		o.headers = headers
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +55,11 @@ func TestRPCCallWithContentType(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, contentType, o.contentType)
 }
+
+func TestRPCCallWithHeaders(t *testing.T) {
+	headers := amqp.Table{"x-tenant": "acme"}
+	o := &rpcCallOptions{}
+	err := RPCCallWithHeaders(headers)(o)
+	assert.NoError(t, err)
+	assert.Equal(t, headers, o.headers)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	grpcApi "github.com/dialecticanet-com/rmq-rpc-mockserver/api/v1"
 	gocoreamqp "github.com/dialecticanet-com/rmq-rpc-mockserver/lib/amqp"
@@ -106,6 +107,38 @@ func TestAssertions(t *testing.T) {
 		assertion.Value("matched").Boolean().IsFalse()
 	})
 
+	t.Run("records the reply", func(t *testing.T) {
+		// the reply is recorded right after it is published
+		require.Eventually(t, func() bool {
+			resp := NewHTTPExpect(t).GET("/api/v1/assertions").Expect().Status(http.StatusOK).JSON().Object()
+			for _, v := range resp.Value("assertions").Array().Iter() {
+				if reply, ok := v.Object().Raw()["reply"]; !ok || reply == nil {
+					return false
+				}
+			}
+			return true
+		}, time.Second, 10*time.Millisecond)
+
+		assertionsResp = NewHTTPExpect(t).GET("/api/v1/assertions").Expect().Status(http.StatusOK).JSON().Object()
+		assertions := assertionsResp.Value("assertions").Array()
+
+		// matched, replied with the expectation response
+		assertion := assertions.Value(0).Object()
+		candidate := assertion.Value("candidate").Object()
+		candidate.Value("queue").String().IsEqual(queue)
+		candidate.Value("subscription_id").String().NotEmpty()
+		candidate.Value("delivery").Object().Value("received_at").String().NotEmpty()
+		candidate.Value("properties").Object().Value("reply_to").String().NotEmpty()
+		reply := assertion.Value("reply").Object()
+		assert.JSONEq(t, `{"result":"success","data":"test-data"}`, reply.Value("body").String().Raw())
+		reply.Value("error").String().IsEmpty()
+		reply.Value("latency_ms").Number().Gt(0)
+
+		// unmatched, replied with the fallback response
+		reply = assertions.Value(1).Object().Value("reply").Object()
+		assert.JSONEq(t, `{"errors":"no match found"}`, reply.Value("body").String().Raw())
+	})
+
 	t.Run("gets all matched assertions", func(t *testing.T) {
 		// get only matched assertions
 		assertionsResp = NewHTTPExpect(t).GET("/api/v1/assertions").WithQuery("status", "matched").